@@ -46,14 +46,27 @@ type translateToASCII bool
 
 func (strict translateToASCII) Translate(data []byte, eof bool) (int, []byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
-	for _, c := range data {
+	n := 0
+	for n < len(data) {
+		c := data[n]
 		if c > 0 && c < 128 {
 			buf.WriteByte(c)
-		} else {
-			buf.WriteByte(errorByte)
+			n++
+			continue
+		}
+		// one error byte for each character.
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
 		}
+		_, size := utf8.DecodeRune(data[n:])
+		buf.WriteByte(errorByte)
+		n += size
 	}
-	return len(data), buf.Bytes(), nil
+	return n, buf.Bytes(), nil
+}
+
+func (strict translateToASCII) encodes(r rune) bool {
+	return r > 0 && r < utf8.RuneSelf
 }
 
 func fromASCII(arg string) (Translator, error) {
@@ -0,0 +1,239 @@
+package charset
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Best fit mappings
+//
+// When Windows converts text to a code page (with
+// WideCharToMultiByte), it maps many characters that the code
+// page does not hold to similar ones that it does: for example,
+// FULLWIDTH LATIN CAPITAL LETTER A (U+FF21) to "A". Microsoft
+// publishes these mappings as best fit files (bestfit1252.txt
+// and so on), which ReadBestFit reads. SetBestFit installs
+// them for a character set of the cp or cp932 class, and the
+// BestFit option makes an encoder use them, before the other
+// fallbacks (see fallback.go).
+//
+// Best fit mappings are one way: the text does not survive a
+// round trip. Because they can map harmless characters to
+// syntactically significant ones (such as U+FF02 FULLWIDTH
+// QUOTATION MARK to '"'), text must not be checked for
+// such characters before it is encoded with best fit; this
+// is why the mappings are only used on request.
+
+// A BestFitTable holds the mappings of a Microsoft best fit
+// file. As in EUDCTable, each code is the value of its bytes
+// taken as a big-endian number.
+type BestFitTable struct {
+	CodePage int
+	// MB holds the mappings of the MBTABLE and
+	// DBCSTABLE sections, from codes to characters.
+	MB map[uint32]rune
+	// WC holds the mappings of the WCTABLE section,
+	// from characters to codes, including the best fits.
+	WC map[rune]uint32
+}
+
+var bestFitClasses = []string{"cp", "cp932"}
+
+// BestFit returns an Option that makes an encoder use the
+// best fit mappings installed by SetBestFit for its character
+// set, if any.
+func BestFit() Option {
+	return func(o *options) {
+		o.bestFit = true
+	}
+}
+
+// A bestFitEncoder is an encoder that can have best
+// fit mappings.
+type bestFitEncoder interface {
+	// bestFit returns the character that the encoder encodes
+	// as the best fit for r, if there is one.
+	bestFit(r rune) (rune, bool)
+}
+
+// bestFits maps each character that has a best fit to the
+// character that its code decodes to.
+type bestFits map[rune]rune
+
+func (m bestFits) bestFit(r rune) (rune, bool) {
+	fit, ok := m[r]
+	return fit, ok
+}
+
+var (
+	bestFitMutex  sync.Mutex
+	bestFitTables = make(map[string]bestFits)
+)
+
+// getBestFits returns the mappings installed for the given
+// class and argument, or nil if there are none.
+func getBestFits(class, arg string) bestFits {
+	bestFitMutex.Lock()
+	defer bestFitMutex.Unlock()
+	return bestFitTables[eudcKey(class, arg)]
+}
+
+// SetBestFit installs the best fit mappings in t for the named
+// character set, which must be of the cp or cp932 class, for
+// encoders created after the call with the BestFit option.
+// If t is nil, the mappings are removed.
+func SetBestFit(charset string, t *BestFitTable) error {
+	localFactory{}.init()
+	cs := localCharsets[NormalizedName(charset)]
+	if cs == nil {
+		return fmt.Errorf("charset: character set %q not found", charset)
+	}
+	className := ""
+	for _, name := range bestFitClasses {
+		if cs.class == classes[name] {
+			className = name
+		}
+	}
+	if className == "" {
+		return fmt.Errorf("charset: %q cannot have best fit mappings", charset)
+	}
+	var m bestFits
+	if t != nil {
+		m = make(bestFits)
+		for r, code := range t.WC {
+			if fit, ok := t.MB[code]; ok && fit != r {
+				m[r] = fit
+			}
+		}
+	}
+	bestFitMutex.Lock()
+	defer bestFitMutex.Unlock()
+	key := eudcKey(className, cs.arg)
+	if m == nil {
+		delete(bestFitTables, key)
+	} else {
+		bestFitTables[key] = m
+	}
+	return nil
+}
+
+func badBestFitLine(line int) error {
+	return fmt.Errorf("charset: bad best fit file line %d", line)
+}
+
+// ReadBestFit reads a Microsoft best fit file, such as
+//
+//	CODEPAGE 1252
+//	CPINFO 1 0x3f 0x003f
+//	MBTABLE 256
+//	0x00 0x0000
+//	...
+//	WCTABLE 1234
+//	0x0000 0x00
+//	...
+//	ENDCODEPAGE
+//
+// The code pages of double-byte character sets list the
+// ranges of their lead bytes in a DBCSRANGE section, each
+// followed by a DBCSTABLE section for each lead byte in
+// the range. Text following ';' is ignored.
+func ReadBestFit(r io.Reader) (*BestFitTable, error) {
+	t := &BestFitTable{
+		MB: make(map[uint32]rune),
+		WC: make(map[rune]uint32),
+	}
+	var (
+		section string   // section whose entries follow.
+		count   int      // entries left in the section.
+		ranges  int      // lead byte ranges left.
+		leads   []uint32 // lead bytes whose tables follow.
+		lead    uint32   // lead byte of the current DBCSTABLE.
+		done    bool
+	)
+	scanner := bufio.NewScanner(r)
+	for line := 1; !done && scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, ';'); i >= 0 {
+			text = text[:i]
+		}
+		f := strings.Fields(text)
+		if len(f) == 0 {
+			continue
+		}
+		if count == 0 {
+			keyword := strings.ToUpper(f[0])
+			switch keyword {
+			case "ENDCODEPAGE":
+				done = true
+				continue
+			case "CPINFO":
+				continue
+			}
+			if len(f) != 2 {
+				return nil, badBestFitLine(line)
+			}
+			if ranges > 0 && strings.HasPrefix(keyword, "0X") {
+				// a range of lead bytes.
+				b0, err0 := parseHex(f[0])
+				b1, err1 := parseHex(f[1])
+				if err0 != nil || err1 != nil || b0 > b1 || b1 > 0xff {
+					return nil, badBestFitLine(line)
+				}
+				for b := b0; b <= b1; b++ {
+					leads = append(leads, uint32(b))
+				}
+				ranges--
+				continue
+			}
+			n, err := strconv.Atoi(f[1])
+			if err != nil || n < 0 {
+				return nil, badBestFitLine(line)
+			}
+			switch keyword {
+			case "CODEPAGE":
+				t.CodePage = n
+			case "DBCSRANGE":
+				ranges = n
+			case "DBCSTABLE":
+				if len(leads) == 0 {
+					return nil, badBestFitLine(line)
+				}
+				lead, leads = leads[0], leads[1:]
+				section, count = keyword, n
+			case "MBTABLE", "WCTABLE":
+				section, count = keyword, n
+			default:
+				return nil, badBestFitLine(line)
+			}
+			continue
+		}
+		if len(f) != 2 {
+			return nil, badBestFitLine(line)
+		}
+		a, err0 := parseHex(f[0])
+		b, err1 := parseHex(f[1])
+		if err0 != nil || err1 != nil {
+			return nil, badBestFitLine(line)
+		}
+		switch section {
+		case "MBTABLE":
+			t.MB[uint32(a)] = rune(b)
+		case "DBCSTABLE":
+			t.MB[lead<<8|uint32(a)] = rune(b)
+		case "WCTABLE":
+			t.WC[rune(a)] = uint32(b)
+		}
+		count--
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("charset: best fit file ends in %s section", section)
+	}
+	return t, nil
+}
@@ -0,0 +1,329 @@
+package charset
+
+import (
+	"unicode"
+)
+
+// This file implements enough of the Unicode bidirectional
+// algorithm (UAX #9) to convert a single line of text between
+// logical and visual order. Explicit embeddings, overrides and
+// isolates are not supported; their control characters are
+// treated as boundary neutrals.
+
+type bidiType uint8
+
+const (
+	bidiL   bidiType = iota // Left-to-right
+	bidiR                   // Right-to-left
+	bidiAL                  // Right-to-left Arabic
+	bidiEN                  // European number
+	bidiES                  // European number separator
+	bidiET                  // European number terminator
+	bidiAN                  // Arabic number
+	bidiCS                  // Common number separator
+	bidiNSM                 // Non-spacing mark
+	bidiBN                  // Boundary neutral
+	bidiB                   // Paragraph separator
+	bidiS                   // Segment separator
+	bidiWS                  // Whitespace
+	bidiON                  // Other neutrals
+)
+
+// bidiClass returns the bidirectional character type of r.
+// It is derived from the general category and script tables
+// in the unicode package, which is close enough to the
+// Bidi_Class property for the scripts we care about.
+func bidiClass(r rune) bidiType {
+	switch r {
+	case '\n', '\r', 0x1c, 0x1d, 0x1e, 0x85, 0x2029:
+		return bidiB
+	case '\t', 0x0b, 0x1f:
+		return bidiS
+	case ' ', '\f', 0x2028:
+		return bidiWS
+	case '+', '-', 0x207a, 0x207b, 0x208a, 0x208b, 0x2212, 0xfb29, 0xfe62, 0xfe63, 0xff0b, 0xff0d:
+		return bidiES
+	case '#', '$', '%', 0xb0, 0xb1, 0x0609, 0x060a, 0x066a, 0x2030, 0x2031, 0x2032, 0x2033, 0x2034, 0x2213, 0xfe5f, 0xfe69, 0xfe6a, 0xff03, 0xff04, 0xff05:
+		return bidiET
+	case ',', '.', '/', ':', 0xa0, 0x060c, 0x202f, 0x2044, 0xfe50, 0xfe52, 0xfe55, 0xff0c, 0xff0e, 0xff0f, 0xff1a:
+		return bidiCS
+	case 0xb2, 0xb3, 0xb9, 0x2070, 0x2074, 0x2075, 0x2076, 0x2077, 0x2078, 0x2079:
+		return bidiEN
+	case 0x200e:
+		return bidiL
+	case 0x200f:
+		return bidiR
+	case 0x061c:
+		return bidiAL
+	case 0x066b, 0x066c, 0x06dd, 0x08e2:
+		return bidiAN
+	}
+	switch {
+	case r >= '0' && r <= '9',
+		r >= 0x06f0 && r <= 0x06f9,
+		r >= 0x2080 && r <= 0x2089,
+		r >= 0x2488 && r <= 0x249b,
+		r >= 0xff10 && r <= 0xff19:
+		return bidiEN
+	case r >= 0x0600 && r <= 0x0605,
+		r >= 0x0660 && r <= 0x0669:
+		return bidiAN
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return bidiNSM
+	case unicode.Is(unicode.Sc, r):
+		return bidiET
+	case unicode.IsControl(r), unicode.In(r, unicode.Bidi_Control, unicode.Cf):
+		return bidiBN
+	case unicode.Is(unicode.Zs, r):
+		return bidiWS
+	case r >= 0x0590 && r <= 0x05ff,
+		r >= 0x07c0 && r <= 0x085f,
+		r >= 0xfb1d && r <= 0xfb4f,
+		r >= 0x10800 && r <= 0x10fff,
+		r >= 0x1e800 && r <= 0x1edff:
+		return bidiR
+	case r >= 0x0600 && r <= 0x07bf,
+		r >= 0x0860 && r <= 0x08ff,
+		r >= 0xfb50 && r <= 0xfdff,
+		r >= 0xfe70 && r <= 0xfeff:
+		return bidiAL
+	case unicode.In(r, unicode.L, unicode.Mc, unicode.Nd, unicode.Nl, unicode.Co, unicode.Cs),
+		r >= 0x249c && r <= 0x24e9,
+		r >= 0x2800 && r <= 0x28ff,
+		r >= 0x3200 && r <= 0x33ff:
+		return bidiL
+	}
+	return bidiON
+}
+
+// bidiMirror holds pairs of characters that have the
+// Bidi_Mirrored property and a mirror glyph.
+var bidiMirror = map[rune]rune{
+	'(': ')', ')': '(',
+	'<': '>', '>': '<',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'«': '»', '»': '«',
+	0x2039: 0x203a, 0x203a: 0x2039,
+	0x2045: 0x2046, 0x2046: 0x2045,
+	0x207d: 0x207e, 0x207e: 0x207d,
+	0x208d: 0x208e, 0x208e: 0x208d,
+	0x2264: 0x2265, 0x2265: 0x2264,
+	0x2329: 0x232a, 0x232a: 0x2329,
+	0x3008: 0x3009, 0x3009: 0x3008,
+	0x300a: 0x300b, 0x300b: 0x300a,
+	0x300c: 0x300d, 0x300d: 0x300c,
+	0x300e: 0x300f, 0x300f: 0x300e,
+	0x3010: 0x3011, 0x3011: 0x3010,
+	0xff08: 0xff09, 0xff09: 0xff08,
+	0xff1c: 0xff1e, 0xff1e: 0xff1c,
+	0xff3b: 0xff3d, 0xff3d: 0xff3b,
+	0xff5b: 0xff5d, 0xff5d: 0xff5b,
+}
+
+func isStrong(t bidiType) bool {
+	return t == bidiL || t == bidiR || t == bidiAL
+}
+
+// isNeutral reports whether t is treated as a neutral
+// by rules N1 and N2.
+func isNeutral(t bidiType) bool {
+	return t == bidiB || t == bidiS || t == bidiWS || t == bidiON || t == bidiBN
+}
+
+// bidiLevels returns the resolved embedding level of each
+// rune in line, given the paragraph embedding level.
+func bidiLevels(line []rune, paraLevel int) []int {
+	n := len(line)
+	types := make([]bidiType, n)
+	for i, r := range line {
+		types[i] = bidiClass(r)
+	}
+	sos := bidiL
+	if paraLevel&1 != 0 {
+		sos = bidiR
+	}
+
+	// W1: non-spacing marks (and boundary neutrals) take
+	// the type of the previous character.
+	prev := sos
+	for i, t := range types {
+		if t == bidiNSM || t == bidiBN {
+			types[i] = prev
+		}
+		prev = types[i]
+	}
+	// W2, W3: European numbers after Arabic letters become
+	// Arabic numbers; Arabic letters become R.
+	last := sos
+	for i, t := range types {
+		switch {
+		case isStrong(t):
+			last = t
+		case t == bidiEN && last == bidiAL:
+			types[i] = bidiAN
+		}
+	}
+	for i, t := range types {
+		if t == bidiAL {
+			types[i] = bidiR
+		}
+	}
+	// W4: a single separator between two numbers of the
+	// same type takes that type.
+	for i := 1; i < n-1; i++ {
+		before, after := types[i-1], types[i+1]
+		switch types[i] {
+		case bidiES:
+			if before == bidiEN && after == bidiEN {
+				types[i] = bidiEN
+			}
+		case bidiCS:
+			if before == after && (before == bidiEN || before == bidiAN) {
+				types[i] = before
+			}
+		}
+	}
+	// W5: terminators adjacent to European numbers become
+	// European numbers.
+	for i := 0; i < n; i++ {
+		if types[i] != bidiET {
+			continue
+		}
+		j := i
+		for j < n && types[j] == bidiET {
+			j++
+		}
+		if (i > 0 && types[i-1] == bidiEN) || (j < n && types[j] == bidiEN) {
+			for k := i; k < j; k++ {
+				types[k] = bidiEN
+			}
+		}
+		i = j
+	}
+	// W6: remaining separators and terminators become neutral.
+	for i, t := range types {
+		if t == bidiES || t == bidiET || t == bidiCS {
+			types[i] = bidiON
+		}
+	}
+	// W7: European numbers in a left-to-right context become L.
+	last = sos
+	for i, t := range types {
+		switch {
+		case t == bidiL || t == bidiR:
+			last = t
+		case t == bidiEN && last == bidiL:
+			types[i] = bidiL
+		}
+	}
+	// N1, N2: sequences of neutrals take the direction of
+	// the surrounding text if both sides agree, and the
+	// embedding direction otherwise.
+	strongDir := func(t bidiType) bidiType {
+		if t == bidiEN || t == bidiAN {
+			return bidiR
+		}
+		return t
+	}
+	for i := 0; i < n; i++ {
+		if !isNeutral(types[i]) {
+			continue
+		}
+		j := i
+		for j < n && isNeutral(types[j]) {
+			j++
+		}
+		before, after := sos, sos
+		if i > 0 {
+			before = strongDir(types[i-1])
+		}
+		if j < n {
+			after = strongDir(types[j])
+		}
+		dir := sos
+		if before == after {
+			dir = before
+		}
+		for k := i; k < j; k++ {
+			types[k] = dir
+		}
+		i = j
+	}
+	// I1, I2: resolve implicit levels.
+	levels := make([]int, n)
+	for i, t := range types {
+		level := paraLevel
+		if level&1 == 0 {
+			switch t {
+			case bidiR:
+				level++
+			case bidiAN, bidiEN:
+				level += 2
+			}
+		} else if t == bidiL || t == bidiEN || t == bidiAN {
+			level++
+		}
+		levels[i] = level
+	}
+	// L1: segment separators and trailing whitespace are
+	// reset to the paragraph level.
+	trailing := true
+	for i := n - 1; i >= 0; i-- {
+		switch bidiClass(line[i]) {
+		case bidiS, bidiB:
+			levels[i] = paraLevel
+			trailing = true
+		case bidiWS, bidiBN:
+			if trailing {
+				levels[i] = paraLevel
+			}
+		default:
+			trailing = false
+		}
+	}
+	return levels
+}
+
+// bidiReorder reorders line in place according to the
+// resolved levels of its characters (rule L2), mirroring
+// characters at right-to-left levels (rule L4). Because
+// reordering a line is (very nearly) its own inverse,
+// the same function converts logical order to visual
+// order and visual order to logical order.
+func bidiReorder(line []rune, paraLevel int) {
+	levels := bidiLevels(line, paraLevel)
+	maxLevel, minOdd := 0, -1
+	for i, level := range levels {
+		if level > maxLevel {
+			maxLevel = level
+		}
+		if level&1 != 0 {
+			if m, ok := bidiMirror[line[i]]; ok {
+				line[i] = m
+			}
+			if minOdd == -1 || level < minOdd {
+				minOdd = level
+			}
+		}
+	}
+	if minOdd == -1 {
+		return
+	}
+	for level := maxLevel; level >= minOdd; level-- {
+		for i := 0; i < len(line); i++ {
+			if levels[i] < level {
+				continue
+			}
+			j := i
+			for j < len(line) && levels[j] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				line[a], line[b] = line[b], line[a]
+				levels[a], levels[b] = levels[b], levels[a]
+			}
+			i = j
+		}
+	}
+}
@@ -6,42 +6,129 @@ import (
 )
 
 func init() {
-	registerClass("big5", fromBig5, nil)
+	registerClass("big5", fromBig5, toBig5)
 }
 
-// Big5 consists of 89 fonts of 157 chars each
+// Big5 consists of fonts (lead bytes) of 157 chars each:
+// trail bytes 40..7e and a1..fe.
+//
+// The class argument selects the variant:
+//
+//	""	HKU Big 5: 89 fonts, lead bytes a1..f9
+//	"hkscs"	Big5-HKSCS (2008): 120 fonts, lead bytes 87..fe
+//	"cp950"	Microsoft CP950: 89 fonts, lead bytes a1..f9,
+//		including the euro sign (a3e1) and the ETEN
+//		extensions in row f9.
+//
+// In Big5-HKSCS, four codes decode to a letter followed by
+// a combining mark (see big5HKSCSPairs).
+//
+// In Big5 and CP950, the user-defined areas are mapped to
+// the Unicode Private Use Area (see big5UDA and cp950UDA)
+// unless overridden by SetEUDC.
 const (
-	big5Max  = 13973
 	big5Font = 157
 	big5Data = "big5.dat"
 )
 
+type big5Variant struct {
+	data  string
+	lead0 int // first lead byte in the data file.
+	fonts int
+	pairs map[int][2]rune
+	// prefer holds the codes that the encoder uses
+	// for characters that have more than one code.
+	// Otherwise the later code is used.
+	prefer []int
+	uda    []udaRange
+}
+
+var big5Variants = map[string]*big5Variant{
+	"":      {big5Data, 0xa1, 89, nil, nil, big5UDA},
+	"hkscs": {"big5-hkscs.dat", 0x87, 120, big5HKSCSPairs, nil, nil},
+	"cp950": {"cp950.dat", 0xa1, 89, nil, cp950Prefer, cp950UDA},
+}
+
+// cp950Prefer holds the box drawing characters in row a2,
+// which are duplicated in row f9.
+var cp950Prefer = []int{0xa27e, 0xa2a1, 0xa2a2, 0xa2a3, 0xa2a4, 0xa2a5, 0xa2a6, 0xa2a7}
+
+// big5HKSCSPairs holds the Big5-HKSCS codes that
+// decode to two code points.
+var big5HKSCSPairs = map[int][2]rune{
+	0x8862: {0x00ca, 0x0304},
+	0x8864: {0x00ca, 0x030c},
+	0x88a3: {0x00ea, 0x0304},
+	0x88a5: {0x00ea, 0x030c},
+}
+
+type big5Tables struct {
+	*big5Variant
+	big5map   []rune
+	codes     map[rune]int
+	pairCodes map[[2]rune]int
+	bases     map[rune]bool // first characters of pairCodes.
+}
+
+// code returns the Big5 code for index ix in big5map.
+func (t *big5Tables) code(ix int) int {
+	c := ix % big5Font
+	if c < 63 {
+		c += 0x40
+	} else {
+		c += 0xa1 - 63
+	}
+	return (ix/big5Font+t.lead0)<<8 | c
+}
+
+// index returns the index in big5map of the given Big5 code.
+func (t *big5Tables) index(code int) int {
+	c := code & 0xff
+	if c >= 0xa1 {
+		c -= 0xa1 - 63
+	} else {
+		c -= 0x40
+	}
+	return (code>>8-t.lead0)*big5Font + c
+}
+
 type translateFromBig5 struct {
+	*big5Tables
+	eudc    *eudcMaps
 	font    int
 	scratch []byte
-	big5map []rune
 }
 
 func (p *translateFromBig5) Translate(data []byte, eof bool) (int, []byte, error) {
 	p.scratch = p.scratch[:0]
 	n := 0
-	for len(data) > 0 {
-		c := int(data[0])
-		data = data[1:]
+	for n < len(data) {
+		c := int(data[n])
 		n++
 		if p.font == -1 {
 			// idle state
-			if c >= 0xa1 {
+			if c >= 0x81 && c <= 0xfe {
 				p.font = c
 				continue
 			}
-			if c == 26 {
+			if c >= 0x80 {
+				p.scratch = appendRune(p.scratch, utf8.RuneError)
+				continue
+			}
+			if c == 26 && p.data == big5Data {
 				c = '\n'
 			}
+			p.scratch = append(p.scratch, byte(c))
 			continue
 		}
 		f := p.font
 		p.font = -1
+		code := f<<8 | c
+		if pair, ok := p.pairs[code]; ok {
+			p.scratch = appendRune(p.scratch, pair[0])
+			p.scratch = appendRune(p.scratch, pair[1])
+			continue
+		}
 		r := utf8.RuneError
 		switch {
 		case c >= 64 && c <= 126:
@@ -50,39 +137,142 @@ func (p *translateFromBig5) Translate(data []byte, eof bool) (int, []byte, error
 			c = c - 161 + 63
 		default:
 			// bad big5 char
-			f = 255
+			f = -1
 		}
-		if f <= 254 {
-			f -= 161
-			ix := f*big5Font + c
-			if ix < len(p.big5map) {
-				r = p.big5map[ix]
-			}
-			if r == -1 {
-				r = utf8.RuneError
+		if f == -1 {
+			p.scratch = appendRune(p.scratch, r)
+			if c < 0x80 {
+				// an ASCII byte is read again, as the WHATWG
+				// decoder and Windows do, so that markup after
+				// a stray lead byte is kept.
+				n--
 			}
+			continue
+		}
+		if f -= p.lead0; f >= 0 && f < p.fonts {
+			r = p.big5map[f*big5Font+c]
 		}
+		r = p.eudc.decode(p.uda, uint32(code), r)
 		p.scratch = appendRune(p.scratch, r)
 	}
+	if eof && p.font != -1 {
+		// truncated character.
+		p.font = -1
+		p.scratch = appendRune(p.scratch, utf8.RuneError)
+	}
 	return n, p.scratch, nil
 }
 
-type big5Key bool
+type translateToBig5 struct {
+	*big5Tables
+	eudc    *eudcMaps
+	scratch []byte
+}
 
-func fromBig5(arg string) (Translator, error) {
-	big5map, err := cache(big5Key(false), func() (interface{}, error) {
-		data, err := readFile(big5Data)
+func (p *translateToBig5) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if r < utf8.RuneSelf {
+			p.scratch = append(p.scratch, byte(r))
+			n += size
+			continue
+		}
+		if p.bases[r] {
+			// look for a sequence that has a single code.
+			rest := data[n+size:]
+			if !eof && !utf8.FullRune(rest) {
+				break
+			}
+			r2, size2 := utf8.DecodeRune(rest)
+			if code, ok := p.pairCodes[[2]rune{r, r2}]; ok {
+				p.scratch = append(p.scratch, byte(code>>8), byte(code))
+				n += size + size2
+				continue
+			}
+		}
+		if code, ok := p.eudc.encode(p.uda, r); ok {
+			p.scratch = append(p.scratch, byte(code>>8), byte(code))
+		} else if code, ok := p.codes[r]; ok && !p.eudc.overrides(uint32(code)) {
+			p.scratch = append(p.scratch, byte(code>>8), byte(code))
+		} else {
+			p.scratch = append(p.scratch, errorByte)
+		}
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func (p *translateToBig5) encodes(r rune) bool {
+	if r < utf8.RuneSelf {
+		return true
+	}
+	if _, ok := p.eudc.encode(p.uda, r); ok {
+		return true
+	}
+	code, ok := p.codes[r]
+	return ok && !p.eudc.overrides(uint32(code))
+}
+
+type big5Key string
+
+func getBig5Tables(arg string) (*big5Tables, error) {
+	v := big5Variants[arg]
+	if v == nil {
+		return nil, fmt.Errorf("charset: unknown big5 variant %q", arg)
+	}
+	t, err := cache(big5Key(arg), func() (interface{}, error) {
+		data, err := readFile(v.data)
 		if err != nil {
 			return nil, fmt.Errorf("charset: cannot open big5 data file: %v", err)
 		}
 		big5map := []rune(string(data))
-		if len(big5map) != big5Max {
+		if len(big5map) != v.fonts*big5Font {
 			return nil, fmt.Errorf("charset: corrupt big5 data")
 		}
-		return big5map, nil
+		t := &big5Tables{
+			big5Variant: v,
+			big5map:     big5map,
+			codes:       make(map[rune]int),
+			pairCodes:   make(map[[2]rune]int),
+			bases:       make(map[rune]bool),
+		}
+		for ix, r := range big5map {
+			if r != utf8.RuneError {
+				t.codes[r] = t.code(ix)
+			}
+		}
+		for _, code := range v.prefer {
+			t.codes[big5map[t.index(code)]] = code
+		}
+		for code, pair := range v.pairs {
+			t.pairCodes[pair] = code
+			t.bases[pair[0]] = true
+		}
+		return t, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &translateFromBig5{big5map: big5map.([]rune), font: -1}, nil
+	return t.(*big5Tables), nil
+}
+
+func fromBig5(arg string) (Translator, error) {
+	t, err := getBig5Tables(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromBig5{big5Tables: t, eudc: getEUDC("big5", arg), font: -1}, nil
+}
+
+func toBig5(arg string) (Translator, error) {
+	t, err := getBig5Tables(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToBig5{big5Tables: t, eudc: getEUDC("big5", arg)}, nil
 }
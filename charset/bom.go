@@ -0,0 +1,53 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// boms holds the byte order marks recognised by
+// NewReaderAutoBOM, with the character sets they
+// introduce. The UTF-32LE mark starts with the UTF-16LE
+// one, so it must be tried first.
+var boms = []struct {
+	bom     []byte
+	charset string
+}{
+	{[]byte{0xff, 0xfe, 0x00, 0x00}, "utf-32le"},
+	{[]byte{0x00, 0x00, 0xfe, 0xff}, "utf-32be"},
+	{[]byte{0xef, 0xbb, 0xbf}, "utf-8"},
+	{[]byte{0xff, 0xfe}, "utf-16le"},
+	{[]byte{0xfe, 0xff}, "utf-16be"},
+}
+
+// DetectBOM returns the character set indicated by the byte
+// order mark at the start of data, and the length of the
+// mark. It returns "", 0 if data does not start with one.
+func DetectBOM(data []byte) (charset string, n int) {
+	for _, b := range boms {
+		if bytes.HasPrefix(data, b.bom) {
+			return b.charset, len(b.bom)
+		}
+	}
+	return "", 0
+}
+
+// NewReaderAutoBOM returns a new Reader that translates r
+// to UTF-8. If r starts with a UTF-8, UTF-16 or UTF-32 byte
+// order mark, the mark is removed and the rest of r is
+// decoded in the character set that it indicates; otherwise
+// r is decoded in the fallback character set.
+func NewReaderAutoBOM(fallback string, r io.Reader, opts ...Option) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	data, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	charset, n := DetectBOM(data)
+	if n == 0 {
+		charset = fallback
+	}
+	br.Discard(n)
+	return NewReader(charset, br, opts...)
+}
@@ -22,6 +22,13 @@ type Charset struct {
 	Desc    string   // Description.
 	NoFrom  bool     // Not possible to translate from this charset.
 	NoTo    bool     // Not possible to translate to this charset.
+
+	// Information from the IANA registry and code page tables;
+	// see ByMIB.
+	MIMEName string // IANA preferred MIME name, or the registry name.
+	MIB      int    // IANA MIBenum, or 0 if not registered.
+	CodePage int    // Windows code page, or 0 if none.
+	CCSID    int    // IBM coded character set identifier, or 0 if none.
 }
 
 // Translator represents a character set converter.
@@ -62,11 +69,33 @@ func Register(factory Factory) {
 
 // NewReader returns a new Reader that translates from the named
 // character set to UTF-8 as it reads r.
-func NewReader(charset string, r io.Reader) (io.Reader, error) {
+func NewReader(charset string, r io.Reader, opts ...Option) (io.Reader, error) {
 	tr, err := TranslatorFrom(charset)
 	if err != nil {
 		return nil, err
 	}
+	return newReader(tr, r, opts)
+}
+
+// newReader returns a new Reader that translates r with tr,
+// followed by the width folding and normalization given by
+// opts, if any.
+func newReader(tr Translator, r io.Reader, opts []Option) (io.Reader, error) {
+	o := getOptions(opts)
+	if o.foldWidth {
+		var err error
+		tr, err = foldWidth(tr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if o.form != 0 {
+		norm, err := newNormalizer(o.form)
+		if err != nil {
+			return nil, err
+		}
+		tr = &chainedTranslator{first: tr, second: norm}
+	}
 	return NewTranslatingReader(r, tr), nil
 }
 
@@ -74,20 +103,31 @@ func NewReader(charset string, r io.Reader) (io.Reader, error) {
 // of UTF-8 text into writes on w of text in the named character set.
 // The Close is necessary to flush any remaining partially translated
 // characters to the output.
-func NewWriter(charset string, w io.Writer) (io.WriteCloser, error) {
-	tr, err := TranslatorTo(charset)
+func NewWriter(charset string, w io.Writer, opts ...Option) (io.WriteCloser, error) {
+	tr, err := TranslatorTo(charset, opts...)
 	if err != nil {
 		return nil, err
 	}
+	if o := getOptions(opts); o.form != 0 {
+		norm, err := newNormalizer(o.form)
+		if err != nil {
+			return nil, err
+		}
+		tr = &chainedTranslator{first: norm, second: tr}
+	}
 	return NewTranslatingWriter(w, tr), nil
 }
 
 // Info returns information about a character set, or nil
-// if the character set is not found.
+// if the character set is not found. The name may also be any
+// of the names of the character set in the IANA registry.
 func Info(name string) *Charset {
-	for _, f := range factories {
-		if info := f.Info(name); info != nil {
-			return info
+	for _, n := range append([]string{name}, registryNames(name)...) {
+		for _, f := range factories {
+			if info := f.Info(n); info != nil {
+				addRegistryInfo(info)
+				return info
+			}
 		}
 	}
 	return nil
@@ -105,36 +145,49 @@ func Names() []string {
 
 // TranslatorFrom returns a translator that will translate from
 // the named character set to UTF-8.
+// A "//TRANSLIT" suffix on the name is ignored.
 func TranslatorFrom(charset string) (Translator, error) {
-	var err error
-	var tr Translator
-	for _, f := range factories {
-		tr, err = f.TranslatorFrom(charset)
-		if err == nil {
-			break
-		}
-	}
-	if tr == nil {
+	charset, _ = splitTranslit(charset)
+	return findTranslator(charset, Factory.TranslatorFrom)
+}
+
+// TranslatorTo returns a translator that will translate from UTF-8
+// to the named character set.
+// As in iconv, the suffix "//TRANSLIT" on the name is the same
+// as the Transliterate option.
+func TranslatorTo(charset string, opts ...Option) (Translator, error) {
+	o := getOptions(opts)
+	charset, translit := splitTranslit(charset)
+	o.translit = o.translit || translit
+	tr, err := findTranslator(charset, Factory.TranslatorTo)
+	if err != nil {
 		return nil, err
 	}
+	if enc, ok := tr.(runeEncoder); ok {
+		return newFallbackEncoder(enc, o)
+	}
 	return tr, nil
 }
 
-// TranslatorTo returns a translator that will translate from UTF-8
-// to the named character set.
-func TranslatorTo(charset string) (Translator, error) {
+// findTranslator returns the first translator that a factory
+// makes for the named character set with newTranslator, trying
+// the other names of the character set in the IANA registry
+// if no factory knows the name. The error is the one for the
+// name itself.
+func findTranslator(charset string, newTranslator func(Factory, string) (Translator, error)) (Translator, error) {
 	var err error
-	var tr Translator
-	for _, f := range factories {
-		tr, err = f.TranslatorTo(charset)
-		if err == nil {
-			break
+	for i, name := range append([]string{charset}, registryNames(charset)...) {
+		for _, f := range factories {
+			tr, ferr := newTranslator(f, name)
+			if ferr == nil {
+				return tr, nil
+			}
+			if i == 0 {
+				err = ferr
+			}
 		}
 	}
-	if tr == nil {
-		return nil, err
-	}
-	return tr, nil
+	return nil, err
 }
 
 func normalizedChar(c rune) rune {
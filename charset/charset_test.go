@@ -2,10 +2,13 @@ package charset_test
 
 import (
 	"bytes"
+	"encoding/hex"
+	"encoding/xml"
 	"fmt"
 	"github.com/paulrosania/go-charset/charset"
 	_ "github.com/paulrosania/go-charset/data"
 	"io"
+	"io/ioutil"
 	"strings"
 	"testing"
 	"testing/iotest"
@@ -26,10 +29,98 @@ var tests = []translateTest{
 	{true, "ms-kanji", "\x82\xb1\x82\xea\x82\xcd\x8a\xbf\x8e\x9a\x82\xc5\x82\xb7\x81B", "これは漢字です。"},
 	{true, "utf-16le", "S0\x8c0o0\"oW[g0Y0\x020", "これは漢字です。"},
 	{true, "utf-16be", "0S0\x8c0oo\"[W0g0Y0\x02", "これは漢字です。"},
+	// the encoder without an endianness writes a byte order mark.
+	{true, "utf-16", "\xfe\xff0S\x00A", "こA"},
+	{true, "utf-32le", "\x53\x30\x00\x00A\x00\x00\x00\x0b\x00\x02\x00", "こA𠀋"},
+	{true, "utf-32be", "\x00\x00\x30\x53\x00\x00\x00A\x00\x02\x00\x0b", "こA𠀋"},
+	{true, "utf-32", "\x00\x00\xfe\xff\x00\x00\x00A", "A"},
+	{false, "utf-32", "\xff\xfe\x00\x00A\x00\x00\x00", "A"},
+	{false, "utf-32be", "\x00\x11\x00\x00\x00\x00\xd8\x00\x00\x00", "\ufffd\ufffd\ufffd"},
 	{true, "utf-8", "♔", "♔"},
+	{true, "utf-8-sig", "\xef\xbb\xbf♔", "♔"},
+	{false, "utf-8-sig", "a♔", "a♔"},
 	{false, "utf-8", "a♔é\x80", "a♔é" + string(utf8.RuneError)},
 	{true, "sjis", "", ""},
 	{true, "latin1", "\xa35 for Pepp\xe9", "£5 for Peppé"},
+	{true, "iso-8859-8-i", "\xf9\xec\xe5\xed 123", "שלום 123"},
+	{true, "iso-8859-8", "123 \xed\xe5\xec\xf9\n(\xed\xe5\xec\xf9) abc", "שלום 123\nabc (שלום)"},
+	{true, "iscii-dev", "\xc6\xcc\xd7\xe8\xc2\xe1 \xb3\xe9\xa1\xe9 \xef\x43\xc6\xcc\n\xc6", "नमस्ते क\u093cॐ নম\nन"},
+	{true, "iscii-tml", "\xc2\xcc\xdb\xd3\xe8", "தமிழ்"},
+	// an EXT or ATR code truncated at the end of the input.
+	{false, "iscii-dev", "a\xf0", "a\ufffd"},
+	{false, "iscii-dev", "a\xef", "a\ufffd"},
+	{true, "tscii", "\xbe\xc1\xa2\xfa \xa6\xb8\xa1\xa6\xbe\xa8\x87", "தமிழ் கொதெக்ஷை"},
+	{true, "armscii-8", "\xd0\xb3\xdb\xb3\xeb\xef\xb3\xdd", "Հայաստան"},
+	{true, "georgian-ps", "\xd3\xc0\xd8\xc0\xd2\xc8\xc5\xc4\xcb\xcf", "საქართველო"},
+	{true, "georgian-academy", "\xd1\xc0\xd5\xc0\xd0\xc7\xc5\xc4\xca\xcd", "საქართველო"},
+	{true, "kz-1048", "\x8d\xe0\xe7\xe0\x9d\xf1\xf2\xe0\xed", "Қазақстан"},
+	{true, "pt154", "\x8d\xe0\xe7\xe0\x9d\xf1\xf2\xe0\xed", "Қазақстан"},
+	{true, "cp1125", "\x93\xaa\xe0\xa0\xf9\xad\xa0", "Україна"},
+	{true, "gsm0338", "Hello \x1b\x65 \x00\x1b\x28x\x1b\x29", "Hello € @{x}"},
+	{false, "gsm0338", "\x1b\x41", "A"},
+	{true, "gsm0338-packed", "\xe8\x32\x9b\xfd\x46\x97\xd9\xec\x37", "hellohello"},
+	{true, "gsm0338-packed", "\x31\xd9\x8c\x56\xb3\xdd\x1a", "1234567"},
+	{true, "gsm0338-packed", "\x31\xd9\x8c\x56\xb3\xdd\x36\x65\x3c", "1234567€x"},
+	{true, "gsm0338-tr", "\x0c\x1c\x07\x40", "ğŞıİ"},
+	{false, "gsm0338-tr", "\x1b\x49\x1b\x65", "İ€"},
+	{true, "gsm0338-es", "Espa\x7da \x1b\x41", "España Á"},
+	{true, "marc-8", "Caf\xe2e \xf2\xe3a \xe1\xa1", "Café ậ Ł̀"},
+	{false, "marc-8", "\xe3\xf2a", "ậ"},
+	{true, "marc-8", "\x1b(NMOSKWA \x1b(B\x1e\x1b(S\x41\x1b(B", "москва \x1eΑ"},
+	{false, "marc-8", "\x1b(N\x4d\x1ex", "м\x1ex"},
+	{true, "marc-8", "H\x1bb2\x1bsO", "H₂O"},
+	// the EACC and Extended Arabic sets are not bundled.
+	{false, "marc-8", "\x1b$1\x21\x30\x21\x1b(BA", "\ufffdA"},
+	{false, "marc-8", "\x1b$)1\xa1\xb0\xa1\x1b$1\x21\x30", "\ufffd\ufffd"},
+	{false, "marc-8", "\x1b)4\xa1\x1b)E", "\ufffd"},
+	{true, "iso-6937", "Fran\xcbcais \xc2a\xc8o\xcdo \xe8\xc2z\xc7z", "Français áöő Łźż"},
+	{true, "iso-6937", "\xc2 #\xe0", "´#Ω"},
+	{false, "iso-6937", "\xc8 \xc8\xc1e\xc2", "¨̈è́"},
+	{true, "t.61", "\xcf\x63a\xa6\xa4\xc3 \xcb\x43", "ča#$^Ç"},
+	{false, "t.61", "#x", "�x"},
+	{true, "pdfdocencoding", "\x8d\x93nancial\x8e \x80 \xa0\n", "“ﬁnancial” • €\n"},
+	{true, "winansiencoding", "\x95\x80\xe9", "•€é"},
+	{true, "adobe-standard-encoding", "\x60quoted\x27 \xae\xe8", "‘quoted’ ﬁŁ"},
+	// oneoldstyle, ff and Asmall; the Adobe glyph list maps
+	// oldstyle figures and small capitals to the Private Use Area.
+	{true, "macexpertencoding", "\x31\x56\x61", "\uf731\ufb00\uf761"},
+	{true, "symbol", "\x61\x62\x44 \xa5\xf2\xe6", "αβΔ ∞∫\uf8eb"},
+	{true, "zapfdingbats", "\x33\x48\xac\x80", "\u2713\u2605\u2460\u2768"},
+	{true, "shift_jis-2004", "\x82\xf5\xb6\\\x87\xa0\x87\x9f\x81_~", "か゚ｶ¥𠀋俱\\‾"},
+	{true, "euc-jis-2004", "\xa4\xf7\x8e\xb6\xae\xa2\xae\xa1\\\xab\xc4", "か゚ｶ𠀋俱\\æ̀"},
+	{false, "shift_jis-2004", "\x82\xa9\x80\x81", "か��"},
+	{true, "big5-hkscs", "Hong Kong \xad\xbb\xb4\xe4 \x88\x62\x88\xa5\x88\x66 \x88\x40\x87\x40 \xf9\xf9", "Hong Kong 香港 Ê̄ê̌Ê ㇀䏰 ═"},
+	{false, "big5-hkscs", "\xa2\xa4\x88", "═�"},
+	{true, "cp950", "\xa4\x51 \xa3\xe1 \xf9\xd6 \xa2\xa4\n", "十 € 碁 ═\n"},
+	{false, "cp950", "\xa2\xcc\xf9\xf9\x80", "十═�"},
+	// an ASCII byte after a lead byte is read again.
+	{false, "cp950", "\xa1<p>", "\ufffd<p>"},
+	{false, "big5-hkscs", "\xa1\n\xa4\x51", "\ufffd\n十"},
+	{false, "big5", "\xa1<p>\xa1", "\ufffd<p>\ufffd"},
+	{true, "big5", "Big5 \xa4\xa4\xa4\xe5", "Big5 中文"},
+	{true, "big5", "\xfe\xfe\xc6\xa1\x8e\x40", "\ue310ヾ\ue311"},
+	{true, "cp950", "\xfa\x40\xc6\xa1\x81\x40", "\ue000\uf6b1\ueeb8"},
+	{true, "cp932", "\x82\xa0\xb1\\\xf0\x40\xf9\xfc\x87\x40", "あｱ\\\ue000\ue757①"},
+	{false, "cp932", "\xed\x40\xfa\x5c\x82", "纊纊�"},
+	{true, "shift_jis", "\x88\x9f\\\xf0\x41", "亜¥\ue001"},
+	{true, "euc-jp", "\xb0\xa1\x8e\xb1\xa1\xa1\xf5\xa1\x8f\xfe\xfe", "亜ｱ\u3000\ue000\ue757"},
+	{false, "euc-jp", "\x8f\xb0\xa1\x8e\xe0\xb0", "���"},
+	{true, "jis_x0201", "\\~\xb6\xde!", "¥‾ｶﾞ!"},
+	{false, "jis_x0201", "\x80\xe0", "��"},
+	{true, "jis_c6220-1969-ro", "\\a~", "¥a‾"},
+	{true, "jis_c6220-1969-jp", "\x36\x5e \x21", "ｶﾞ ｡"},
+	{true, "punycode", "3B-ww4c5e180e575a65lsy2b bcher-kva\nihqwcrb4cv8a8dqg056pqjye", "3年B組金八先生 bücher\n他们为什么不说中文"},
+	{false, "punycode", "abc- ab\xc3\xbc", "abc �"},
+	{true, "idna", "mail user@xn--mnchen-3ya.example, see www.xn--bcher-kva.example. bücher xn--bcher-kva", "mail user@münchen.example, see www.bücher.example. bücher xn--bcher-kva"},
+	{false, "idna", "XN--MNCHEN-3YA.de xn--zz!.de", "MüNCHEN.de xn--zz!.de"},
+	{true, "java-escape", `caf\u00e9 \ud83d\ude00 a\\b \u005cu0041 \\u0041`, "café 😀 a\\\\b \\u0041 \\\\u0041"},
+	{false, "java-escape", "\\uuu00e9\xe9 \\u12 \\ud800x", "éé \\u12 �x"},
+	{true, "c-escape", `caf\u00e9 \U0001f600 a\\b \302\205`, "café 😀 a\\b \u0085"},
+	{false, "c-escape", `\x41\101\n\xc3\xa9\q\xff`, "AA\né\\q�"},
+	{true, "percent", "caf%C3%A9 100%25", "café 100%"},
+	{false, "percent", "%e2%82%ac%zz%", "€%zz%"},
+	{true, "html-entities", "caf&#233; &amp; &#128512;", "café & 😀"},
+	{false, "html-entities", "&eacute;&lt;&#x20AC;&copy &bogus; &", "é<€© &bogus; &"},
 }
 
 func TestCharsets(t *testing.T) {
@@ -83,7 +174,353 @@ func (test translateTest) run(t *testing.T) {
 	}
 }
 
-// TODO test big5
+var gsmSeptetTests = []struct {
+	charset string
+	in      string
+	n       int
+	ok      bool
+}{
+	{"gsm0338", "hello", 5, true},
+	{"gsm0338", "€10 [approx]", 15, true},
+	{"gsm0338", "ğ", 0, false},
+	{"gsm0338-tr", "ğ", 1, true},
+	{"gsm0338-tr-packed", "Ğ{", 3, true},
+	{"latin1", "hello", 0, false},
+}
+
+func TestGSMSeptets(t *testing.T) {
+	for _, test := range gsmSeptetTests {
+		n, err := charset.GSMSeptets(test.charset, test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("%s %q: unexpected error status %v", test.charset, test.in, err)
+			continue
+		}
+		if n != test.n {
+			t.Errorf("%s %q: expected %d septets, got %d", test.charset, test.in, test.n, n)
+		}
+	}
+}
+
+var eudcTable = `
+# test table
+0xF040	0x20B9F
+0xF041	0xE000
+`
+
+func TestEUDC(t *testing.T) {
+	table, err := charset.ReadEUDCTable(strings.NewReader(eudcTable))
+	if err != nil {
+		t.Fatalf("cannot read EUDC table: %v", err)
+	}
+	if err := charset.SetEUDC("cp932", table); err != nil {
+		t.Fatalf("cannot set EUDC table: %v", err)
+	}
+	defer charset.SetEUDC("cp932", nil)
+	translateTest{true, "cp932", "\xf0\x40\xf0\x41\xf0\x42", "𠮟\ue000\ue002"}.run(t)
+	tr, err := charset.TranslatorTo("windows-31j")
+	if err != nil {
+		t.Fatalf("cannot make translator: %v", err)
+	}
+	if out, _ := translate(tr, "\ue001"); out != "?" {
+		t.Errorf("overridden code: expected %q, got %q", "?", out)
+	}
+	if err := charset.SetEUDC("latin1", table); err == nil {
+		t.Errorf("expected error setting EUDC table for latin1")
+	}
+}
+
+var widthTests = []struct {
+	charset string
+	in      string
+	out     string
+}{
+	{"shift_jis", "\xb6\xde\xca\xdf\xb3\xde\xb1\xdf\xde\x82\x60\x81\x40\x82\xa9", "ガパヴア゜゛A か"},
+	{"shift_jis", "\xb6\xde\xca\xdf\xb6", "ガパカ"},
+	{"euc-jp", "\x8e\xb6\x8e\xde\xa3\xc1\x8e\xb1", "ガAア"},
+	{"shift_jis-2004", "\xca\xdf\x82\x60", "パA"},
+	{"euc-jis-2004", "\x8e\xca\x8e\xdf\xa3\xc1", "パA"},
+	{"jis_x0201", "\xb6\xde\xca\xdf!", "ガパ!"},
+	{"windows-31j", "\x82\x60\xb1", "Aア"},
+}
+
+func TestWidthFolding(t *testing.T) {
+	for _, test := range widthTests {
+		// sound marks must be combined even when split across reads.
+		r, err := charset.NewReader(test.charset, iotest.OneByteReader(strings.NewReader(test.in)), charset.FoldWidth())
+		if err != nil {
+			t.Errorf("%s: cannot make reader: %v", test.charset, err)
+			continue
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s: translation failed: %v", test.charset, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("%s: expected %q, got %q", test.charset, test.out, out)
+		}
+	}
+}
+
+func TestIDNAEncoding(t *testing.T) {
+	tr, err := charset.TranslatorTo("idna")
+	if err != nil {
+		t.Fatalf("cannot make translator: %v", err)
+	}
+	r := charset.NewTranslatingReader(iotest.OneByteReader(strings.NewReader("www.Bücher.example MÜNCHEN.de Grüße")), tr)
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("translation failed: %v", err)
+	}
+	if want := "www.xn--bcher-kva.example xn--mnchen-3ya.de Grüße"; string(out) != want {
+		t.Errorf("expected %q, got %q", want, out)
+	}
+}
+
+func TestEscapeStreaming(t *testing.T) {
+	for _, name := range []string{"java-escape", "c-escape", "percent", "html-entities"} {
+		const text = `a\b\u0041 & % é😀 &amp; %41 \\é`
+		tr, err := charset.TranslatorTo(name)
+		if err != nil {
+			t.Fatalf("cannot make translator: %v", err)
+		}
+		r := charset.NewTranslatingReader(iotest.OneByteReader(strings.NewReader(text)), tr)
+		tr, err = charset.TranslatorFrom(name)
+		if err != nil {
+			t.Fatalf("cannot make translator: %v", err)
+		}
+		out, err := ioutil.ReadAll(charset.NewTranslatingReader(iotest.OneByteReader(r), tr))
+		if err != nil {
+			t.Fatalf("%s: translation failed: %v", name, err)
+		}
+		if string(out) != text {
+			t.Errorf("%s: round trip failed: got %q", name, out)
+		}
+	}
+}
+
+var normalizeTests = []struct {
+	form    charset.Form
+	in, out string
+}{
+	{charset.NFC, "e\u0301a\u0323\u0302", "éậ"},
+	{charset.NFD, "éậ", "e\u0301a\u0323\u0302"},
+	{charset.NFC, "\u1100\u1161\u11a8", "각"},
+	{charset.NFD, "각", "\u1100\u1161\u11a8"},
+	{charset.NFKC, "ｶﾞ ﬁ ①", "ガ fi 1"},
+	{charset.NFKD, "ǆ \u0385", "dz\u030c  \u0308\u0301"},
+}
+
+func TestNormalize(t *testing.T) {
+	for _, test := range normalizeTests {
+		// the text must be normalized correctly however it is split.
+		r, err := charset.NewReader("utf-8", iotest.OneByteReader(strings.NewReader(test.in)), charset.Normalize(test.form))
+		if err != nil {
+			t.Fatalf("cannot make reader: %v", err)
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("translation failed: %v", err)
+		}
+		if string(out) != test.out {
+			t.Errorf("form %d, reading %+q: expected %+q, got %+q", test.form, test.in, test.out, out)
+		}
+
+		var buf bytes.Buffer
+		w, err := charset.NewWriter("utf-8", OneByteWriter(&buf), charset.Normalize(test.form))
+		if err != nil {
+			t.Fatalf("cannot make writer: %v", err)
+		}
+		for i := 0; i < len(test.in); i++ {
+			w.Write([]byte{test.in[i]})
+		}
+		w.Close()
+		if buf.String() != test.out {
+			t.Errorf("form %d, writing %+q: expected %+q, got %+q", test.form, test.in, test.out, buf.String())
+		}
+	}
+}
+
+func TestEncodeComposed(t *testing.T) {
+	for _, test := range []struct {
+		charset, in, out string
+	}{
+		{"latin1", "e\u0301A\u030a\u0323", "\xe9A??"},
+		{"shift_jis", "か\u3099", "\x82\xaa"},
+		{"latin1", "\u212b", "\xc5"},
+	} {
+		tr, err := charset.TranslatorTo(test.charset)
+		if err != nil {
+			t.Fatalf("cannot make translator: %v", err)
+		}
+		r := charset.NewTranslatingReader(iotest.OneByteReader(strings.NewReader(test.in)), tr)
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("translation failed: %v", err)
+		}
+		if string(out) != test.out {
+			t.Errorf("%s %+q: expected %q, got %q", test.charset, test.in, test.out, out)
+		}
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	for _, test := range []struct {
+		charset, in, out string
+	}{
+		{"us-ascii//TRANSLIT", "Ångström — “quoted”", `Angstrom - "quoted"`},
+		{"us-ascii//translit", "Œuvre… ½ ﬁn Straße", "OEuvre... 1/2 fin Strasse"},
+		{"us-ascii", "Ångström", "?ngstr?m"},
+		{"latin1//TRANSLIT", "Œuvre… Straße Łódź", "OEuvre... Stra\xdfe L\xf3dz"},
+		{"latin1//TRANSLIT", "x\u0301 中", "x ?"},
+	} {
+		tr, err := charset.TranslatorTo(test.charset)
+		if err != nil {
+			t.Fatalf("cannot make translator: %v", err)
+		}
+		r := charset.NewTranslatingReader(iotest.OneByteReader(strings.NewReader(test.in)), tr)
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("translation failed: %v", err)
+		}
+		if string(out) != test.out {
+			t.Errorf("%s %q: expected %q, got %q", test.charset, test.in, test.out, out)
+		}
+	}
+	var buf bytes.Buffer
+	w, err := charset.NewWriter("windows-1252", &buf, charset.Transliterate())
+	if err != nil {
+		t.Fatalf("cannot make writer: %v", err)
+	}
+	w.Write([]byte("“ok” → Ω"))
+	w.Close()
+	if want := "\x93ok\x94 ? ?"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+var romanizeTests = []struct {
+	charset string
+	schemes []string
+	in, out string
+}{
+	{"latin1", []string{"iso9"}, "Щука ЩУКА Ёлка Київ", "Suka SUKA \xcblka Ki\xefv"},
+	{"windows-1252", []string{"iso9"}, "Жёлтый", "\x8e\xebltyj"},
+	{"us-ascii", []string{"icao"}, "Юрий Гагарин, Щедрин Лев Ильич", "Iurii Gagarin, Shchedrin Lev Ilich"},
+	{"us-ascii", []string{"elot743"}, "Αθήνα ευχαριστώ αυτός Μπάμπης Ντίνος ΕΥΑΓΓΕΛΟΣ", "Athina efcharisto aftos Bambis Dinos EVANGELOS"},
+	{"us-ascii", []string{"hepburn"}, "とうきょう コーヒー まっちゃ しんぶん せんえん ガッコウ", "tokyo kohi matcha shinbun sen'en gakko"},
+	{"iso-8859-4", []string{"hepburn"}, "とうきょう おおさか きょうと すうがく おかあさん ねえさん おにいさん せんせい", "t\xf2ky\xf2 \xf2saka ky\xf2to s\xfegaku ok\xe0san n\xbasan oniisan sensei"},
+	{"iso-8859-4", []string{"hepburn"}, "おもう いう くう ゆうがた ちゅう", "omou iu kuu y\xfegata ch\xfe"},
+	{"us-ascii", []string{"rr"}, "한국어 서울 울릉도 안녕하세요", "hangugeo seoul ulleungdo annyeonghaseyo"},
+	{"us-ascii", []string{"rr"}, "신라 종로 백마 독립 같이 별내 왕십리 해돋이 굳히다", "silla jongno baengma dongnip gachi byeollae wangsimni haedoji guchida"},
+	{"us-ascii", []string{"rr"}, "좋고 놓다 낳지 않고 놓는 끓는 묵호", "joko nota nachi anko nonneun kkeulleun mukho"},
+	{"us-ascii", []string{"icao", "hepburn"}, "Москва 東京 とうきょう", "Moskva ?? tokyo"},
+}
+
+func TestRomanize(t *testing.T) {
+	for _, test := range romanizeTests {
+		tr, err := charset.TranslatorTo(test.charset, charset.Romanize(test.schemes...))
+		if err != nil {
+			t.Fatalf("cannot make translator: %v", err)
+		}
+		r := charset.NewTranslatingReader(iotest.OneByteReader(strings.NewReader(test.in)), tr)
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("translation failed: %v", err)
+		}
+		if string(out) != test.out {
+			t.Errorf("%s %v %q: expected %q, got %q", test.charset, test.schemes, test.in, test.out, out)
+		}
+	}
+	if _, err := charset.TranslatorTo("us-ascii", charset.Romanize("klingon")); err == nil {
+		t.Errorf("expected error for unknown romanization scheme")
+	}
+}
+
+const bestFit1252 = `CODEPAGE 1252
+CPINFO 1 0x3f 0x003f
+MBTABLE 3 ; a few entries only
+0x38 0x0038
+0x41 0x0041
+0x80 0x20ac
+WCTABLE 4
+0x0038 0x38
+0x0041 0x41
+0x221e 0x38 ; infinity
+0xff21 0x41 ; fullwidth A
+ENDCODEPAGE
+`
+
+const bestFit932 = `CODEPAGE 932
+CPINFO 2 0x3f 0x30fb
+MBTABLE 1
+0x5c 0x005c
+DBCSRANGE 1
+0x81 0x82
+DBCSTABLE 1 ;LeadByte = 0x81
+0x40 0x3000
+DBCSTABLE 1 ;LeadByte = 0x82
+0x60 0xff21
+WCTABLE 4
+0x005c 0x5c
+0x00a5 0x5c
+0x3000 0x8140
+0xff21 0x8260
+ENDCODEPAGE
+`
+
+func TestBestFit(t *testing.T) {
+	for _, test := range []struct {
+		charset, table, in string
+		out                [2]string // without and with best fit.
+	}{
+		{"windows-1252", bestFit1252, "Ａ∞€", [2]string{"??\x80", "A8\x80"}},
+		{"windows-31j", bestFit932, "¥　", [2]string{"?\x81\x40", "\\\x81\x40"}},
+	} {
+		bf, err := charset.ReadBestFit(strings.NewReader(test.table))
+		if err != nil {
+			t.Fatalf("cannot read best fit table: %v", err)
+		}
+		if err := charset.SetBestFit(test.charset, bf); err != nil {
+			t.Fatalf("cannot set best fit table: %v", err)
+		}
+		for i, opts := range [][]charset.Option{nil, {charset.BestFit()}} {
+			tr, err := charset.TranslatorTo(test.charset, opts...)
+			if err != nil {
+				t.Fatalf("cannot make translator: %v", err)
+			}
+			_, out, _ := tr.Translate([]byte(test.in), true)
+			if string(out) != test.out[i] {
+				t.Errorf("%s, option %d: expected %q, got %q", test.charset, i, test.out[i], out)
+			}
+		}
+		charset.SetBestFit(test.charset, nil)
+	}
+	if err := charset.SetBestFit("utf-8", nil); err == nil {
+		t.Errorf("expected error setting best fit table for utf-8")
+	}
+}
+
+var mojibakeTests = []struct {
+	in, out string
+}{
+	{"CafÃ©", "Café"},
+	{"itâ€™s", "it’s"},
+	{"Ã¢â‚¬â„¢ twice", "’ twice"},
+	{"Ã\u0083Â© latin1 twice", "é latin1 twice"},
+	{"mixed Café and CafÃ©, 日本", "mixed Café and Café, 日本"},
+	{"Ãœber", "Über"},
+	{"naïve café", "naïve café"},
+	{"ÄÖÜ", "ÄÖÜ"},
+}
+
+func TestFixMojibake(t *testing.T) {
+	for _, test := range mojibakeTests {
+		if out := charset.FixMojibake(test.in); out != test.out {
+			t.Errorf("%q: expected %q, got %q", test.in, test.out, out)
+		}
+	}
+}
 
 var testReaders = []func(io.Reader) io.Reader{
 	func(r io.Reader) io.Reader { return r },
@@ -102,7 +539,534 @@ var testTranslators = []func() charset.Translator{
 	func() charset.Translator { return new(shortTranslator) },
 }
 
-var codepageCharsets = []string{"latin1"}
+var codepageCharsets = []string{"latin1", "kz-1048", "ptcp154", "cp1125", "georgian-ps", "georgian-academy"}
+
+var autoBOMTests = []struct {
+	in  string
+	out string
+}{
+	{"\xef\xbb\xbfcafé", "café"},
+	{"\xff\xfec\x00\xe9\x00", "cé"},
+	{"\xfe\xff\x00c\x00\xe9", "cé"},
+	{"\xff\xfe\x00\x00c\x00\x00\x00", "c"},
+	{"\x00\x00\xfe\xff\x00\x00\x00c", "c"},
+	{"caf\xe9", "café"},
+	{"\xff", "ÿ"},
+	{"", ""},
+}
+
+func TestAutoBOM(t *testing.T) {
+	for _, test := range autoBOMTests {
+		r, err := charset.NewReaderAutoBOM("latin1", iotest.OneByteReader(strings.NewReader(test.in)))
+		if err != nil {
+			t.Fatalf("error creating reader: %v", err)
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("%q: error reading: %v", test.in, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("%q: expected %q, got %q", test.in, test.out, out)
+		}
+	}
+}
+
+var detectTests = []struct {
+	charset string // charset the text is encoded in.
+	text    string
+	want    string // expected first candidate.
+}{
+	{"windows-1252", "Le café est très chaud, n’est-ce pas ?", "windows-1252"},
+	{"iso-8859-2", "Zażółć gęślą jaźń. Wszystko się zmieniło.", "iso-8859-2"},
+	{"windows-1250", "Zażółć gęślą jaźń. Wszystko się zmieniło.", "windows-1250"},
+	{"windows-1251", "Съешь же ещё этих мягких французских булок.", "windows-1251"},
+	{"koi8-r", "Съешь же ещё этих мягких французских булок.", "koi8-r"},
+	{"iso-8859-5", "Съешь же ещё этих мягких французских булок.", "iso-8859-5"},
+	{"iso-8859-7", "Η γρήγορη καφέ αλεπού πηδάει πάνω από το σκυλί.", "iso-8859-7"},
+	{"iso-8859-9", "Pijamalı hasta yağız şoföre çabucak güvendi.", "iso-8859-9"},
+	// character sets without languages in the detector's list.
+	{"iso-8859-3", "Pijamalı hasta yağız şoföre çabucak güvendi.", "iso-8859-3"},
+	{"ibm850", "Søren spiser rødgrød med fløde på gården.", "ibm850"},
+	{"shift_jis", "これは日本語の文章です。漢字とひらがなを使っています。", "shift_jis"},
+	{"euc-jp", "これは日本語の文章です。漢字とひらがなを使っています。", "euc-jp"},
+	{"big5", "這是一個中文句子，我們用它來測試字符集的檢測。", "big5"},
+	{"utf-8", "Le café est très chaud. これは日本語です。", "utf-8"},
+	// text with escapes is not taken as an escape pseudo-charset.
+	{"utf-8", "Größe &amp; Maß", "utf-8"},
+	{"utf-8", "100% sûr, 50%20 café", "utf-8"},
+	{"utf-8", `C:\u0041 naïve \x41`, "utf-8"},
+	{"utf-8", "Příliš žluťoučký kůň úpěl ďábelské ódy.", "utf-8"},
+	{"utf-16le", "Hello, world.", "utf-16le"},
+	{"utf-16", "Hello, world.", "utf-16"},
+	{"utf-8-sig", "café", "utf-8-sig"},
+	{"utf-8", "Hello, world.", "us-ascii"},
+}
+
+func TestDetect(t *testing.T) {
+	for _, test := range detectTests {
+		var buf bytes.Buffer
+		w, err := charset.NewWriter(test.charset, &buf)
+		if err != nil {
+			t.Fatalf("cannot make writer for %q: %v", test.charset, err)
+		}
+		fmt.Fprint(w, test.text)
+		w.Close()
+		cands := charset.Detect(buf.Bytes())
+		if len(cands) == 0 || cands[0].Charset != test.want {
+			t.Errorf("%s %q: expected %s, got %v", test.charset, test.text, test.want, cands)
+			continue
+		}
+		// the result must not depend on how the text is split.
+		d := charset.NewDetector()
+		io.Copy(d, iotest.OneByteReader(&buf))
+		if c := d.Candidates(); len(c) != len(cands) || c[0] != cands[0] {
+			t.Errorf("%s %q: streaming detector gave %v, expected %v", test.charset, test.text, c, cands)
+		}
+	}
+	if c := charset.Detect(nil); c != nil {
+		t.Errorf("expected no candidates for empty text, got %v", c)
+	}
+}
+
+var xmlTests = []struct {
+	in      string
+	charset string
+	out     string
+}{
+	{"<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?><a>caf\xe9</a>", "ISO-8859-1", "<?xml version=\"1.0\" encoding=\"UTF-8\"?><a>café</a>"},
+	{"<?xml version='1.0' encoding='koi8-r' standalone='yes'?><a>\xf0</a>", "koi8-r", "<?xml version='1.0' encoding='UTF-8' standalone='yes'?><a>П</a>"},
+	{"<?xml version=\"1.0\"?><a>é</a>", "utf-8", "<?xml version=\"1.0\"?><a>é</a>"},
+	{"<a>é</a>", "utf-8", "<a>é</a>"},
+	{"\xef\xbb\xbf<a>é</a>", "utf-8-sig", "<a>é</a>"},
+	{"<\x00?\x00x\x00m\x00l\x00 \x00e\x00n\x00c\x00o\x00d\x00i\x00n\x00g\x00=\x00'\x00U\x00T\x00F\x00-\x001\x006\x00'\x00?\x00>\x00", "utf-16le", "<?xml encoding='UTF-8'?>"},
+	{"\xfe\xff\x00<\x00a\x00>", "utf-16", "<a>"},
+	{"\x00\x00\x00<\x00\x00\x00?\x00\x00\x00x\x00\x00\x00m\x00\x00\x00l\x00\x00\x00?\x00\x00\x00>", "utf-32be", "<?xml?>"},
+}
+
+func TestXMLReader(t *testing.T) {
+	for i, test := range xmlTests {
+		r, cs, err := charset.NewXMLReader(iotest.OneByteReader(strings.NewReader(test.in)))
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if cs != test.charset {
+			t.Errorf("test %d: expected charset %q, got %q", i, test.charset, cs)
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("test %d: error reading: %v", i, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("test %d: expected %q, got %q", i, test.out, out)
+		}
+	}
+	if _, _, err := charset.NewXMLReader(strings.NewReader("\x4c\x6f\xa7\x94\x6f\x6e")); err == nil {
+		t.Errorf("expected error for EBCDIC document without encoding declaration")
+	}
+}
+
+func TestXMLCharsetReader(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := charset.NewXMLWriter("windows-1252", &buf)
+	if err != nil {
+		t.Fatalf("cannot make writer: %v", err)
+	}
+	fmt.Fprint(w, "<a>“Café”</a>")
+	w.Close()
+	if want := "<?xml version=\"1.0\" encoding=\"WINDOWS-1252\"?>\n<a>\x93Caf\xe9\x94</a>"; buf.String() != want {
+		t.Fatalf("expected %q, got %q", want, buf.String())
+	}
+	d := xml.NewDecoder(&buf)
+	d.CharsetReader = charset.XMLCharsetReader
+	var v struct {
+		A string `xml:",chardata"`
+	}
+	if err := d.Decode(&v); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if v.A != "“Café”" {
+		t.Errorf("expected %q, got %q", "“Café”", v.A)
+	}
+}
+
+var whatwgNameTests = []struct {
+	label string
+	name  string
+}{
+	{"latin1", "windows-1252"},
+	{"ISO-8859-1", "windows-1252"},
+	{" ascii\n", "windows-1252"},
+	{"UTF8", "UTF-8"},
+	{"utf-16", "UTF-16LE"},
+	{"unicodeFFFE", "UTF-16BE"},
+	{"iso-8859-9", "windows-1254"},
+	{"tis-620", "windows-874"},
+	{"gb2312", "GBK"},
+	{"x-mac-ukrainian", "x-mac-cyrillic"},
+	{"logical", "ISO-8859-8-I"},
+	{"ms932", "Shift_JIS"},
+	{"iso-2022-kr", "replacement"},
+	{"hz-gb-2312", "replacement"},
+	{"x-user-defined", "x-user-defined"},
+	{"utf-7", ""},
+	{"iso-8859-1 x", ""},
+	{"", ""},
+}
+
+func TestWHATWGName(t *testing.T) {
+	for _, test := range whatwgNameTests {
+		name, ok := charset.WHATWGName(test.label)
+		if name != test.name || ok != (test.name != "") {
+			t.Errorf("label %q: expected %q, got %q, %v", test.label, test.name, name, ok)
+		}
+	}
+}
+
+// whatwgTests follow the decoders of the WHATWG Encoding Standard,
+// in particular the U+FFFD characters that replace errors, and the
+// bytes that are read again after them.
+var whatwgTests = []struct {
+	label string
+	in    string
+	out   string
+}{
+	{"utf-8", "a\xe2\x82\xacb", "a€b"},
+	{"utf-8", "\xe2\x82A", "�A"},
+	{"utf-8", "\xe2\x82", "�"},
+	{"utf-8", "\xf0\x80\x80", "���"},
+	{"utf-8", "\xed\xa0\x80", "���"},
+	{"utf-8", "\xc0\xaf", "��"},
+	{"utf-8", "\xf4\x90\x80\x80", "����"},
+	{"utf-8", "\xef\xbb\xbfa", "\ufeffa"},
+	{"utf-16le", "A\x00=\xd8\x00\xde", "A😀"},
+	{"utf-16le", "\x00\xd8A\x00", "�A"},
+	{"utf-16le", "\x00\xdcA\x00B", "�A�"},
+	{"utf-16be", "\xd8=\xde\x00", "😀"},
+	{"utf-16be", "\xd8=", "�"},
+	{"iso-8859-1", "caf\xe9 \x80\x81\x9f", "café €\u0081Ÿ"},
+	{"windows-1253", "\xe1\xaa", "α�"},
+	{"windows-1255", "\xca", "ֺ"},
+	{"windows-874", "\x81\xa1", "\u0081ก"},
+	{"iso-8859-3", "\xa5", "�"},
+	{"iso-8859-7", "\xa4", "€"},
+	{"koi8-u", "\xae\xbe", "ўЎ"},
+	{"x-mac-cyrillic", "\xa2\xff", "Ґ€"},
+	{"x-user-defined", "a\x80\xff", "a\uf780\uf7ff"},
+	{"iso-2022-kr", "\x1b$)C\x0e!!", "�"},
+	{"replacement", "", ""},
+	{"shift_jis", "\x82\xa0\xb1\x5c\x7e", "あｱ\\~"},
+	{"shift_jis", "\x82A\x80\xa0", "�A\u0080�"},
+	{"shift_jis", "\xf0\x40\x87\x40", "\ue000①"},
+	{"shift_jis", "\x82", "�"},
+	{"euc-jp", "\xa4\xa2\x8e\xb1\x8f\xb0\xa1", "あｱ丂"},
+	{"euc-jp", "\xa4A\x8e\xe0", "�A�"},
+	{"iso-2022-jp", "a\x1b$B$\"\x1b(Bb", "aあb"},
+	{"iso-2022-jp", "\x1b(J\\~\x1b(I1", "¥‾ｱ"},
+	{"iso-2022-jp", "\x1b$B\x1b(Ba", "�a"},
+	{"iso-2022-jp", "\x1b(Xa", "�(Xa"},
+	{"iso-2022-jp", "\x1b$B$", "�"},
+	{"iso-2022-jp", "a\x1b", "a�"},
+	{"gbk", "\xd6\xd0\x80", "中€"},
+	{"gb18030", "\x81\x30\x81\x30\x84\x31\xa4\x39", "\u0080\uffff"},
+	{"gb18030", "\x90\x30\x81\x30\xe3\x32\x9a\x35", "\U00010000\U0010ffff"},
+	{"gb18030", "\xa8\xbc\x81\x35\xf4\x37\xa3\xa0", "ḿ\ue7c7　"},
+	{"gb18030", "\x81\x30\x81A", "�0丄"},
+	{"gb18030", "\x84\x31\xa5\x30", "�"},
+	// GB18030-2022 mappings, and a user-defined area.
+	{"gb18030", "\xa6\xd9\xa6\xda\xfe\x59\xfe\xa0", "\ufe10\ufe12\u9fb4\u9fbb"},
+	{"gb18030", "\xaa\xa1\xa1\x40", "\ue000\ue4c6"},
+	{"big5", "\xa4\x40\xa3\xe1", "一€"},
+	{"big5", "\x88\x62\x88\x64", "Ê̄Ê̌"},
+	{"big5", "\x81A\xa4", "�A�"},
+	{"big5", "\x87\x40\x87\x41", "\u43f0\u4c32"},
+	{"euc-kr", "\xb0\xa1\x81\x41", "가갂"},
+	{"euc-kr", "\xffA\xb0", "�A�"},
+}
+
+func TestWHATWG(t *testing.T) {
+	for _, test := range whatwgTests {
+		for _, rd := range []func(io.Reader) io.Reader{nil, iotest.OneByteReader} {
+			tr, err := charset.WHATWG(test.label)
+			if err != nil {
+				t.Errorf("%s: %v", test.label, err)
+				break
+			}
+			var r io.Reader = strings.NewReader(test.in)
+			if rd != nil {
+				r = rd(r)
+			}
+			out, err := ioutil.ReadAll(charset.NewTranslatingReader(r, tr))
+			if err != nil {
+				t.Errorf("%s %q: error reading: %v", test.label, test.in, err)
+				continue
+			}
+			if string(out) != test.out {
+				t.Errorf("%s %q: expected %q, got %q", test.label, test.in, test.out, out)
+			}
+		}
+	}
+	if _, err := charset.WHATWG("utf-7"); err == nil {
+		t.Errorf("expected error for unknown label")
+	}
+}
+
+// The testdata/whatwg-*.txt files hold the output of the
+// TextDecoder of a browser for each label and for a range of
+// input in each encoding (see data/generate-whatwg-tests.go),
+// so that the decoders are checked against an implementation
+// that does not read the whatwg-* data files.
+
+// readWHATWGTestData calls f with the tab-separated fields of
+// each line of the test file with the given name.
+func readWHATWGTestData(t *testing.T, name string, f func(fields []string)) {
+	data, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("cannot read test data: %v", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f(strings.Split(line, "\t"))
+	}
+}
+
+func TestWHATWGLabels(t *testing.T) {
+	readWHATWGTestData(t, "whatwg-labels.txt", func(f []string) {
+		name, ok := charset.WHATWGName(f[0])
+		if f[1] == "-" {
+			// the browser has no decoder for the replacement
+			// encoding, nor for labels that it does not know.
+			if ok && name != "replacement" {
+				t.Errorf("label %q: expected replacement or no encoding, got %q", f[0], name)
+			}
+			return
+		}
+		if strings.ToLower(name) != f[1] {
+			t.Errorf("label %q: expected %q, got %q, %v", f[0], f[1], name, ok)
+		}
+	})
+}
+
+func TestWHATWGSingleByte(t *testing.T) {
+	in := make([]byte, 0x80)
+	for i := range in {
+		in[i] = byte(0x80 + i)
+	}
+	readWHATWGTestData(t, "whatwg-single-byte.txt", func(f []string) {
+		tr, err := charset.WHATWG(f[0])
+		if err != nil {
+			t.Errorf("%s: %v", f[0], err)
+			return
+		}
+		_, out, err := tr.Translate(in, true)
+		if err != nil || string(out) != f[1] {
+			t.Errorf("%s: expected %q, got %q, %v", f[0], f[1], out, err)
+		}
+	})
+}
+
+// TestWHATWGMultiByte decodes each prefix in the test files
+// followed by each byte from 0x30 to 0xff.
+func TestWHATWGMultiByte(t *testing.T) {
+	for _, label := range []string{"big5", "euc-jp", "euc-kr", "gb18030", "shift_jis"} {
+		errors := 0
+		readWHATWGTestData(t, "whatwg-"+label+".txt", func(f []string) {
+			prefix, err := hex.DecodeString(f[0])
+			want := strings.Split(f[1], " ")
+			if err != nil || len(want) != 0x100-0x30 {
+				t.Fatalf("%s: bad test data line for %s", label, f[0])
+			}
+			for i, w := range want {
+				if errors > 10 {
+					return
+				}
+				if label == "big5" && w == "\ufffd\ufffd" && 0x30+i >= 0x80 {
+					// Chrome reads a non-ASCII byte again after a lead
+					// byte that it cannot decode with, where the standard
+					// consumes it with the lead.
+					continue
+				}
+				tr, err := charset.WHATWG(label)
+				if err != nil {
+					t.Fatalf("%s: %v", label, err)
+				}
+				in := append(append([]byte(nil), prefix...), byte(0x30+i))
+				_, out, err := tr.Translate(in, true)
+				if err != nil || string(out) != w {
+					t.Errorf("%s %q: expected %q, got %q, %v", label, in, w, out, err)
+					errors++
+				}
+			}
+		})
+	}
+}
+
+func TestWHATWGVectors(t *testing.T) {
+	readWHATWGTestData(t, "whatwg-vectors.txt", func(f []string) {
+		in, err := hex.DecodeString(f[1])
+		if err != nil {
+			t.Fatalf("bad test data input %q", f[1])
+		}
+		want, err := hex.DecodeString(f[2])
+		if err != nil {
+			t.Fatalf("bad test data output %q", f[2])
+		}
+		tr, err := charset.WHATWG(f[0])
+		if err != nil {
+			t.Errorf("%s: %v", f[0], err)
+			return
+		}
+		out, err := ioutil.ReadAll(charset.NewTranslatingReader(iotest.OneByteReader(bytes.NewReader(in)), tr))
+		if err != nil || !bytes.Equal(out, want) {
+			t.Errorf("%s %q: expected %q, got %q, %v", f[0], in, want, out, err)
+		}
+	})
+}
+
+func TestWHATWGReader(t *testing.T) {
+	for _, test := range []struct {
+		label string
+		in    string
+		out   string
+	}{
+		{"latin1", "caf\xe9", "café"},
+		{"latin1", "\xef\xbb\xbfcaf\xc3\xa9", "café"},
+		{"utf-8", "\xff\xfeA\x00", "A"},
+		{"utf-8", "\xef\xbb\xbf\xef\xbb\xbfa", "\ufeffa"},
+	} {
+		r, err := charset.NewWHATWGReader(test.label, iotest.OneByteReader(strings.NewReader(test.in)))
+		if err != nil {
+			t.Errorf("%s: %v", test.label, err)
+			continue
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s %q: error reading: %v", test.label, test.in, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("%s %q: expected %q, got %q", test.label, test.in, test.out, out)
+		}
+	}
+}
+
+var htmlTests = []struct {
+	contentType string
+	in          string
+	charset     string
+	rule        charset.HTMLRule
+	out         string
+}{
+	{"text/html; charset=ISO-8859-1", "<p>caf\xe9 \x80", "windows-1252", charset.HTMLRuleContentType, "<p>café €"},
+	{"text/html; charset=utf-8", "\xfe\xff\x00a", "UTF-16BE", charset.HTMLRuleBOM, "a"},
+	{"", "<meta charset=\"shift_jis\"><p>\x82\xa0", "Shift_JIS", charset.HTMLRuleMeta, "<meta charset=\"shift_jis\"><p>あ"},
+	{"", "<meta http-equiv=Content-Type content='text/html; charset=koi8-r'>\xf0", "KOI8-R", charset.HTMLRuleMeta, "<meta http-equiv=Content-Type content='text/html; charset=koi8-r'>П"},
+	{"", "<meta content='text/html; charset=koi8-r'>", "windows-1252", charset.HTMLRuleFallback, "<meta content='text/html; charset=koi8-r'>"},
+	{"", "<meta charset=utf-16><p>é", "UTF-8", charset.HTMLRuleMeta, "<meta charset=utf-16><p>é"},
+	{"", "<meta charset=x-user-defined><p>\xe9", "windows-1252", charset.HTMLRuleMeta, "<meta charset=x-user-defined><p>é"},
+	{"", "<!-- <meta charset=big5> --><p>\xe9", "windows-1252", charset.HTMLRuleFallback, "<!-- <meta charset=big5> --><p>é"},
+	{"text/html; charset=iso-2022-kr", "<p>a", "replacement", charset.HTMLRuleContentType, "�"},
+	// the first known character set in a <meta> element is kept.
+	{"", "<meta http-equiv=content-type content=\"text/html; charset=koi8-r\" charset=\"utf-8\">\xf0", "KOI8-R", charset.HTMLRuleMeta, "<meta http-equiv=content-type content=\"text/html; charset=koi8-r\" charset=\"utf-8\">П"},
+	{"", "<meta charset=\"bogus\" content=\"text/html; charset=koi8-r\" http-equiv=content-type>\xf0", "KOI8-R", charset.HTMLRuleMeta, "<meta charset=\"bogus\" content=\"text/html; charset=koi8-r\" http-equiv=content-type>П"},
+	{"", "<meta charset=bogus><meta charset=koi8-r>\xf0", "KOI8-R", charset.HTMLRuleMeta, "<meta charset=bogus><meta charset=koi8-r>П"},
+	{"", "<meta charset=koi8-r charset=utf-8>\xf0", "KOI8-R", charset.HTMLRuleMeta, "<meta charset=koi8-r charset=utf-8>П"},
+}
+
+func TestHTMLReader(t *testing.T) {
+	for i, test := range htmlTests {
+		r, e, err := charset.NewHTMLReader(iotest.OneByteReader(strings.NewReader(test.in)), test.contentType)
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if e.Charset != test.charset || e.Rule != test.rule {
+			t.Errorf("test %d: expected %s by %v, got %s by %v", i, test.charset, test.rule, e.Charset, e.Rule)
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("test %d: error reading: %v", i, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("test %d: expected %q, got %q", i, test.out, out)
+		}
+	}
+}
+
+var registryTests = []struct {
+	by       string
+	n        int
+	name     string
+	mimeName string
+	mib      int
+	codePage int
+	ccsid    int
+}{
+	{"mib", 2252, "windows-1252", "windows-1252", 2252, 1252, 1252},
+	{"mib", 4, "iso-8859-1", "ISO-8859-1", 4, 28591, 819},
+	{"mib", 106, "utf-8", "UTF-8", 106, 65001, 1208},
+	{"mib", 2024, "windows-31j", "Windows-31J", 2024, 932, 943},
+	{"mib", 9999, "", "", 0, 0, 0},
+	{"codepage", 1252, "windows-1252", "windows-1252", 2252, 1252, 1252},
+	{"codepage", 932, "windows-31j", "Windows-31J", 2024, 932, 943},
+	{"codepage", 65001, "utf-8", "UTF-8", 106, 65001, 1208},
+	{"codepage", 0, "", "", 0, 0, 0},
+	{"ccsid", 819, "iso-8859-1", "ISO-8859-1", 4, 28591, 819},
+	{"ccsid", 1208, "utf-8", "UTF-8", 106, 65001, 1208},
+}
+
+func TestRegistry(t *testing.T) {
+	for _, test := range registryTests {
+		var cs *charset.Charset
+		switch test.by {
+		case "mib":
+			cs = charset.ByMIB(test.n)
+		case "codepage":
+			cs = charset.ByCodePage(test.n)
+		case "ccsid":
+			cs = charset.ByCCSID(test.n)
+		}
+		if test.name == "" {
+			if cs != nil {
+				t.Errorf("%s %d: expected nil, got %q", test.by, test.n, cs.Name)
+			}
+			continue
+		}
+		if cs == nil {
+			t.Errorf("%s %d: expected %q, got nil", test.by, test.n, test.name)
+			continue
+		}
+		if cs.Name != test.name || cs.MIMEName != test.mimeName || cs.MIB != test.mib || cs.CodePage != test.codePage || cs.CCSID != test.ccsid {
+			t.Errorf("%s %d: expected %q %q %d %d %d, got %q %q %d %d %d", test.by, test.n,
+				test.name, test.mimeName, test.mib, test.codePage, test.ccsid,
+				cs.Name, cs.MIMEName, cs.MIB, cs.CodePage, cs.CCSID)
+		}
+	}
+}
+
+func TestRegistryAliases(t *testing.T) {
+	if cs := charset.Info("latin1"); cs == nil || cs.MIB != 4 {
+		t.Errorf("expected latin1 to have MIBenum 4, got %+v", cs)
+	}
+	// csISOLatin1 is only known to the registry.
+	cs := charset.Info("csISOLatin1")
+	if cs == nil || cs.Name != "iso-8859-1" {
+		t.Fatalf("expected iso-8859-1 for csISOLatin1, got %+v", cs)
+	}
+	r, err := charset.NewReader("csISOLatin1", iotest.OneByteReader(strings.NewReader("caf\xe9")))
+	if err != nil {
+		t.Fatalf("cannot make reader: %v", err)
+	}
+	out, err := ioutil.ReadAll(r)
+	if err != nil || string(out) != "café" {
+		t.Errorf("expected %q, got %q (error %v)", "café", out, err)
+	}
+}
 
 func TestCodepages(t *testing.T) {
 	for _, name := range codepageCharsets {
@@ -228,6 +1192,37 @@ func checkTranslation(in, out []byte) error {
 	return nil
 }
 
+var encodeBenchmarks = []struct {
+	charset string
+	opts    []charset.Option
+}{
+	{"latin1", nil},
+	{"windows-1252", nil},
+	{"us-ascii", nil},
+	{"us-ascii", []charset.Option{charset.Transliterate()}},
+}
+
+func BenchmarkEncode(b *testing.B) {
+	text := []byte(strings.Repeat("Le café est très chaud, n’est-ce pas ? Voilà l’été. ", 20000))
+	for _, bm := range encodeBenchmarks {
+		name := bm.charset
+		if bm.opts != nil {
+			name += "-translit"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				w, err := charset.NewWriter(bm.charset, ioutil.Discard, bm.opts...)
+				if err != nil {
+					b.Fatal(err)
+				}
+				w.Write(text)
+				w.Close()
+			}
+		})
+	}
+}
+
 // holdingTranslator holds its input until the end.
 type holdingTranslator struct {
 	scratch []byte
@@ -41,6 +41,7 @@ type toCodePageInfo struct {
 
 type translateToCodePage struct {
 	toCodePageInfo
+	bestFits
 	scratch []byte
 }
 
@@ -74,6 +75,11 @@ func (p *translateToCodePage) Translate(data []byte, eof bool) (int, []byte, err
 	return len(data), buf, nil
 }
 
+func (p *translateToCodePage) encodes(r rune) bool {
+	_, ok := p.rune2byte[r]
+	return r < p.same || ok
+}
+
 func fromCodePage(arg string) (Translator, error) {
 	runes, err := cache(cpKeyFrom(arg), func() (interface{}, error) {
 		data, err := readFile(arg)
@@ -129,5 +135,8 @@ func toCodePage(arg string) (Translator, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &translateToCodePage{toCodePageInfo: m.(toCodePageInfo)}, nil
+	return &translateToCodePage{
+		toCodePageInfo: m.(toCodePageInfo),
+		bestFits:       getBestFits("cp", arg),
+	}, nil
 }
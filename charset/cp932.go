@@ -6,7 +6,7 @@ import (
 )
 
 func init() {
-	registerClass("cp932", fromCP932, nil)
+	registerClass("cp932", fromCP932, toCP932)
 }
 
 // encoding details
@@ -47,7 +47,7 @@ func init() {
 //
 // CP932 double-byte character codes:
 //
-// eb-ec, ef, f0-f9:
+// eb-ec, ef:
 // 	Marked as DBCS LEAD BYTEs in the unicode mapping data
 //	obtained from:
 //		https://www.unicode.org/Public/MAPPINGS/VENDORS/MICSFT/WINDOWS/CP932.TXT
@@ -55,6 +55,19 @@ func init() {
 // 	but there are no defined mappings for codes in this range.
 // 	It is not clear whether or not an implementation should
 // 	consume one or two bytes before emitting an error char.
+//
+// f0-f9:
+//	User defined GAIJI, mapped to the Unicode Private Use Area
+//	(U+E000..U+E757) unless overridden by SetEUDC.
+//	Shift-JIS has the same user-defined area. The Japanese
+//	mobile carriers put their emoji there; a table of a
+//	carrier's emoji can be installed with SetEUDC.
+//
+// Encoding
+//
+//	Characters with more than one code are encoded using
+//	the first, except that the NEC-selected IBM extended
+//	characters (ed..ee) are never used, as Windows does.
 
 const (
 	kanaPages    = 1
@@ -70,10 +83,23 @@ type jisTables struct {
 	page0   [256]rune
 	dbcsoff [256]int
 	cp932   []rune
+	codes   map[rune]uint16
+}
+
+// dbcs returns the character for the double-byte code b1 b2,
+// or utf8.RuneError if there is none.
+func (t *jisTables) dbcs(b1, b2 byte) rune {
+	pnum := t.dbcsoff[b1]
+	ix := int(b2) - cp932Char0
+	if pnum == -1 || ix < 0 || ix >= cp932PageSize {
+		return utf8.RuneError
+	}
+	return t.cp932[pnum*cp932PageSize+ix]
 }
 
 type translateFromCP932 struct {
 	tables  *jisTables
+	eudc    *eudcMaps
 	scratch []byte
 }
 
@@ -92,25 +118,65 @@ func (p *translateFromCP932) Translate(data []byte, eof bool) (int, []byte, erro
 		// DBCS
 		i++
 		if i >= len(data) {
+			if eof {
+				// truncated character.
+				p.scratch = appendRune(p.scratch, utf8.RuneError)
+				n++
+			}
 			break
 		}
-		pnum := tables.dbcsoff[b]
-		ix := int(data[i]) - cp932Char0
-		if pnum == -1 || ix < 0 || ix >= cp932PageSize {
-			r = utf8.RuneError
-		} else {
-			r = tables.cp932[pnum*cp932PageSize+ix]
-		}
+		code := uint32(b)<<8 | uint32(data[i])
+		r = p.eudc.decode(sjisUDA, code, tables.dbcs(b, data[i]))
 		p.scratch = appendRune(p.scratch, r)
 		n += 2
 	}
 	return n, p.scratch, nil
 }
 
+type translateToCP932 struct {
+	tables *jisTables
+	eudc   *eudcMaps
+	bestFits
+	scratch []byte
+}
+
+func (p *translateToCP932) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		n += size
+		if code, ok := p.eudc.encode(sjisUDA, r); ok {
+			p.scratch = append(p.scratch, byte(code>>8), byte(code))
+			continue
+		}
+		code, ok := p.tables.codes[r]
+		switch {
+		case !ok || p.eudc.overrides(uint32(code)):
+			p.scratch = append(p.scratch, errorByte)
+		case code < 0x100:
+			p.scratch = append(p.scratch, byte(code))
+		default:
+			p.scratch = append(p.scratch, byte(code>>8), byte(code))
+		}
+	}
+	return n, p.scratch, nil
+}
+
+func (p *translateToCP932) encodes(r rune) bool {
+	if _, ok := p.eudc.encode(sjisUDA, r); ok {
+		return true
+	}
+	code, ok := p.tables.codes[r]
+	return ok && !p.eudc.overrides(uint32(code))
+}
+
 type cp932Key bool
 
-func fromCP932(arg string) (Translator, error) {
-	shiftJIS := arg == "shiftjis"
+func getJISTables(shiftJIS bool) (*jisTables, error) {
 	tables, err := cache(cp932Key(shiftJIS), func() (interface{}, error) {
 		tables := new(jisTables)
 		kana, err := jisGetMap("jisx0201kana.dat", kanaPageSize, kanaPages)
@@ -121,6 +187,10 @@ func fromCP932(arg string) (Translator, error) {
 		if err != nil {
 			return nil, err
 		}
+		for i := range tables.page0 {
+			tables.page0[i] = utf8.RuneError
+			tables.dbcsoff[i] = -1
+		}
 
 		// jisx0201kana is mapped into 0xA1..0xDF
 		for i := 0; i < kanaPageSize; i++ {
@@ -128,7 +198,7 @@ func fromCP932(arg string) (Translator, error) {
 		}
 
 		// 00..7f same as ascii in cp932
-		for i := rune(0); i < 0x7f; i++ {
+		for i := rune(0); i <= 0x7f; i++ {
 			tables.page0[i] = i
 		}
 
@@ -158,28 +228,67 @@ func fromCP932(arg string) (Translator, error) {
 			tables.dbcsoff[i] = pnum
 			pnum++
 		}
-		if shiftJIS {
-			return tables, nil
-		}
-		// add in cp932 extensions
-		for i := 0xed; i <= 0xee; i++ {
+		// user defined GAIJI
+		for i := 0xf0; i <= 0xf9; i++ {
 			tables.page0[i] = -1
-			tables.dbcsoff[i] = pnum
-			pnum++
 		}
-		for i := 0xfa; i <= 0xfc; i++ {
-			tables.page0[i] = -1
-			tables.dbcsoff[i] = pnum
-			pnum++
+		if !shiftJIS {
+			// add in cp932 extensions
+			for i := 0xed; i <= 0xee; i++ {
+				tables.page0[i] = -1
+				tables.dbcsoff[i] = pnum
+				pnum++
+			}
+			for i := 0xfa; i <= 0xfc; i++ {
+				tables.page0[i] = -1
+				tables.dbcsoff[i] = pnum
+				pnum++
+			}
+		}
+
+		tables.codes = make(map[rune]uint16)
+		for i, r := range tables.page0 {
+			if r != -1 && r != utf8.RuneError {
+				tables.codes[r] = uint16(i)
+			}
+		}
+		for b1 := 0x81; b1 <= 0xfc; b1++ {
+			if tables.dbcsoff[b1] == -1 || b1 == 0xed || b1 == 0xee {
+				continue
+			}
+			for b2 := cp932Char0; b2 < cp932Char0+cp932PageSize; b2++ {
+				r := tables.dbcs(byte(b1), byte(b2))
+				if _, ok := tables.codes[r]; !ok && r != utf8.RuneError {
+					tables.codes[r] = uint16(b1<<8 | b2)
+				}
+			}
 		}
 		return tables, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return tables.(*jisTables), nil
+}
 
+func fromCP932(arg string) (Translator, error) {
+	tables, err := getJISTables(arg == "shiftjis")
 	if err != nil {
 		return nil, err
 	}
+	return &translateFromCP932{tables: tables, eudc: getEUDC("cp932", arg)}, nil
+}
 
-	return &translateFromCP932{tables: tables.(*jisTables)}, nil
+func toCP932(arg string) (Translator, error) {
+	tables, err := getJISTables(arg == "shiftjis")
+	if err != nil {
+		return nil, err
+	}
+	return &translateToCP932{
+		tables:   tables,
+		eudc:     getEUDC("cp932", arg),
+		bestFits: getBestFits("cp932", arg),
+	}, nil
 }
 
 func jisGetMap(name string, pgsize, npages int) ([]rune, error) {
@@ -0,0 +1,797 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Character set detection
+//
+// A Detector guesses the character set of text from its bytes.
+// Text that starts with a byte order mark is in the character
+// set that the mark indicates, and text that is entirely ASCII
+// is reported as US-ASCII (or as ISO-2022-JP or ISO-2022-KR,
+// if it holds their escape sequences), since every
+// ASCII-compatible character set decodes it the same way.
+//
+// Other text is decoded in each candidate character set: every
+// character set that the registered factories can translate
+// from (see Names), other than those that encode text within
+// text, such as html-entities and punycode (see
+// undetectedClasses). The result is scored with a model of the
+// characters of each language that the character set may be
+// used for: the frequencies of the letters of the language, for
+// the single-byte character sets, or of its most common
+// characters, for the multibyte ones, whose byte structure is
+// also checked (see mbMachine). The models come from the files
+// detect-<language>.dat, which list a character and its
+// frequency in per cent on each line; the frequency of the
+// characters that are not listed is given by "*".
+// Undefined bytes, control characters and broken multibyte
+// sequences count heavily against a character set.
+//
+// The languages of the character sets in common use are given
+// by detectLangs. Any other character set is scored against the
+// languages whose common characters it holds, which is found by
+// encoding them and decoding the result (see fitLangs).
+//
+// The score of a character set is the log-likelihood of the
+// text, under the language that fits it best; the confidence
+// of each candidate is the probability given by these
+// scores, taking all the candidates as equally likely
+// beforehand. A candidate that decodes the text the same way
+// as one that is preferred to it (see detectLangs) is left
+// out, so that aliases and supersets of a character set do
+// not share its confidence. A candidate whose score falls far
+// behind the best one (see pruneLogL) is no longer decoded.
+//
+// Text in GBK, EUC-KR and some of the Windows code pages is
+// only recognised when the iconv package is used. UTF-16
+// text without a byte order mark is only recognised when it
+// holds ASCII characters.
+
+// A Candidate is a character set that text may be in.
+type Candidate struct {
+	Charset    string
+	Confidence float64 // from 0 to 1.
+}
+
+// minConfidence is the confidence below which candidates
+// are not reported.
+const minConfidence = 0.001
+
+const (
+	logPError   = -16.1 // undefined bytes and control characters.
+	logPASCII   = -3.9  // printable ASCII characters other than letters.
+	logPForeign = -6.9  // ASCII letters that the language does not use.
+	logPPunct   = -6.9  // other punctuation and spaces.
+	logPSymbol  = -9.2  // other symbols.
+	logPUpper   = -1.6  // added for upper-case letters.
+)
+
+// pruneLogL is how far the log-likelihood of a candidate may
+// fall behind that of the best one before the candidate is
+// dropped. It is checked every pruneBlock bytes of text.
+const (
+	pruneLogL  = 20 * logPError
+	pruneBlock = 4096
+)
+
+// undetectedClasses holds the classes of the character sets
+// that are never candidates: those that encode text within
+// text, as escapes or ASCII labels, rather than as bytes. Any
+// text decodes in them, often to fewer characters than it
+// holds, which would score better than the text itself.
+var undetectedClasses = []string{"8bit", "escape", "punycode"}
+
+// minFitFreq is the frequency, in per cent, of the characters
+// of a language that a character set must hold to be scored
+// against the language.
+const minFitFreq = 0.1
+
+// detectLangs gives the languages that the character sets in
+// common use are scored against. Those character sets are
+// preferred, in this order, to the others when candidates
+// decode text the same way or have equal scores. The Unicode
+// encodings, which have a nil list, are scored against all the
+// languages.
+var detectLangs = []struct {
+	charset string
+	langs   []string
+}{
+	{"utf-8", nil},
+	{"shift_jis", []string{"ja"}},
+	{"euc-jp", []string{"ja"}},
+	{"gbk", []string{"zh-hans"}},
+	{"gb2312", []string{"zh-hans"}},
+	{"big5", []string{"zh-hant"}},
+	{"euc-kr", []string{"ko"}},
+	{"windows-1252", westernLangs},
+	{"iso-8859-1", westernLangs},
+	{"iso-8859-15", westernLangs},
+	{"windows-1250", centralLangs},
+	{"iso-8859-2", centralLangs},
+	{"windows-1251", []string{"ru", "uk", "bg"}},
+	{"koi8-r", []string{"ru"}},
+	{"koi8-u", []string{"uk"}},
+	{"iso-8859-5", []string{"ru", "bg"}},
+	{"ibm866", []string{"ru"}},
+	{"windows-1253", []string{"el"}},
+	{"iso-8859-7", []string{"el"}},
+	{"windows-1254", []string{"tr"}},
+	{"iso-8859-9", []string{"tr"}},
+	{"windows-1255", []string{"he"}},
+	{"iso-8859-8-i", []string{"he"}},
+	{"windows-1256", []string{"ar"}},
+	{"iso-8859-6", []string{"ar"}},
+	{"utf-16le", nil},
+	{"utf-16be", nil},
+}
+
+var (
+	westernLangs = []string{"fr", "de", "es", "pt", "it", "sv", "da", "fi"}
+	centralLangs = []string{"pl", "cs", "sk", "hu", "ro", "hr"}
+	allLangs     = append(append([]string{"en"}, westernLangs...), append(centralLangs,
+		"ru", "uk", "bg", "el", "tr", "he", "ar", "ja", "zh-hans", "zh-hant", "ko")...)
+)
+
+// A detectCharset is a character set that text is decoded
+// in, with the languages that it is scored against.
+type detectCharset struct {
+	name  string
+	langs []string
+}
+
+// detectCharsets returns the character sets that the
+// registered factories can translate from, in order of
+// preference: those in detectLangs first, under the names
+// given there, and then the others in alphabetical order.
+func detectCharsets() []detectCharset {
+	listed := make(map[string]int) // index in detectLangs by normalized name.
+	for i, c := range detectLangs {
+		listed[NormalizedName(c.charset)] = i
+	}
+	found := make([]bool, len(detectLangs))
+	var others []detectCharset
+	seen := make(map[string]bool)
+	for _, name := range Names() {
+		cs := Info(name)
+		if cs == nil || cs.NoFrom || seen[cs.Name] || !encodesBytes(name) {
+			continue
+		}
+		seen[cs.Name] = true
+		i, ok := -1, false
+		for _, n := range append([]string{cs.Name}, cs.Aliases...) {
+			if i, ok = listed[NormalizedName(n)]; ok && !found[i] {
+				break
+			}
+		}
+		if ok && !found[i] {
+			found[i] = true
+			continue
+		}
+		others = append(others, detectCharset{cs.Name, fitLangs(cs.Name)})
+	}
+	var cands []detectCharset
+	for i, c := range detectLangs {
+		if !found[i] {
+			continue
+		}
+		langs := c.langs
+		if langs == nil {
+			langs = allLangs
+		}
+		cands = append(cands, detectCharset{c.charset, langs})
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].name < others[j].name
+	})
+	return append(cands, others...)
+}
+
+// encodesBytes reports whether the named character set is
+// a byte encoding of text, rather than one of those in
+// undetectedClasses.
+func encodesBytes(name string) bool {
+	localFactory{}.init()
+	cs := localCharsets[NormalizedName(name)]
+	if cs == nil {
+		return true
+	}
+	for _, c := range undetectedClasses {
+		if cs.class == classes[c] {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	fitLangsMutex sync.Mutex
+	fitLangsStore = make(map[string][]string)
+)
+
+// fitLangs returns the languages whose characters with a
+// frequency of at least minFitFreq the named character set
+// holds, or all the languages if it has no encoder to tell.
+// It returns nil if the character set does not decode ASCII
+// text as ASCII, since text in such character sets (other
+// than UTF-16, in detectLangs) is not recognised.
+func fitLangs(name string) []string {
+	fitLangsMutex.Lock()
+	defer fitLangsMutex.Unlock()
+	if langs, ok := fitLangsStore[name]; ok {
+		return langs
+	}
+	var langs []string
+	_, err := findTranslator(name, Factory.TranslatorTo)
+	switch {
+	case !decodesASCII(name):
+	case err != nil:
+		langs = allLangs
+	default:
+		for _, lang := range allLangs {
+			if m, err := getCharModel(lang); err == nil && roundTrips(name, m.common()) {
+				langs = append(langs, lang)
+			}
+		}
+	}
+	fitLangsStore[name] = langs
+	return langs
+}
+
+// decodesASCII reports whether the named character set
+// decodes the printable ASCII characters, tab and newlines
+// as themselves.
+func decodesASCII(name string) bool {
+	tr, err := TranslatorFrom(name)
+	if err != nil {
+		return false
+	}
+	ascii := []byte("\t\n\r")
+	for b := byte(' '); b < 0x7f; b++ {
+		ascii = append(ascii, b)
+	}
+	_, out, err := tr.Translate(ascii, true)
+	return err == nil && bytes.Equal(out, ascii)
+}
+
+// roundTrips reports whether the named character set encodes
+// s and decodes the result unchanged.
+func roundTrips(name, s string) bool {
+	enc, err := findTranslator(name, Factory.TranslatorTo)
+	if err != nil {
+		return false
+	}
+	dec, err := TranslatorFrom(name)
+	if err != nil {
+		return false
+	}
+	_, data, err := enc.Translate([]byte(s), true)
+	if err != nil {
+		return false
+	}
+	_, out, err := dec.Translate(data, true)
+	return err == nil && string(out) == s
+}
+
+// mbMachines holds constructors of the machines that check the byte
+// structure of the multibyte character sets.
+var mbMachines = map[string]func() *mbMachine{
+	"shift_jis": func() *mbMachine {
+		return &mbMachine{width: sjisWidth, trail: sjisTrail}
+	},
+	"euc-jp": func() *mbMachine {
+		return &mbMachine{width: eucJPWidth, trail: eucJPTrail}
+	},
+	"gbk": func() *mbMachine {
+		return &mbMachine{width: gbkWidth, trail: gbkTrail}
+	},
+	"gb2312": func() *mbMachine {
+		return &mbMachine{width: eucWidth, trail: eucTrail}
+	},
+	"big5": func() *mbMachine {
+		return &mbMachine{width: gbkWidth, trail: big5Trail}
+	},
+	"euc-kr": func() *mbMachine {
+		return &mbMachine{width: eucWidth, trail: eucTrail}
+	},
+}
+
+// escapeCharsets holds the 7-bit character sets recognised
+// by their escape sequences.
+var escapeCharsets = []struct {
+	charset string
+	escapes []string
+}{
+	{"iso-2022-jp", []string{"\x1b$@", "\x1b$B", "\x1b(J", "\x1b$(D"}},
+	{"iso-2022-kr", []string{"\x1b$)C"}},
+}
+
+// bomCharsets maps the character sets returned by DetectBOM
+// to ones whose decoders remove the byte order mark.
+var bomCharsets = map[string]string{
+	"utf-8":    "utf-8-sig",
+	"utf-16le": "utf-16",
+	"utf-16be": "utf-16",
+	"utf-32le": "utf-32",
+	"utf-32be": "utf-32",
+}
+
+// A charModel holds the log-probabilities of the characters
+// of a language.
+type charModel struct {
+	logP  map[rune]float64
+	other float64 // characters not in logP.
+}
+
+type charModelKey string
+
+func getCharModel(lang string) (*charModel, error) {
+	m, err := cache(charModelKey(lang), func() (interface{}, error) {
+		data, err := readFile("detect-" + lang + ".dat")
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open detection data: %v", err)
+		}
+		m := &charModel{
+			logP:  make(map[rune]float64),
+			other: math.Log(0.0001),
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			f := strings.Split(line, "\t")
+			var pct float64
+			if len(f) == 2 {
+				pct, err = strconv.ParseFloat(f[1], 64)
+			}
+			if len(f) != 2 || err != nil || pct <= 0 || utf8.RuneCountInString(f[0]) != 1 {
+				return nil, fmt.Errorf("charset: bad detection data line %q", line)
+			}
+			if f[0] == "*" {
+				m.other = math.Log(pct / 100)
+				continue
+			}
+			r, _ := utf8.DecodeRuneInString(f[0])
+			m.logP[r] = math.Log(pct / 100)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.(*charModel), nil
+}
+
+// common returns the characters whose frequency is at least
+// minFitFreq, in code point order.
+func (m *charModel) common() string {
+	min := math.Log(minFitFreq / 100)
+	var rs []rune
+	for r, p := range m.logP {
+		if p >= min {
+			rs = append(rs, r)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i] < rs[j]
+	})
+	return string(rs)
+}
+
+// isErrorRune reports whether r shows that text has been
+// decoded in the wrong character set.
+func isErrorRune(r rune) bool {
+	switch {
+	case r < 0x20:
+		return r != '\t' && r != '\n' && r != '\v' && r != '\f' && r != '\r'
+	case r >= 0x7f && r < 0xa0:
+		return true
+	case r == utf8.RuneError, r >= 0xd800 && r < 0xe000, r >= 0xe000 && r < 0xf900:
+		return true
+	}
+	return false
+}
+
+// score returns the log-probability of r.
+func (m *charModel) score(r rune) float64 {
+	switch {
+	case isErrorRune(r):
+		return logPError
+	case r < utf8.RuneSelf && !unicode.IsLetter(r):
+		return logPASCII
+	}
+	if p, ok := m.logP[r]; ok {
+		return p
+	}
+	if unicode.IsUpper(r) {
+		if p, ok := m.logP[unicode.ToLower(r)]; ok {
+			return p + logPUpper
+		}
+	}
+	switch {
+	case r < utf8.RuneSelf:
+		return logPForeign
+	case unicode.IsLetter(r):
+		return m.other
+	case unicode.IsPunct(r), unicode.IsSpace(r):
+		return logPPunct
+	}
+	return logPSymbol
+}
+
+// An mbMachine checks the byte structure of a multibyte
+// character set.
+type mbMachine struct {
+	// width returns the length of the characters that start
+	// with b, or 0 if no character starts with b.
+	width func(b byte) int
+	// trail reports whether b can follow lead in a character.
+	trail  func(lead, b byte) bool
+	lead   byte
+	need   int // bytes still needed to complete the character.
+	errors int
+}
+
+func (m *mbMachine) feed(data []byte) {
+	for _, b := range data {
+		if m.need > 0 {
+			if m.trail(m.lead, b) {
+				m.need--
+				continue
+			}
+			m.errors++
+			m.need = 0
+		}
+		switch w := m.width(b); w {
+		case 0:
+			m.errors++
+		case 1:
+		default:
+			m.lead, m.need = b, w-1
+		}
+	}
+}
+
+func sjisWidth(b byte) int {
+	switch {
+	case b < 0x80, b >= 0xa1 && b <= 0xdf:
+		return 1
+	case b >= 0x81 && b <= 0x9f, b >= 0xe0 && b <= 0xfc:
+		return 2
+	}
+	return 0
+}
+
+func sjisTrail(lead, b byte) bool {
+	return b >= 0x40 && b <= 0xfc && b != 0x7f
+}
+
+func eucJPWidth(b byte) int {
+	switch {
+	case b < 0x80:
+		return 1
+	case b == 0x8e, b >= 0xa1 && b <= 0xfe:
+		return 2
+	case b == 0x8f:
+		return 3
+	}
+	return 0
+}
+
+func eucJPTrail(lead, b byte) bool {
+	if lead == 0x8e {
+		return b >= 0xa1 && b <= 0xdf
+	}
+	return b >= 0xa1 && b <= 0xfe
+}
+
+func eucWidth(b byte) int {
+	switch {
+	case b < 0x80:
+		return 1
+	case b >= 0xa1 && b <= 0xfe:
+		return 2
+	}
+	return 0
+}
+
+func eucTrail(lead, b byte) bool {
+	return b >= 0xa1 && b <= 0xfe
+}
+
+func gbkWidth(b byte) int {
+	switch {
+	case b <= 0x80:
+		return 1
+	case b <= 0xfe:
+		return 2
+	}
+	return 0
+}
+
+func gbkTrail(lead, b byte) bool {
+	return b >= 0x40 && b <= 0xfe && b != 0x7f
+}
+
+func big5Trail(lead, b byte) bool {
+	return b >= 0x40 && b <= 0x7e || b >= 0xa1 && b <= 0xfe
+}
+
+// A probe scores text decoded in one candidate character set.
+type probe struct {
+	charset string
+	tr      Translator
+	mb      *mbMachine
+	models  []*charModel
+	logL    []float64   // log-likelihood of the text under each model.
+	errors  int         // errors of mb already counted.
+	buf     []byte      // bytes not yet decoded.
+	sum     hash.Hash64 // of the decoded text.
+	dropped bool        // the decoder failed, or the probe fell too far behind.
+}
+
+func (p *probe) write(data []byte) {
+	if p.dropped {
+		return
+	}
+	if p.mb != nil {
+		p.mb.feed(data)
+		for i := range p.logL {
+			p.logL[i] += float64(p.mb.errors-p.errors) * logPError
+		}
+		p.errors = p.mb.errors
+	}
+	p.buf = append(p.buf, data...)
+	n, cdata, err := p.tr.Translate(p.buf, false)
+	if err != nil {
+		p.dropped = true
+		return
+	}
+	p.buf = append(p.buf[:0], p.buf[n:]...)
+	p.sum.Write(cdata)
+	p.add(p.logL, cdata)
+}
+
+// add adds the log-likelihood of the decoded text cdata under
+// each model to logL.
+func (p *probe) add(logL []float64, cdata []byte) {
+	for len(cdata) > 0 {
+		r, size := utf8.DecodeRune(cdata)
+		cdata = cdata[size:]
+		for i, m := range p.models {
+			logL[i] += m.score(r)
+		}
+	}
+}
+
+// best returns the log-likelihood of the text decoded so far
+// under the model that fits it best.
+func (p *probe) best() float64 {
+	best := math.Inf(-1)
+	for _, l := range p.logL {
+		best = math.Max(best, l)
+	}
+	return best
+}
+
+// A decodedKey identifies the text decoded by a probe.
+type decodedKey struct {
+	sum  uint64 // of the text that the probe has decoded.
+	tail string // the text in the bytes it has not decoded yet.
+}
+
+// finish returns the log-likelihood of the text under the
+// model that fits it best, and the key of the decoded text.
+// Bytes that the translator has not decoded yet, such as a
+// last line without a newline in a character set that is
+// stored in visual order, are decoded as if they ended the
+// text.
+func (p *probe) finish() (float64, decodedKey) {
+	logL := p.logL
+	key := decodedKey{sum: p.sum.Sum64()}
+	if len(p.buf) > 0 {
+		logL = append([]float64(nil), p.logL...)
+		if tr, err := TranslatorFrom(p.charset); err == nil {
+			if _, cdata, err := tr.Translate(p.buf, true); err == nil {
+				p.add(logL, cdata)
+				key.tail = string(cdata)
+			}
+		}
+	}
+	best := math.Inf(-1)
+	for _, l := range logL {
+		best = math.Max(best, l)
+	}
+	return best, key
+}
+
+// A Detector guesses the character set of the text written
+// to it.
+type Detector struct {
+	head    []byte // the first bytes, which may be a byte order mark.
+	tail    []byte // the last bytes, which may start an escape sequence.
+	count   int
+	high    int    // bytes outside ASCII.
+	zeros   [2]int // zero bytes at even and odd offsets.
+	escapes map[string]bool
+	probes  []*probe
+}
+
+// NewDetector returns a new Detector.
+func NewDetector() *Detector {
+	d := &Detector{escapes: make(map[string]bool)}
+	for _, c := range detectCharsets() {
+		tr, err := TranslatorFrom(c.name)
+		if err != nil {
+			continue
+		}
+		p := &probe{charset: c.name, tr: tr, sum: fnv.New64a()}
+		for _, lang := range c.langs {
+			if m, err := getCharModel(lang); err == nil {
+				p.models = append(p.models, m)
+			}
+		}
+		if len(p.models) == 0 {
+			continue
+		}
+		if mb := mbMachines[c.name]; mb != nil {
+			p.mb = mb()
+		}
+		p.logL = make([]float64, len(p.models))
+		d.probes = append(d.probes, p)
+	}
+	return d
+}
+
+// Write adds data to the text seen by the Detector.
+// It always succeeds.
+func (d *Detector) Write(data []byte) (int, error) {
+	if n := 4 - len(d.head); n > 0 {
+		if n > len(data) {
+			n = len(data)
+		}
+		d.head = append(d.head, data[:n]...)
+	}
+	for i, b := range data {
+		if b >= utf8.RuneSelf {
+			d.high++
+		} else if b == 0 {
+			d.zeros[(d.count+i)&1]++
+		}
+	}
+	if bytes.IndexByte(data, 0x1b) >= 0 || len(d.tail) > 0 {
+		d.tail = append(d.tail, data...)
+		for _, c := range escapeCharsets {
+			for _, esc := range c.escapes {
+				if bytes.Contains(d.tail, []byte(esc)) {
+					d.escapes[c.charset] = true
+				}
+			}
+		}
+		// keep enough to complete an escape sequence.
+		if i := bytes.LastIndexByte(d.tail, 0x1b); i >= 0 && len(d.tail)-i < 4 {
+			d.tail = append(d.tail[:0], d.tail[i:]...)
+		} else {
+			d.tail = d.tail[:0]
+		}
+	}
+	n := len(data)
+	for len(data) > 0 {
+		// write up to the next multiple of pruneBlock, so that
+		// the probes dropped do not depend on how the text
+		// is split.
+		m := pruneBlock - d.count%pruneBlock
+		if m > len(data) {
+			m = len(data)
+		}
+		for _, p := range d.probes {
+			p.write(data[:m])
+		}
+		d.count += m
+		data = data[m:]
+		if d.count%pruneBlock == 0 {
+			d.prune()
+		}
+	}
+	return n, nil
+}
+
+// prune drops the probes that have fallen more than pruneLogL
+// behind the best one.
+func (d *Detector) prune() {
+	best := math.Inf(-1)
+	for _, p := range d.probes {
+		if !p.dropped && (d.utf16() || !strings.HasPrefix(p.charset, "utf-16")) {
+			best = math.Max(best, p.best())
+		}
+	}
+	for _, p := range d.probes {
+		if p.best() < best+pruneLogL {
+			p.dropped = true
+		}
+	}
+}
+
+// utf16 reports whether the zero bytes seen suggest UTF-16 text.
+func (d *Detector) utf16() bool {
+	pairs := d.count / 2
+	return pairs > 0 && (d.zeros[0]*4 >= pairs || d.zeros[1]*4 >= pairs)
+}
+
+// Candidates returns the character sets that the text written so
+// far may be in, most likely first. It returns nil if no text
+// has been written.
+func (d *Detector) Candidates() []Candidate {
+	if d.count == 0 {
+		return nil
+	}
+	if cs, n := DetectBOM(d.head); n > 0 {
+		return []Candidate{{bomCharsets[cs], 1}}
+	}
+	if d.high == 0 && !d.utf16() {
+		for _, c := range escapeCharsets {
+			if d.escapes[c.charset] {
+				if _, err := TranslatorFrom(c.charset); err == nil {
+					return []Candidate{{c.charset, 1}}
+				}
+			}
+		}
+		return []Candidate{{"us-ascii", 1}}
+	}
+	var probes []*probe
+	var scores []float64
+	best := math.Inf(-1)
+	decoded := make(map[decodedKey]bool)
+	for _, p := range d.probes {
+		if p.dropped || strings.HasPrefix(p.charset, "utf-16") && !d.utf16() {
+			continue
+		}
+		score, key := p.finish()
+		if decoded[key] {
+			continue
+		}
+		decoded[key] = true
+		probes = append(probes, p)
+		scores = append(scores, score)
+		best = math.Max(best, score)
+	}
+	total := 0.0
+	for _, score := range scores {
+		total += math.Exp(score - best)
+	}
+	var cands []Candidate
+	for i, p := range probes {
+		if c := math.Exp(scores[i]-best) / total; c >= minConfidence {
+			cands = append(cands, Candidate{p.charset, c})
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		return cands[i].Confidence > cands[j].Confidence
+	})
+	return cands
+}
+
+// Detect returns the character sets that data may be in, most
+// likely first.
+func Detect(data []byte) []Candidate {
+	d := NewDetector()
+	d.Write(data)
+	return d.Candidates()
+}
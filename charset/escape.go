@@ -0,0 +1,398 @@
+package charset
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("escape", fromEscape, toEscape)
+}
+
+// encoding details
+// Escape syntaxes
+//
+// The class argument selects the syntax:
+//
+//	"java"	\uXXXX, as read by the Java compiler and by
+//		java.util.Properties, and written by native2ascii.
+//		Other bytes are ISO 8859-1. Characters outside
+//		the BMP are written as a surrogate pair.
+//	"c"	the C escape sequences: \a \b \f \n \r \t \v \\ \' \" \?,
+//		\ooo and \xHH for bytes (at most two hex digits are read),
+//		\uXXXX and \UXXXXXXXX for characters.
+//	"percent"	%HH for bytes, as in URLs (RFC 3986).
+//	"html"	HTML character references: &name; &#NNN; &#xHHHH;
+//
+// Except for "java", the bytes given by escapes and the bytes
+// outside escapes together form UTF-8 text; invalid UTF-8 is
+// decoded as U+FFFD.
+//
+// The encoder escapes each non-ASCII character, and the ASCII
+// characters needed to decode the result unambiguously:
+// \ before u or a non-ASCII character in "java", \ in "c",
+// % in "percent" and & in "html". Other ASCII characters are
+// unchanged.
+//
+// Notes
+//
+// As in Java, a backslash is the start of a \u escape only if it
+// is preceded by an even number of backslashes.
+// Malformed escapes are copied unchanged.
+// An HTML character reference is decoded as a browser decodes
+// it in text, so some references are recognised without the
+// final semicolon.
+
+const (
+	escJava    = "java"
+	escC       = "c"
+	escPercent = "percent"
+	escHTML    = "html"
+)
+
+// maxEntityLen is the length of the longest HTML character
+// reference name, "&CounterClockwiseContourIntegral;".
+const maxEntityLen = 33
+
+var cEscapes = map[byte]byte{
+	'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+	'\\': '\\', '\'': '\'', '"': '"', '?': '?',
+}
+
+func isHexDigit(c byte) bool {
+	return hexValue([]byte{c}, 1) >= 0
+}
+
+// hexValue returns the value of the hex digits in s,
+// or -1 if there are fewer than n.
+func hexValue(s []byte, n int) int {
+	if len(s) < n {
+		return -1
+	}
+	v := 0
+	for _, c := range s[:n] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c -= 'a' - 10
+		case c >= 'A' && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return -1
+		}
+		v = v<<4 | int(c)
+	}
+	return v
+}
+
+type translateFromEscape struct {
+	// unescape decodes the escape or byte at the start
+	// of data and returns the number of bytes used, or 0
+	// if more data is needed.
+	unescape func(data []byte, eof bool) int
+	// pending holds decoded bytes that may be the start
+	// of an incomplete UTF-8 sequence.
+	pending   []byte
+	backslash bool // java: preceded by an odd number of backslashes.
+	scratch   []byte
+}
+
+func (p *translateFromEscape) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		size := p.unescape(data[n:], eof)
+		if size == 0 {
+			break
+		}
+		n += size
+	}
+	// move complete characters from pending to scratch.
+	i := 0
+	for i < len(p.pending) {
+		if !eof && !utf8.FullRune(p.pending[i:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(p.pending[i:])
+		p.scratch = appendRune(p.scratch, r)
+		i += size
+	}
+	p.pending = p.pending[:copy(p.pending, p.pending[i:])]
+	return n, p.scratch, nil
+}
+
+func (p *translateFromEscape) unescapeJava(data []byte, eof bool) int {
+	c := data[0]
+	if c != '\\' || p.backslash {
+		p.backslash = c == '\\' && !p.backslash
+		p.pending = appendRune(p.pending, rune(c))
+		return 1
+	}
+	if len(data) < 2 && !eof {
+		return 0
+	}
+	i := 1
+	for i < len(data) && data[i] == 'u' {
+		i++
+	}
+	if i == 1 {
+		p.backslash = true
+		p.pending = append(p.pending, c)
+		return 1
+	}
+	v := hexValue(data[i:], 4)
+	if v < 0 {
+		if len(data) < i+4 && !eof {
+			return 0
+		}
+		// malformed escape.
+		p.backslash = true
+		p.pending = append(p.pending, c)
+		return 1
+	}
+	r, size := rune(v), i+4
+	if r >= 0xd800 && r < 0xdc00 {
+		// look for the second half of a surrogate pair.
+		rest := data[size:]
+		if len(rest) < 6 && !eof {
+			return 0
+		}
+		if len(rest) >= 6 && rest[0] == '\\' && rest[1] == 'u' {
+			if v2 := hexValue(rest[2:], 4); v2 >= 0xdc00 && v2 < 0xe000 {
+				r = (r-0xd800)<<10 + rune(v2) - 0xdc00 + 0x10000
+				size += 6
+			}
+		}
+	}
+	if r >= 0xd800 && r < 0xe000 {
+		r = utf8.RuneError
+	}
+	p.pending = appendRune(p.pending, r)
+	return size
+}
+
+func (p *translateFromEscape) unescapeC(data []byte, eof bool) int {
+	if data[0] != '\\' {
+		p.pending = append(p.pending, data[0])
+		return 1
+	}
+	if len(data) < 2 {
+		if !eof {
+			return 0
+		}
+		p.pending = append(p.pending, '\\')
+		return 1
+	}
+	c := data[1]
+	if b, ok := cEscapes[c]; ok {
+		p.pending = append(p.pending, b)
+		return 2
+	}
+	switch {
+	case c >= '0' && c <= '7':
+		v, i := 0, 1
+		for ; i < len(data) && i <= 3 && data[i] >= '0' && data[i] <= '7'; i++ {
+			v = v<<3 | int(data[i]-'0')
+		}
+		if i == len(data) && i <= 3 && !eof {
+			return 0
+		}
+		if v > 0xff {
+			p.pending = appendRune(p.pending, utf8.RuneError)
+		} else {
+			p.pending = append(p.pending, byte(v))
+		}
+		return i
+	case c == 'x':
+		i := 2
+		for ; i < len(data) && i < 4 && isHexDigit(data[i]); i++ {
+		}
+		if i == len(data) && i < 4 && !eof {
+			return 0
+		}
+		if i > 2 {
+			p.pending = append(p.pending, byte(hexValue(data[2:], i-2)))
+			return i
+		}
+	case c == 'u' || c == 'U':
+		digits := 4
+		if c == 'U' {
+			digits = 8
+		}
+		v := hexValue(data[2:], digits)
+		if v < 0 && len(data) < digits+2 && !eof {
+			return 0
+		}
+		if v >= 0 {
+			r := rune(v)
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			p.pending = appendRune(p.pending, r)
+			return digits + 2
+		}
+	}
+	// unknown or malformed escape.
+	p.pending = append(p.pending, '\\')
+	return 1
+}
+
+func (p *translateFromEscape) unescapePercent(data []byte, eof bool) int {
+	if data[0] != '%' {
+		p.pending = append(p.pending, data[0])
+		return 1
+	}
+	if v := hexValue(data[1:], 2); v >= 0 {
+		p.pending = append(p.pending, byte(v))
+		return 3
+	}
+	if len(data) < 3 && !eof {
+		return 0
+	}
+	p.pending = append(p.pending, '%')
+	return 1
+}
+
+func isEntityByte(c byte) bool {
+	return c == '#' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func (p *translateFromEscape) unescapeHTML(data []byte, eof bool) int {
+	if data[0] != '&' {
+		p.pending = append(p.pending, data[0])
+		return 1
+	}
+	i := 1
+	for i < len(data) && i < maxEntityLen && isEntityByte(data[i]) {
+		i++
+	}
+	if i < len(data) && data[i] == ';' {
+		i++
+	} else if i == len(data) && i < maxEntityLen && !eof {
+		return 0
+	}
+	p.pending = append(p.pending, html.UnescapeString(string(data[:i]))...)
+	return i
+}
+
+type translateToEscape struct {
+	syntax    string
+	backslash bool // java: preceded by an odd number of backslashes.
+	scratch   []byte
+}
+
+const lowerHex = "0123456789abcdef"
+
+func appendHex(buf []byte, v rune, digits int) []byte {
+	for i := digits - 1; i >= 0; i-- {
+		buf = append(buf, lowerHex[v>>(uint(i)*4)&0xf])
+	}
+	return buf
+}
+
+func (p *translateToEscape) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if p.syntax == escJava && r == '\\' && !p.backslash {
+			// a backslash must be escaped if the decoder
+			// would take it as the start of an escape.
+			rest := data[n+1:]
+			if len(rest) == 0 && !eof {
+				break
+			}
+			if len(rest) > 0 && (rest[0] == 'u' || rest[0] >= utf8.RuneSelf) {
+				p.scratch = append(p.scratch, `\u005c`...)
+				n += size
+				continue
+			}
+		}
+		n += size
+		if r < utf8.RuneSelf && size == 1 {
+			p.backslash = r == '\\' && !p.backslash
+			switch {
+			case p.syntax == escC && r == '\\':
+				p.scratch = append(p.scratch, `\\`...)
+			case p.syntax == escPercent && r == '%':
+				p.scratch = append(p.scratch, "%25"...)
+			case p.syntax == escHTML && r == '&':
+				p.scratch = append(p.scratch, "&amp;"...)
+			default:
+				p.scratch = append(p.scratch, byte(r))
+			}
+			continue
+		}
+		p.backslash = false
+		switch p.syntax {
+		case escJava:
+			if r >= 0x10000 {
+				r -= 0x10000
+				p.scratch = appendHex(append(p.scratch, `\u`...), 0xd800+r>>10, 4)
+				r = 0xdc00 + r&0x3ff
+			}
+			p.scratch = appendHex(append(p.scratch, `\u`...), r, 4)
+		case escC:
+			switch {
+			case r < 0xa0:
+				// universal character names cannot
+				// stand for C1 controls.
+				for _, b := range []byte(string(r)) {
+					p.scratch = append(p.scratch, '\\', '0'+b>>6, '0'+b>>3&7, '0'+b&7)
+				}
+			case r < 0x10000:
+				p.scratch = appendHex(append(p.scratch, `\u`...), r, 4)
+			default:
+				p.scratch = appendHex(append(p.scratch, `\U`...), r, 8)
+			}
+		case escPercent:
+			for _, b := range []byte(string(r)) {
+				p.scratch = append(p.scratch, '%', "0123456789ABCDEF"[b>>4], "0123456789ABCDEF"[b&0xf])
+			}
+		case escHTML:
+			p.scratch = append(p.scratch, "&#"...)
+			p.scratch = strconv.AppendInt(p.scratch, int64(r), 10)
+			p.scratch = append(p.scratch, ';')
+		}
+	}
+	return n, p.scratch, nil
+}
+
+func escapeSyntax(arg string) error {
+	switch arg {
+	case escJava, escC, escPercent, escHTML:
+		return nil
+	}
+	return fmt.Errorf("charset: unknown escape syntax %q", arg)
+}
+
+func fromEscape(arg string) (Translator, error) {
+	if err := escapeSyntax(arg); err != nil {
+		return nil, err
+	}
+	p := new(translateFromEscape)
+	switch arg {
+	case escJava:
+		p.unescape = p.unescapeJava
+	case escC:
+		p.unescape = p.unescapeC
+	case escPercent:
+		p.unescape = p.unescapePercent
+	case escHTML:
+		p.unescape = p.unescapeHTML
+	}
+	return p, nil
+}
+
+func toEscape(arg string) (Translator, error) {
+	if err := escapeSyntax(arg); err != nil {
+		return nil, err
+	}
+	return &translateToEscape{syntax: arg}, nil
+}
@@ -0,0 +1,168 @@
+package charset
+
+import (
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("euc-jp", fromEUCJP, toEUCJP)
+}
+
+// encoding details
+// EUC-JP
+//
+// 00..7f	ASCII
+// 8e		single shift 2: JIS X 0201 katakana in a1..df follows
+// 8f		single shift 3: JIS X 0212 row and cell follow
+// a1..fe	JIS X 0208 row (+0xa0), followed by the cell (+0xa0)
+//
+// Notes
+//
+// JIS X 0208 is decoded using the CP932 tables, so the NEC
+// special characters in row 13 are recognised.
+// Rows f5..fe and 8f f5..fe are the user-defined area, mapped
+// to the Unicode Private Use Area unless overridden by SetEUDC.
+// Apart from its user-defined area, JIS X 0212 is not
+// supported: its characters are decoded as U+FFFD.
+
+type translateFromEUCJP struct {
+	tables  *jisTables
+	eudc    *eudcMaps
+	scratch []byte
+}
+
+func (p *translateFromEUCJP) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		b := data[n]
+		if b < 0x80 {
+			p.scratch = append(p.scratch, b)
+			n++
+			continue
+		}
+		need := 2
+		if b == 0x8f {
+			need = 3
+		}
+		if b != 0x8e && b != 0x8f && !isEUCByte(b) {
+			need = 1
+		}
+		size := need
+		if n+size > len(data) {
+			if !eof {
+				break
+			}
+			size = len(data) - n
+		}
+		// the number of valid bytes in the code.
+		valid := 1
+		for valid < size && isEUCByte(data[n+valid]) {
+			valid++
+		}
+		r := utf8.RuneError
+		switch {
+		case valid < need || need == 1:
+			size = valid
+		case b == 0x8e:
+			if c := data[n+1]; c < kanaChar0+kanaPageSize {
+				r = p.tables.page0[c]
+			}
+		case b == 0x8f:
+			code := uint32(b)<<16 | uint32(data[n+1])<<8 | uint32(data[n+2])
+			r = p.eudc.decode(eucJPUDA, code, r)
+		default:
+			row, cell := int(b-0xa0), int(data[n+1]-0xa0)
+			if row <= 84 {
+				b1, b2, _ := kutenToSJIS(kuten(1, row, cell))
+				r = p.tables.dbcs(b1, b2)
+			}
+			r = p.eudc.decode(eucJPUDA, uint32(b)<<8|uint32(data[n+1]), r)
+		}
+		p.scratch = appendRune(p.scratch, r)
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+type translateToEUCJP struct {
+	tables  *jisTables
+	eudc    *eudcMaps
+	scratch []byte
+}
+
+func (p *translateToEUCJP) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		n += size
+		if r < utf8.RuneSelf {
+			p.scratch = append(p.scratch, byte(r))
+			continue
+		}
+		if code, ok := p.eudc.encode(eucJPUDA, r); ok {
+			if code > 0xffff {
+				p.scratch = append(p.scratch, byte(code>>16))
+			}
+			p.scratch = append(p.scratch, byte(code>>8), byte(code))
+			continue
+		}
+		code, ok := p.tables.codes[r]
+		if !ok {
+			p.scratch = append(p.scratch, errorByte)
+			continue
+		}
+		var euc uint32
+		if code < 0x100 {
+			if code >= kanaChar0 && code < kanaChar0+kanaPageSize {
+				euc = 0x8e00 | uint32(code)
+			}
+		} else if k := sjisToKuten(byte(code>>8), byte(code)); k >= 0 && k < 84*94 {
+			euc = uint32(k/94+0xa1)<<8 | uint32(k%94+0xa1)
+		}
+		if euc == 0 || p.eudc.overrides(euc) {
+			p.scratch = append(p.scratch, errorByte)
+			continue
+		}
+		p.scratch = append(p.scratch, byte(euc>>8), byte(euc))
+	}
+	return n, p.scratch, nil
+}
+
+func (p *translateToEUCJP) encodes(r rune) bool {
+	if r < utf8.RuneSelf {
+		return true
+	}
+	if _, ok := p.eudc.encode(eucJPUDA, r); ok {
+		return true
+	}
+	code, ok := p.tables.codes[r]
+	if !ok {
+		return false
+	}
+	if code < 0x100 {
+		return code >= kanaChar0 && code < kanaChar0+kanaPageSize
+	}
+	k := sjisToKuten(byte(code>>8), byte(code))
+	return k >= 0 && k < 84*94 && !p.eudc.overrides(uint32(k/94+0xa1)<<8|uint32(k%94+0xa1))
+}
+
+func fromEUCJP(arg string) (Translator, error) {
+	tables, err := getJISTables(false)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromEUCJP{tables: tables, eudc: getEUDC("euc-jp", arg)}, nil
+}
+
+func toEUCJP(arg string) (Translator, error) {
+	tables, err := getJISTables(false)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToEUCJP{tables: tables, eudc: getEUDC("euc-jp", arg)}, nil
+}
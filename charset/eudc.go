@@ -0,0 +1,287 @@
+package charset
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// User-defined characters (EUDC, or gaiji in Japanese) occupy
+// areas of the double-byte character sets that are left for
+// private use. By default, the user-defined areas are mapped
+// to the Unicode Private Use Area, as Windows maps them:
+//
+//	Shift_JIS, CP932	f040..f9fc	U+E000..U+E757
+//	EUC-JP	f5a1..fefe	U+E000..U+E3AB
+//		8ff5a1..8ffefe	U+E3AC..U+E757
+//	Big5, CP950	fa40..fefe	U+E000..U+E310
+//		8e40..a0fe	U+E311..U+EEB7
+//		8140..8dfe	U+EEB8..U+F6B0
+//	CP950	c6a1..c8fe	U+F6B1..U+F848
+//
+// Big5-HKSCS assigns characters to most of these areas
+// and has no default mapping.
+// SetEUDC installs a table that overrides these mappings.
+
+// An EUDCTable maps character codes to runes. Each code is
+// the value of its bytes taken as a big-endian number;
+// for example, the Shift_JIS code f0 40 is 0xf040.
+type EUDCTable map[uint32]rune
+
+// udaRange describes a block of user-defined codes that maps
+// to consecutive private use characters.
+type udaRange struct {
+	prefix       byte // first byte of three-byte codes, or 0.
+	lead0, lead1 byte
+	trails       [][2]byte // ranges of trail bytes.
+	r0           rune
+}
+
+var (
+	sjisTrails = [][2]byte{{0x40, 0x7e}, {0x80, 0xfc}}
+	big5Trails = [][2]byte{{0x40, 0x7e}, {0xa1, 0xfe}}
+	eucTrails  = [][2]byte{{0xa1, 0xfe}}
+)
+
+var sjisUDA = []udaRange{
+	{0, 0xf0, 0xf9, sjisTrails, 0xe000},
+}
+
+var eucJPUDA = []udaRange{
+	{0, 0xf5, 0xfe, eucTrails, 0xe000},
+	{0x8f, 0xf5, 0xfe, eucTrails, 0xe3ac},
+}
+
+var big5UDA = []udaRange{
+	{0, 0xfa, 0xfe, big5Trails, 0xe000},
+	{0, 0x8e, 0xa0, big5Trails, 0xe311},
+	{0, 0x81, 0x8d, big5Trails, 0xeeb8},
+}
+
+// cp950UDA adds the rows that CP950 leaves for user-defined
+// characters; HKU Big 5 has the ETEN kana there.
+var cp950UDA = append(big5UDA[:len(big5UDA):len(big5UDA)],
+	udaRange{0, 0xc6, 0xc6, eucTrails, 0xf6b1},
+	udaRange{0, 0xc7, 0xc8, big5Trails, 0xf6b1 + 94},
+)
+
+// trailIndex returns the position of b among the trail
+// bytes, and the number of trail bytes.
+func (u *udaRange) trailIndex(b byte) (int, int) {
+	ix, n := -1, 0
+	for _, t := range u.trails {
+		if b >= t[0] && b <= t[1] {
+			ix = n + int(b-t[0])
+		}
+		n += int(t[1]-t[0]) + 1
+	}
+	return ix, n
+}
+
+// udaRune returns the private use character for the
+// given code, if it is in one of the ranges.
+func udaRune(ranges []udaRange, code uint32) (rune, bool) {
+	prefix, lead, trail := byte(code>>16), byte(code>>8), byte(code)
+	for i := range ranges {
+		u := &ranges[i]
+		if prefix != u.prefix || lead < u.lead0 || lead > u.lead1 {
+			continue
+		}
+		ix, n := u.trailIndex(trail)
+		if ix < 0 {
+			return 0, false
+		}
+		return u.r0 + rune(int(lead-u.lead0)*n+ix), true
+	}
+	return 0, false
+}
+
+// udaCode returns the code for the given private use
+// character, if it is in one of the ranges.
+func udaCode(ranges []udaRange, r rune) (uint32, bool) {
+	for i := range ranges {
+		u := &ranges[i]
+		_, n := u.trailIndex(0)
+		ix := int(r - u.r0)
+		if ix < 0 || ix >= int(u.lead1-u.lead0+1)*n {
+			continue
+		}
+		lead, t := u.lead0+byte(ix/n), ix%n
+		for _, tr := range u.trails {
+			if size := int(tr[1]-tr[0]) + 1; t >= size {
+				t -= size
+				continue
+			}
+			return uint32(u.prefix)<<16 | uint32(lead)<<8 | uint32(tr[0]+byte(t)), true
+		}
+	}
+	return 0, false
+}
+
+// eudcClasses holds the names of the classes
+// that support SetEUDC.
+var eudcClasses = []string{"big5", "cp932", "euc-jp"}
+
+// eudcMaps holds both directions of an installed EUDCTable.
+type eudcMaps struct {
+	runes map[uint32]rune
+	codes map[rune]uint32
+}
+
+func newEUDCMaps(t EUDCTable) *eudcMaps {
+	m := &eudcMaps{
+		runes: make(map[uint32]rune),
+		codes: make(map[rune]uint32),
+	}
+	for code, r := range t {
+		m.runes[code] = r
+		if old, ok := m.codes[r]; !ok || code < old {
+			m.codes[r] = code
+		}
+	}
+	return m
+}
+
+// decode returns the rune for code: from m if it holds one,
+// otherwise r, the rune given by the character set's own
+// tables, or the default private use character if r is
+// utf8.RuneError.
+func (m *eudcMaps) decode(uda []udaRange, code uint32, r rune) rune {
+	if m != nil {
+		if cr, ok := m.runes[code]; ok {
+			return cr
+		}
+	}
+	if r == utf8.RuneError {
+		if ur, ok := udaRune(uda, code); ok {
+			return ur
+		}
+	}
+	return r
+}
+
+// encode returns the code for r if it is a user-defined
+// character, either in m or by default.
+func (m *eudcMaps) encode(uda []udaRange, r rune) (uint32, bool) {
+	if m != nil {
+		if code, ok := m.codes[r]; ok {
+			return code, true
+		}
+	}
+	code, ok := udaCode(uda, r)
+	if ok && m.overrides(code) {
+		return 0, false
+	}
+	return code, ok
+}
+
+// overrides reports whether m maps code to a rune
+// of its own.
+func (m *eudcMaps) overrides(code uint32) bool {
+	if m == nil {
+		return false
+	}
+	_, ok := m.runes[code]
+	return ok
+}
+
+var (
+	eudcMutex  sync.Mutex
+	eudcTables = make(map[string]*eudcMaps)
+)
+
+func eudcKey(class, arg string) string {
+	return class + " " + arg
+}
+
+// getEUDC returns the table installed for the given
+// class and argument, or nil if there is none.
+func getEUDC(class, arg string) *eudcMaps {
+	eudcMutex.Lock()
+	defer eudcMutex.Unlock()
+	return eudcTables[eudcKey(class, arg)]
+}
+
+// SetEUDC installs t as the table of user-defined characters
+// for the named character set. It overrides the default
+// mapping in both directions, for translators created
+// after the call. When two codes in t map to the same
+// rune, the encoder uses the lower code.
+// If t is nil, the default mapping is restored.
+func SetEUDC(charset string, t EUDCTable) error {
+	localFactory{}.init()
+	cs := localCharsets[NormalizedName(charset)]
+	if cs == nil {
+		return fmt.Errorf("charset: character set %q not found", charset)
+	}
+	className := ""
+	for _, name := range eudcClasses {
+		if cs.class == classes[name] {
+			className = name
+		}
+	}
+	if className == "" {
+		return fmt.Errorf("charset: %q has no user-defined characters", charset)
+	}
+	var m *eudcMaps
+	if t != nil {
+		m = newEUDCMaps(t)
+	}
+	eudcMutex.Lock()
+	defer eudcMutex.Unlock()
+	key := eudcKey(className, cs.arg)
+	if m == nil {
+		delete(eudcTables, key)
+	} else {
+		eudcTables[key] = m
+	}
+	return nil
+}
+
+// ReadEUDCTable reads an EUDCTable in the format of the Unicode
+// Consortium mapping files: each line holds a code and a
+// Unicode code point in hexadecimal, such as
+//
+//	0xF040	0xE000	# comment
+//
+// Blank lines and text following '#' are ignored.
+func ReadEUDCTable(r io.Reader) (EUDCTable, error) {
+	t := make(EUDCTable)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		f := strings.Fields(text)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 2 {
+			return nil, fmt.Errorf("charset: bad EUDC table line %d", line)
+		}
+		code, err := parseHex(f[0])
+		if err != nil {
+			return nil, fmt.Errorf("charset: bad EUDC table line %d: %v", line, err)
+		}
+		r, err := parseHex(f[1])
+		if err != nil {
+			return nil, fmt.Errorf("charset: bad EUDC table line %d: %v", line, err)
+		}
+		t[uint32(code)] = rune(r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func parseHex(s string) (uint64, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	s = strings.TrimPrefix(s, "U+")
+	return strconv.ParseUint(s, 16, 32)
+}
@@ -0,0 +1,282 @@
+package charset
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Encoder fallbacks
+//
+// Before an encoder replaces a character that its character set
+// does not hold, it tries some alternatives, in order:
+//
+//	- the canonical composition of the character and any
+//	  combining marks that follow it, so that "é" is
+//	  encoded as "é";
+//	- if the BestFit option is given, the best fit mapping
+//	  of each character that cannot be encoded (see bestfit.go);
+//	- if the Romanize option is given, the romanization of
+//	  runs of letters in other scripts (see romanize.go);
+//	- if the Transliterate option is given, an approximation
+//	  of each character that cannot be encoded (see translitTable).
+//
+// The fallbacks apply to the encoders that can report which
+// characters their character set holds (see runeEncoder).
+// Text is passed to the encoder as it is up to the first
+// character that it cannot encode; only the normalization
+// segment of that character is composed or replaced.
+
+// A runeEncoder is a translator to a character set that
+// can report which characters the character set holds.
+type runeEncoder interface {
+	Translator
+	encodes(r rune) bool
+}
+
+// Transliterate returns an Option that makes an encoder
+// approximate characters that its character set does not
+// hold, before replacing them: letters lose their diacritics,
+// ligatures are spelled out, and typographic punctuation
+// such as curly quotes, dashes and the ellipsis becomes ASCII,
+// so that "Ångström — “quoted”" is encoded in US-ASCII
+// as "Angstrom - \"quoted\"".
+func Transliterate() Option {
+	return func(o *options) {
+		o.translit = true
+	}
+}
+
+// Romanize returns an Option that makes an encoder write
+// the letters of other scripts that its character set does
+// not hold in Latin letters, using the named schemes, which
+// are tried in order (see romanize.go for the list). It
+// implies Transliterate, so that Latin letters with
+// diacritics that the character set does not hold are
+// approximated.
+func Romanize(schemes ...string) Option {
+	return func(o *options) {
+		o.romanize = append(o.romanize, schemes...)
+		o.translit = true
+	}
+}
+
+const translitSuffix = "//translit"
+
+// splitTranslit returns the name of a character set without
+// any "//TRANSLIT" suffix, and whether the suffix was present.
+func splitTranslit(name string) (string, bool) {
+	if n := len(name) - len(translitSuffix); n >= 0 && strings.EqualFold(name[n:], translitSuffix) {
+		return name[:n], true
+	}
+	return name, false
+}
+
+// translitTable holds the approximations of characters that
+// cannot be found by removing the marks from their
+// compatibility decomposition.
+var translitTable = map[rune]string{
+	'Æ': "AE", 'æ': "ae", 'Œ': "OE", 'œ': "oe", 'ß': "ss", 'ẞ': "SS",
+	'Ø': "O", 'ø': "o", 'Đ': "D", 'đ': "d", 'Ð': "D", 'ð': "d",
+	'Ł': "L", 'ł': "l", 'Þ': "TH", 'þ': "th", 'Ħ': "H", 'ħ': "h",
+	'ı': "i", 'ĸ': "q", 'Ŧ': "T", 'ŧ': "t", 'ƒ': "f",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", 'ʹ': "'", 'ʼ': "'",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`, '″': `"`,
+	'‹': "<", '›': ">", '«': "<<", '»': ">>",
+	'…': "...", '•': "*", '·': ".", '⁄': "/", '×': "x", '÷': "/",
+	'©': "(C)", '®': "(R)", '€': "EUR", '¢': "c", '£': "GBP", '¥': "JPY",
+	'¡': "!", '¿': "?", '§': "S", '¶': "P", '°': "o",
+	'\u00ad': "", '\u200b': "", '\u2060': "", '\ufeff': "",
+}
+
+// fallbackEncoder rewrites the text passed to an encoder,
+// replacing the characters that the encoder cannot encode
+// as described above.
+type fallbackEncoder struct {
+	*normTables
+	enc      runeEncoder
+	fits     bestFitEncoder // nil unless the BestFit option is given.
+	translit bool
+	ascii    [utf8.RuneSelf]bool // whether enc encodes each ASCII character.
+	runes    []rune
+	composed []rune
+	scratch  []byte
+}
+
+// newFallbackEncoder returns a translator that encodes using
+// enc, with the fallbacks selected by o. If the normalization
+// data is not available and no romanization is needed, it
+// returns enc.
+func newFallbackEncoder(enc runeEncoder, o *options) (Translator, error) {
+	t, err := getNormTables()
+	if err != nil {
+		if len(o.romanize) > 0 {
+			return nil, err
+		}
+		return enc, nil
+	}
+	p := &fallbackEncoder{normTables: t, enc: enc, translit: o.translit}
+	for r := range p.ascii {
+		p.ascii[r] = enc.encodes(rune(r))
+	}
+	if fits, ok := enc.(bestFitEncoder); ok && o.bestFit {
+		p.fits = fits
+	}
+	var tr Translator = p
+	if len(o.romanize) > 0 {
+		rom, err := newRomanizer(o.romanize, enc)
+		if err != nil {
+			return nil, err
+		}
+		tr = &chainedTranslator{first: rom, second: tr}
+	}
+	return &chainedTranslator{first: tr, second: enc}, nil
+}
+
+func (p *fallbackEncoder) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		k := n + p.encodable(data[n:])
+		if k == len(data) && eof {
+			p.scratch = append(p.scratch, data[n:]...)
+			return len(data), p.scratch, nil
+		}
+		// the segment holding data[k], or the last segment,
+		// which may continue in the next call, is passed
+		// to the fallbacks.
+		start := n + p.segmentStart(data[n:], k-n)
+		p.scratch = append(p.scratch, data[n:start]...)
+		if k == len(data) && start > n {
+			return start, p.scratch, nil
+		}
+		n = start
+		size, ok := p.segment(data[n:], NFC, eof)
+		if !ok && !eof && (n > 0 || size < maxNormSegment) || size == 0 {
+			break
+		}
+		p.fallback(data[n : n+size])
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+// encodable returns the length of the longest prefix of data
+// that holds only complete characters that the encoder can
+// encode.
+func (p *fallbackEncoder) encodable(data []byte) int {
+	n := 0
+	for n < len(data) {
+		if b := data[n]; b < utf8.RuneSelf {
+			if !p.ascii[b] {
+				break
+			}
+			n++
+			continue
+		}
+		if !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if !p.enc.encodes(r) {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// segmentStart returns the start of the NFC segment of data
+// that holds data[k], or that ends data, if k is len(data)
+// or data[k] starts an incomplete character. The start of
+// data is always taken as a segment boundary.
+func (p *fallbackEncoder) segmentStart(data []byte, k int) int {
+	if k < len(data) && utf8.FullRune(data[k:]) {
+		if r, _ := utf8.DecodeRune(data[k:]); p.boundaryBefore(r, NFC) {
+			return k
+		}
+	}
+	i := k
+	for i > 0 {
+		r, size := utf8.DecodeLastRune(data[:i])
+		i -= size
+		if i == 0 || p.boundaryBefore(r, NFC) {
+			break
+		}
+	}
+	return i
+}
+
+// fallback appends the segment seg to the output,
+// with the fallbacks applied if the encoder cannot
+// encode it as it is.
+func (p *fallbackEncoder) fallback(seg []byte) {
+	p.runes = p.runes[:0]
+	for _, r := range string(seg) {
+		p.runes = append(p.runes, r)
+	}
+	if p.encodesAll(p.runes) {
+		p.scratch = append(p.scratch, seg...)
+		return
+	}
+	if len(p.runes) == 1 && !p.translit && p.fits == nil && !p.decomposes(p.runes[0]) {
+		// composition would leave the character as it is.
+		p.scratch = append(p.scratch, seg...)
+		return
+	}
+	p.composed = p.nfc(p.composed[:0], p.runes)
+	if !p.encodesAll(p.composed) && !p.translit && p.fits == nil {
+		p.scratch = append(p.scratch, seg...)
+		return
+	}
+	for _, r := range p.composed {
+		if p.enc.encodes(r) {
+			p.scratch = appendRune(p.scratch, r)
+			continue
+		}
+		if p.fits != nil {
+			if fit, ok := p.fits.bestFit(r); ok && p.enc.encodes(fit) {
+				p.scratch = appendRune(p.scratch, fit)
+				continue
+			}
+		}
+		if p.translit {
+			if s, ok := p.transliterate(r); ok {
+				p.scratch = append(p.scratch, s...)
+				continue
+			}
+		}
+		p.scratch = appendRune(p.scratch, r)
+	}
+}
+
+func (p *fallbackEncoder) encodesAll(s []rune) bool {
+	for _, r := range s {
+		if !p.enc.encodes(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// transliterate returns an approximation of r that the
+// encoder can encode, if there is one.
+func (p *fallbackEncoder) transliterate(r rune) (string, bool) {
+	if s, ok := translitTable[r]; ok {
+		return s, p.encodesAll([]rune(s))
+	}
+	var s []rune
+	for _, d := range p.decomposeForm(nil, r, true) {
+		switch {
+		case p.ccc[d] != 0 || isMark(d):
+			// drop diacritics.
+		case p.enc.encodes(d):
+			s = append(s, d)
+		case translitTable[d] != "" && p.encodesAll([]rune(translitTable[d])):
+			s = append(s, []rune(translitTable[d])...)
+		default:
+			return "", false
+		}
+	}
+	return string(s), true
+}
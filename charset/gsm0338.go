@@ -0,0 +1,412 @@
+package charset
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("gsm0338", fromGSM, toGSM)
+}
+
+// encoding details
+// GSM 03.38 (3GPP TS 23.038) 7-bit default alphabet
+//
+// 00..7f	septets of the locking shift table
+// 1b		escape to the single shift (extension) table
+//
+// The class argument is a comma-separated list of options:
+//
+//	packed	septets are packed into octets, LSB first, as in an SMS TPDU.
+//		Otherwise each septet is stored in its own byte.
+//	lock=xx	use the national language locking shift table for language xx.
+//	shift=xx	use the national language single shift table for language xx.
+//
+// A language is given by its ISO 639-1 code or by its national language
+// identifier from TS 23.038 section 6.2.1.2.4 (as found in the national
+// language shift information elements of an SMS user data header).
+// Only the Turkish (1), Spanish (2, single shift only) and Portuguese (3)
+// tables are supported; the Indic tables (4 to 13) are not, and selecting
+// them is an error rather than a silent fallback to the default alphabet.
+//
+// Notes
+//
+// An escape followed by a code that is not in the single shift
+// table is decoded as the character from the locking shift table,
+// as TS 23.038 requires.
+//
+// When a packed message ends with seven spare bits, the encoder
+// fills them with CR, and the decoder drops a CR found in that
+// position, as TS 23.038 recommends.
+
+const (
+	gsmEscape  = 0x1b
+	gsmPadding = 0x0d
+)
+
+// gsmTable holds a locking shift or single shift table.
+// Zero entries are undefined.
+type gsmTable [128]rune
+
+var gsmDefault = gsmTable{
+	'@', '£', '$', '¥', 'è', 'é', 'ù', 'ì', 'ò', 'Ç', '\n', 'Ø', 'ø', '\r', 'Å', 'å',
+	'Δ', '_', 'Φ', 'Γ', 'Λ', 'Ω', 'Π', 'Ψ', 'Σ', 'Θ', 'Ξ', 0, 'Æ', 'æ', 'ß', 'É',
+	' ', '!', '"', '#', '¤', '%', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/',
+	'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ':', ';', '<', '=', '>', '?',
+	'¡', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O',
+	'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'Ä', 'Ö', 'Ñ', 'Ü', '§',
+	'¿', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o',
+	'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', 'ä', 'ö', 'ñ', 'ü', 'à',
+}
+
+var gsmDefaultExt = gsmTable{
+	0x0a: '\f',
+	0x14: '^',
+	0x28: '{',
+	0x29: '}',
+	0x2f: '\\',
+	0x3c: '[',
+	0x3d: '~',
+	0x3e: ']',
+	0x40: '|',
+	0x65: '€',
+}
+
+// gsmLocking holds the national language locking shift tables,
+// indexed by language.
+var gsmLocking = map[string]*gsmTable{
+	"tr": {
+		'@', '£', '$', '¥', '€', 'é', 'ù', 'ı', 'ò', 'Ç', '\n', 'Ğ', 'ğ', '\r', 'Å', 'å',
+		'Δ', '_', 'Φ', 'Γ', 'Λ', 'Ω', 'Π', 'Ψ', 'Σ', 'Θ', 'Ξ', 0, 'Ş', 'ş', 'ß', 'É',
+		' ', '!', '"', '#', '¤', '%', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/',
+		'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ':', ';', '<', '=', '>', '?',
+		'İ', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O',
+		'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'Ä', 'Ö', 'Ñ', 'Ü', '§',
+		'ç', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o',
+		'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', 'ä', 'ö', 'ñ', 'ü', 'à',
+	},
+	"pt": {
+		'@', '£', '$', '¥', 'ê', 'é', 'ú', 'í', 'ó', 'ç', '\n', 'Ô', 'ô', '\r', 'Á', 'á',
+		'Δ', '_', 'ª', 'Ç', 'À', '∞', '^', '\\', '€', 'Ó', '|', 0, 'Â', 'â', 'Ê', 'É',
+		' ', '!', '"', '#', 'º', '%', '&', '\'', '(', ')', '*', '+', ',', '-', '.', '/',
+		'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ':', ';', '<', '=', '>', '?',
+		'Í', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O',
+		'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'Ã', 'Õ', 'Ú', 'Ü', '§',
+		'~', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o',
+		'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', 'ã', 'õ', '`', 'ü', 'à',
+	},
+}
+
+// gsmShift holds the national language single shift tables,
+// indexed by language.
+var gsmShift = map[string]*gsmTable{
+	"tr": {
+		0x0a: '\f', 0x14: '^', 0x28: '{', 0x29: '}', 0x2f: '\\',
+		0x3c: '[', 0x3d: '~', 0x3e: ']', 0x40: '|',
+		0x47: 'Ğ', 0x49: 'İ', 0x53: 'Ş', 0x63: 'ç', 0x65: '€',
+		0x67: 'ğ', 0x69: 'ı', 0x73: 'ş',
+	},
+	"es": {
+		0x09: 'ç', 0x0a: '\f', 0x14: '^', 0x28: '{', 0x29: '}', 0x2f: '\\',
+		0x3c: '[', 0x3d: '~', 0x3e: ']', 0x40: '|',
+		0x41: 'Á', 0x49: 'Í', 0x4f: 'Ó', 0x55: 'Ú',
+		0x61: 'á', 0x65: '€', 0x69: 'í', 0x6f: 'ó', 0x75: 'ú',
+	},
+	"pt": {
+		0x05: 'ê', 0x09: 'ç', 0x0a: '\f', 0x0b: 'Ô', 0x0c: 'ô', 0x0e: 'Á', 0x0f: 'á',
+		0x12: 'Φ', 0x13: 'Γ', 0x14: '^', 0x15: 'Ω', 0x16: 'Π', 0x17: 'Ψ', 0x18: 'Σ', 0x19: 'Θ',
+		0x1f: 'Ê', 0x28: '{', 0x29: '}', 0x2f: '\\',
+		0x3c: '[', 0x3d: '~', 0x3e: ']', 0x40: '|',
+		0x41: 'À', 0x49: 'Í', 0x4f: 'Ó', 0x55: 'Ú', 0x5b: 'Ã', 0x5c: 'Õ',
+		0x61: 'Â', 0x65: '€', 0x69: 'í', 0x6f: 'ó', 0x75: 'ú', 0x7b: 'ã', 0x7c: 'õ', 0x7f: 'â',
+	},
+}
+
+// gsmLanguages maps the national language identifiers of
+// TS 23.038 to ISO 639-1 codes.
+var gsmLanguages = []string{
+	1:  "tr",
+	2:  "es",
+	3:  "pt",
+	4:  "bn",
+	5:  "gu",
+	6:  "hi",
+	7:  "kn",
+	8:  "ml",
+	9:  "or",
+	10: "pa",
+	11: "ta",
+	12: "te",
+	13: "ur",
+}
+
+// gsmLanguageTable returns the table for the given language
+// from tables, which holds locking or single shift tables
+// of the given kind.
+func gsmLanguageTable(tables map[string]*gsmTable, lang, kind string) (*gsmTable, error) {
+	if id, err := strconv.Atoi(lang); err == nil && id > 0 && id < len(gsmLanguages) {
+		lang = gsmLanguages[id]
+	}
+	if t := tables[lang]; t != nil {
+		return t, nil
+	}
+	for _, l := range gsmLanguages {
+		if l == lang {
+			return nil, fmt.Errorf("charset: gsm0338 %s table for language %q is not supported", kind, lang)
+		}
+	}
+	return nil, fmt.Errorf("charset: unknown gsm0338 language %q", lang)
+}
+
+// gsmAlphabet holds the tables selected by a class argument.
+type gsmAlphabet struct {
+	packed  bool
+	locking *gsmTable
+	shift   *gsmTable
+
+	// reverse mappings, used for encoding.
+	fromLocking map[rune]byte
+	fromShift   map[rune]byte
+}
+
+type gsmKey string
+
+func getGSMAlphabet(arg string) (*gsmAlphabet, error) {
+	a, err := cache(gsmKey(arg), func() (interface{}, error) {
+		a := &gsmAlphabet{
+			locking: &gsmDefault,
+			shift:   &gsmDefaultExt,
+		}
+		for _, opt := range strings.Split(arg, ",") {
+			var err error
+			switch {
+			case opt == "":
+			case opt == "packed":
+				a.packed = true
+			case strings.HasPrefix(opt, "lock="):
+				a.locking, err = gsmLanguageTable(gsmLocking, opt[len("lock="):], "locking shift")
+			case strings.HasPrefix(opt, "shift="):
+				a.shift, err = gsmLanguageTable(gsmShift, opt[len("shift="):], "single shift")
+			default:
+				err = fmt.Errorf("charset: unknown gsm0338 option %q", opt)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		a.fromLocking = make(map[rune]byte)
+		a.fromShift = make(map[rune]byte)
+		for i, r := range a.locking {
+			if r != 0 {
+				a.fromLocking[r] = byte(i)
+			}
+		}
+		for i, r := range a.shift {
+			if _, ok := a.fromLocking[r]; r != 0 && !ok {
+				a.fromShift[r] = byte(i)
+			}
+		}
+		return a, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.(*gsmAlphabet), nil
+}
+
+type translateFromGSM struct {
+	*gsmAlphabet
+	septets []byte
+	scratch []byte
+}
+
+func (p *translateFromGSM) Translate(data []byte, eof bool) (int, []byte, error) {
+	n := len(data)
+	if p.packed {
+		if !eof {
+			// only unpack complete groups of 7 octets, and
+			// keep the last group back so that we can
+			// tell whether it ends with padding.
+			n = (n - 1) / 7 * 7
+		}
+		p.septets = unpackSeptets(p.septets[:0], data[:n])
+		if eof && n%7 == 0 && len(p.septets) > 0 && p.septets[len(p.septets)-1] == gsmPadding {
+			p.septets = p.septets[:len(p.septets)-1]
+		}
+	} else {
+		p.septets = append(p.septets[:0], data...)
+	}
+	septets := p.septets
+	if !eof && len(septets) > 0 && septets[len(septets)-1] == gsmEscape {
+		// wait to see the character after the escape.
+		if p.packed {
+			n -= 7
+			septets = septets[:len(septets)-8]
+		} else {
+			n--
+			septets = septets[:len(septets)-1]
+		}
+	}
+	p.scratch = p.scratch[:0]
+	for i := 0; i < len(septets); i++ {
+		c := septets[i]
+		if c >= 0x80 {
+			p.scratch = append(p.scratch, errorBytes...)
+			continue
+		}
+		if c != gsmEscape {
+			p.scratch = appendRune(p.scratch, p.locking[c])
+			continue
+		}
+		i++
+		if i >= len(septets) {
+			// drop a trailing escape.
+			break
+		}
+		c = septets[i]
+		r := rune(0)
+		if c < 0x80 {
+			r = p.shift[c]
+			if r == 0 {
+				r = p.locking[c]
+			}
+		}
+		if r == 0 {
+			r = utf8.RuneError
+		}
+		p.scratch = appendRune(p.scratch, r)
+	}
+	return n, p.scratch, nil
+}
+
+// unpackSeptets appends to buf the septets packed into data.
+// Any trailing bits that do not make a whole septet are ignored.
+func unpackSeptets(buf, data []byte) []byte {
+	var acc uint
+	bits := uint(0)
+	for _, b := range data {
+		acc |= uint(b) << bits
+		bits += 8
+		for bits >= 7 {
+			buf = append(buf, byte(acc&0x7f))
+			acc >>= 7
+			bits -= 7
+		}
+	}
+	return buf
+}
+
+// packSeptets appends to buf the septets in septets, packed into
+// octets. If the number of septets is not a multiple of 8, the
+// final octet is padded with zero bits.
+func packSeptets(buf, septets []byte) []byte {
+	var acc uint
+	bits := uint(0)
+	for _, c := range septets {
+		acc |= uint(c&0x7f) << bits
+		bits += 7
+		if bits >= 8 {
+			buf = append(buf, byte(acc))
+			acc >>= 8
+			bits -= 8
+		}
+	}
+	if bits > 0 {
+		buf = append(buf, byte(acc))
+	}
+	return buf
+}
+
+type translateToGSM struct {
+	*gsmAlphabet
+	septets []byte // septets not yet packed.
+	scratch []byte
+}
+
+// appendSeptets appends the septets encoding r to buf.
+// It reports whether r can be represented in the alphabet.
+func (a *gsmAlphabet) appendSeptets(buf []byte, r rune) ([]byte, bool) {
+	if c, ok := a.fromLocking[r]; ok {
+		return append(buf, c), true
+	}
+	if c, ok := a.fromShift[r]; ok {
+		return append(buf, gsmEscape, c), true
+	}
+	return append(buf, a.fromLocking['?']), false
+}
+
+func (p *translateToGSM) Translate(data []byte, eof bool) (int, []byte, error) {
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		p.septets, _ = p.appendSeptets(p.septets, r)
+		n += size
+	}
+	if !p.packed {
+		p.scratch = append(p.scratch[:0], p.septets...)
+		p.septets = p.septets[:0]
+		return n, p.scratch, nil
+	}
+	// only pack complete groups of 8 septets until eof.
+	m := len(p.septets)
+	if eof {
+		if m%8 == 7 {
+			p.septets = append(p.septets, gsmPadding)
+			m++
+		}
+	} else {
+		m -= m % 8
+	}
+	p.scratch = packSeptets(p.scratch[:0], p.septets[:m])
+	p.septets = p.septets[:copy(p.septets, p.septets[m:])]
+	return n, p.scratch, nil
+}
+
+// GSMSeptets returns the number of septets needed to encode
+// the UTF-8 string s in the named GSM 03.38 character set,
+// counting an escape to the single shift table as a septet.
+// It returns an error if the character set is not a GSM 03.38
+// character set or if s contains a character that cannot
+// be represented in it.
+func GSMSeptets(charset, s string) (int, error) {
+	localFactory{}.init()
+	cs := localCharsets[NormalizedName(charset)]
+	if cs == nil || cs.class != classes["gsm0338"] {
+		return 0, fmt.Errorf("charset: %q is not a gsm0338 character set", charset)
+	}
+	a, err := getGSMAlphabet(cs.arg)
+	if err != nil {
+		return 0, err
+	}
+	var buf [2]byte
+	n := 0
+	for _, r := range s {
+		septets, ok := a.appendSeptets(buf[:0], r)
+		if !ok {
+			return 0, fmt.Errorf("charset: %q cannot represent %q", cs.Name, r)
+		}
+		n += len(septets)
+	}
+	return n, nil
+}
+
+func fromGSM(arg string) (Translator, error) {
+	a, err := getGSMAlphabet(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromGSM{gsmAlphabet: a}, nil
+}
+
+func toGSM(arg string) (Translator, error) {
+	a, err := getGSMAlphabet(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToGSM{gsmAlphabet: a}, nil
+}
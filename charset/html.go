@@ -0,0 +1,403 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+)
+
+// HTML encoding sniffing
+//
+// NewHTMLReader decides the character set of an HTML document
+// as a browser does (see "determining the character encoding"
+// in the WHATWG HTML standard), using the first of:
+//
+//	- a UTF-8 or UTF-16 byte order mark;
+//	- the charset parameter of the HTTP Content-Type header;
+//	- a <meta charset> or <meta http-equiv="Content-Type">
+//	  element found by prescanning the first 1024 bytes;
+//	- the fallback character set (by default Windows-1252,
+//	  which browsers use in most locales).
+//
+// Character sets are named by their labels in the WHATWG
+// Encoding Standard, and the document is decoded as the
+// standard does (see WHATWG).
+//
+// A character set found by the prescan is tentative: a browser
+// that finds a different <meta> element while parsing the
+// document will reload it in that character set.
+
+// maxHTMLPrescan is the number of bytes examined by the prescan.
+const maxHTMLPrescan = 1024
+
+// An HTMLRule is a rule that decides the character set of an
+// HTML document.
+type HTMLRule int
+
+const (
+	HTMLRuleBOM         HTMLRule = iota + 1 // A byte order mark.
+	HTMLRuleContentType                     // The Content-Type header.
+	HTMLRuleMeta                            // A <meta> element.
+	HTMLRuleFallback                        // The fallback character set.
+)
+
+func (r HTMLRule) String() string {
+	switch r {
+	case HTMLRuleBOM:
+		return "bom"
+	case HTMLRuleContentType:
+		return "content-type"
+	case HTMLRuleMeta:
+		return "meta"
+	case HTMLRuleFallback:
+		return "fallback"
+	}
+	return "unknown"
+}
+
+// An HTMLConfidence is the confidence of the decision of the
+// character set of an HTML document.
+type HTMLConfidence int
+
+const (
+	// HTMLTentative means that the document may turn out to be
+	// in another character set.
+	HTMLTentative HTMLConfidence = iota + 1
+	// HTMLCertain means that the character set is the one that
+	// the document must be decoded in.
+	HTMLCertain
+)
+
+func (c HTMLConfidence) String() string {
+	switch c {
+	case HTMLTentative:
+		return "tentative"
+	case HTMLCertain:
+		return "certain"
+	}
+	return "unknown"
+}
+
+// HTMLEncoding describes the decision of the character set
+// of an HTML document.
+type HTMLEncoding struct {
+	Charset    string
+	Rule       HTMLRule
+	Confidence HTMLConfidence
+}
+
+// HTMLFallback returns an Option that sets the character set
+// that NewHTMLReader uses when no other rule applies.
+func HTMLFallback(charset string) Option {
+	return func(o *options) {
+		o.htmlFallback = charset
+	}
+}
+
+const defaultHTMLFallback = "windows-1252"
+
+// NewHTMLReader returns a new Reader that translates the HTML
+// document read from r to UTF-8, and the decision of its
+// character set. The contentType argument holds the value of
+// the HTTP Content-Type header, if any. The options are also
+// passed to NewWHATWGReader, or to NewReader if the fallback
+// character set is not a WHATWG encoding.
+func NewHTMLReader(r io.Reader, contentType string, opts ...Option) (io.Reader, *HTMLEncoding, error) {
+	br := bufio.NewReader(r)
+	data, err := br.Peek(maxHTMLPrescan)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, nil, err
+	}
+	e := sniffHTML(data, contentType, getOptions(opts))
+	var rd io.Reader
+	if _, ok := WHATWGName(e.Charset); ok {
+		// NewWHATWGReader removes the byte order mark, if any.
+		rd, err = NewWHATWGReader(e.Charset, br, opts...)
+	} else {
+		rd, err = NewReader(e.Charset, br, opts...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return rd, e, nil
+}
+
+// sniffHTML returns the decision of the character set of the
+// HTML document that starts with data.
+func sniffHTML(data []byte, contentType string, o *options) *HTMLEncoding {
+	if name, n := whatwgBOM(data); n > 0 {
+		return &HTMLEncoding{name, HTMLRuleBOM, HTMLCertain}
+	}
+	if cs := contentTypeCharset(contentType); cs != "" {
+		if name, ok := WHATWGName(cs); ok {
+			return &HTMLEncoding{name, HTMLRuleContentType, HTMLCertain}
+		}
+	}
+	if name, ok := prescanHTML(data); ok {
+		return &HTMLEncoding{name, HTMLRuleMeta, HTMLTentative}
+	}
+	fallback := o.htmlFallback
+	if fallback == "" {
+		fallback = defaultHTMLFallback
+	}
+	if name, ok := WHATWGName(fallback); ok {
+		fallback = name
+	}
+	return &HTMLEncoding{fallback, HTMLRuleFallback, HTMLTentative}
+}
+
+// contentTypeCharset returns the charset parameter of a
+// Content-Type header value.
+func contentTypeCharset(contentType string) string {
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		return params["charset"]
+	}
+	cs, _ := metaContentCharset([]byte(contentType))
+	return cs
+}
+
+func isHTMLSpace(b byte) bool {
+	return b == '\t' || b == '\n' || b == '\f' || b == '\r' || b == ' '
+}
+
+func isASCIILetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// hasPrefixFold reports whether data starts with prefix,
+// which is in lower case, ignoring the case of ASCII letters.
+func hasPrefixFold(data []byte, prefix string) bool {
+	if len(data) < len(prefix) {
+		return false
+	}
+	for i := 0; i < len(prefix); i++ {
+		if lowerASCII(data[i]) != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// prescanHTML looks for the character set given by a <meta>
+// element in data, as described in "prescan a byte stream to
+// determine its encoding" in the WHATWG HTML standard.
+func prescanHTML(data []byte) (string, bool) {
+	if len(data) > maxHTMLPrescan {
+		data = data[:maxHTMLPrescan]
+	}
+	for i := 0; i < len(data); {
+		rest := data[i:]
+		switch {
+		case bytes.HasPrefix(rest, []byte("<!--")):
+			end := bytes.Index(data[i+2:], []byte("-->"))
+			if end < 0 {
+				return "", false
+			}
+			i += 2 + end + 3
+		case hasPrefixFold(rest, "<meta") && len(rest) > 5 && (isHTMLSpace(rest[5]) || rest[5] == '/'):
+			i += 5
+			if name, ok := prescanMeta(data, &i); ok {
+				return name, true
+			}
+		case len(rest) > 1 && rest[0] == '<' && isASCIILetter(rest[1]),
+			len(rest) > 2 && rest[0] == '<' && rest[1] == '/' && isASCIILetter(rest[2]):
+			// skip the tag name and attributes.
+			for i < len(data) && !isHTMLSpace(data[i]) && data[i] != '>' {
+				i++
+			}
+			for {
+				if _, _, ok := htmlAttribute(data, &i); !ok {
+					break
+				}
+			}
+			i++
+		case bytes.HasPrefix(rest, []byte("<!")), bytes.HasPrefix(rest, []byte("</")), bytes.HasPrefix(rest, []byte("<?")):
+			end := bytes.IndexByte(rest, '>')
+			if end < 0 {
+				return "", false
+			}
+			i += end + 1
+		default:
+			i++
+		}
+	}
+	return "", false
+}
+
+// prescanMeta reads the attributes of a <meta> element starting
+// at data[*i], and returns the character set that they give.
+func prescanMeta(data []byte, i *int) (string, bool) {
+	seen := make(map[string]bool)
+	gotPragma := false
+	needPragma := 0 // 0 for unknown, 1 for no, 2 for yes.
+	charset := ""
+	for {
+		name, value, ok := htmlAttribute(data, i)
+		if !ok {
+			break
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		switch name {
+		case "http-equiv":
+			if value == "content-type" {
+				gotPragma = true
+			}
+		case "content":
+			if charset == "" {
+				if cs, ok := metaContentCharset([]byte(value)); ok {
+					if name, ok := WHATWGName(cs); ok {
+						charset, needPragma = name, 2
+					}
+				}
+			}
+		case "charset":
+			// an unknown label is ignored, and the first
+			// character set found is kept.
+			if charset == "" {
+				if name, ok := WHATWGName(value); ok {
+					charset, needPragma = name, 1
+				}
+			}
+		}
+	}
+	if *i >= len(data) {
+		// the prescan ran out of data.
+		return "", false
+	}
+	if needPragma == 0 || needPragma == 2 && !gotPragma {
+		return "", false
+	}
+	name := charset
+	switch name {
+	case "UTF-16BE", "UTF-16LE":
+		// a document that can be read this far is not UTF-16.
+		return "UTF-8", true
+	case "x-user-defined":
+		return "windows-1252", true
+	}
+	return name, true
+}
+
+// htmlAttribute reads the attribute at data[*i], as described
+// in "get an attribute" in the WHATWG HTML standard. The name
+// and value are returned in lower case. It returns false at
+// the end of the tag, or of data.
+func htmlAttribute(data []byte, i *int) (name, value string, ok bool) {
+	p := *i
+	defer func() {
+		*i = p
+	}()
+	for p < len(data) && (isHTMLSpace(data[p]) || data[p] == '/') {
+		p++
+	}
+	if p >= len(data) || data[p] == '>' {
+		return "", "", false
+	}
+	var n, v []byte
+	for {
+		if p >= len(data) {
+			return "", "", false
+		}
+		b := data[p]
+		if b == '=' && len(n) > 0 {
+			p++
+			break
+		}
+		if isHTMLSpace(b) {
+			for p < len(data) && isHTMLSpace(data[p]) {
+				p++
+			}
+			if p >= len(data) {
+				return "", "", false
+			}
+			if data[p] != '=' {
+				return string(n), "", true
+			}
+			p++
+			break
+		}
+		if b == '/' || b == '>' {
+			return string(n), "", true
+		}
+		n = append(n, lowerASCII(b))
+		p++
+	}
+	for p < len(data) && isHTMLSpace(data[p]) {
+		p++
+	}
+	if p >= len(data) {
+		return "", "", false
+	}
+	if q := data[p]; q == '"' || q == '\'' {
+		for p++; p < len(data); p++ {
+			if data[p] == q {
+				p++
+				return string(n), string(v), true
+			}
+			v = append(v, lowerASCII(data[p]))
+		}
+		return "", "", false
+	}
+	if data[p] == '>' {
+		return string(n), "", true
+	}
+	for ; p < len(data); p++ {
+		if isHTMLSpace(data[p]) || data[p] == '>' {
+			return string(n), string(v), true
+		}
+		v = append(v, lowerASCII(data[p]))
+	}
+	return "", "", false
+}
+
+func lowerASCII(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+	return b
+}
+
+// metaContentCharset returns the character set named in the
+// content attribute of a <meta> element, as described in
+// "extracting a character encoding from a meta element" in
+// the WHATWG HTML standard.
+func metaContentCharset(s []byte) (string, bool) {
+	for {
+		for len(s) > 0 && !hasPrefixFold(s, "charset") {
+			s = s[1:]
+		}
+		if len(s) == 0 {
+			return "", false
+		}
+		s = s[len("charset"):]
+		for len(s) > 0 && isHTMLSpace(s[0]) {
+			s = s[1:]
+		}
+		if len(s) > 0 && s[0] == '=' {
+			s = s[1:]
+			break
+		}
+	}
+	for len(s) > 0 && isHTMLSpace(s[0]) {
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return "", false
+	}
+	if q := s[0]; q == '"' || q == '\'' {
+		end := bytes.IndexByte(s[1:], q)
+		if end < 0 {
+			return "", false
+		}
+		return string(s[1 : 1+end]), true
+	}
+	end := 0
+	for end < len(s) && !isHTMLSpace(s[end]) && s[end] != ';' {
+		end++
+	}
+	return string(s[:end]), true
+}
@@ -0,0 +1,219 @@
+package charset
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// IANA character set registry
+//
+// The IANA registry of character sets gives each one a name,
+// aliases, a preferred MIME name, and a number, the MIBenum,
+// by which protocols such as SNMP identify it. The data file
+// iana-charsets.txt holds a copy of the registry, generated
+// by data/generate-iana.go from data/character-sets.xml, and
+// codepages.txt holds the Windows code pages (as found in RTF
+// \ansicpg and DBF language driver IDs) and the IBM CCSIDs
+// (as used by DB2) of character sets.
+//
+// Info fills in the registry information of the character
+// sets that it returns, by matching their names and aliases.
+// The names and aliases in the registry can be used wherever
+// a character set name is expected, and ByMIB, ByCodePage and
+// ByCCSID find character sets by number.
+
+var (
+	readRegistryOnce sync.Once
+	ianaMIBs         = make(map[int]*ianaEntry)
+	ianaNames        = make(map[string]*ianaEntry) // by normalized name and alias.
+	codePages        []*codePageEntry
+	codePageNames    = make(map[string]*codePageEntry)
+)
+
+// An ianaEntry is an entry in the IANA registry.
+type ianaEntry struct {
+	mib      int
+	name     string
+	mimeName string
+	aliases  []string
+}
+
+// A codePageEntry gives the Windows code page and IBM CCSID
+// of a character set.
+type codePageEntry struct {
+	name     string
+	codePage int
+	ccsid    int
+}
+
+// readRegistry reads the registry data files.
+// It's done once only, when first needed.
+func readRegistry() {
+	lines, err := readTable("iana-charsets.txt", 4)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "charset: %v\n", err)
+		return
+	}
+	for _, f := range lines {
+		mib, err := strconv.Atoi(f[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "charset: bad MIBenum %q in \"iana-charsets.txt\"\n", f[0])
+			return
+		}
+		e := &ianaEntry{
+			mib:      mib,
+			name:     f[1],
+			mimeName: f[2],
+			aliases:  strings.Fields(f[3]),
+		}
+		if e.mimeName == "-" {
+			e.mimeName = e.name
+		}
+		ianaMIBs[mib] = e
+		ianaNames[NormalizedName(e.name)] = e
+		for _, a := range e.aliases {
+			ianaNames[NormalizedName(a)] = e
+		}
+	}
+
+	lines, err = readTable("codepages.txt", 3)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "charset: %v\n", err)
+		return
+	}
+	for _, f := range lines {
+		e := &codePageEntry{name: f[0]}
+		e.codePage, err = parseNumber(f[1])
+		if err == nil {
+			e.ccsid, err = parseNumber(f[2])
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "charset: bad line for %q in \"codepages.txt\"\n", f[0])
+			return
+		}
+		codePages = append(codePages, e)
+		codePageNames[NormalizedName(e.name)] = e
+	}
+}
+
+// readTable reads the lines of a data file that holds a table
+// with the given number of tab-separated fields. Empty lines
+// and lines starting with '#' are ignored.
+func readTable(file string, nfields int) ([][]string, error) {
+	data, err := readFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %q: %v", file, err)
+	}
+	var lines [][]string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Split(line, "\t")
+		if len(f) != nfields {
+			return nil, fmt.Errorf("bad line %q in %q", line, file)
+		}
+		lines = append(lines, f)
+	}
+	return lines, nil
+}
+
+// parseNumber parses a number in a table, which is "-"
+// if there is none.
+func parseNumber(s string) (int, error) {
+	if s == "-" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// registryNames returns the other names and aliases in the
+// registry of the character set with the given name.
+func registryNames(name string) []string {
+	readRegistryOnce.Do(readRegistry)
+	e := ianaNames[NormalizedName(name)]
+	if e == nil {
+		return nil
+	}
+	var names []string
+	for _, n := range append([]string{e.name}, e.aliases...) {
+		if NormalizedName(n) != NormalizedName(name) {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// addRegistryInfo fills in the registry information of cs
+// from the first of its names that is in each table.
+func addRegistryInfo(cs *Charset) {
+	readRegistryOnce.Do(readRegistry)
+	names := append([]string{cs.Name}, cs.Aliases...)
+	for _, name := range names {
+		if e := ianaNames[NormalizedName(name)]; e != nil {
+			cs.MIMEName = e.mimeName
+			cs.MIB = e.mib
+			break
+		}
+	}
+	for _, name := range names {
+		if e := codePageNames[NormalizedName(name)]; e != nil {
+			cs.CodePage = e.codePage
+			cs.CCSID = e.ccsid
+			break
+		}
+	}
+}
+
+// ByMIB returns information about the character set with the
+// given IANA MIBenum, or nil if it is not registered or
+// not supported.
+func ByMIB(mib int) *Charset {
+	readRegistryOnce.Do(readRegistry)
+	e := ianaMIBs[mib]
+	if e == nil {
+		return nil
+	}
+	for _, name := range append([]string{e.name}, e.aliases...) {
+		if cs := Info(name); cs != nil && cs.MIB == mib {
+			return cs
+		}
+	}
+	return nil
+}
+
+// ByCodePage returns information about the character set with
+// the given Windows code page number, or nil if there is none
+// or it is not supported.
+func ByCodePage(codePage int) *Charset {
+	return byNumber(func(e *codePageEntry) int { return e.codePage }, codePage, func(cs *Charset) int { return cs.CodePage })
+}
+
+// ByCCSID returns information about the character set with
+// the given IBM coded character set identifier, or nil if
+// there is none or it is not supported.
+func ByCCSID(ccsid int) *Charset {
+	return byNumber(func(e *codePageEntry) int { return e.ccsid }, ccsid, func(cs *Charset) int { return cs.CCSID })
+}
+
+// byNumber returns the first supported character set in
+// codepages.txt whose number, as given by entryNum and csNum,
+// is n.
+func byNumber(entryNum func(*codePageEntry) int, n int, csNum func(*Charset) int) *Charset {
+	readRegistryOnce.Do(readRegistry)
+	if n == 0 {
+		return nil
+	}
+	for _, e := range codePages {
+		if entryNum(e) != n {
+			continue
+		}
+		if cs := Info(e.name); cs != nil && csNum(cs) == n {
+			return cs
+		}
+	}
+	return nil
+}
@@ -34,6 +34,25 @@ var tests = []translateTest{
 	{true, "latin1", "\xa35 for Pepp\xe9", "£5 for Peppé"},
 }
 
+func TestEBCDICXML(t *testing.T) {
+	// <?xml version="1.0" encoding="IBM037"?><a>é</a> in IBM037.
+	in := "\x4c\x6f\xa7\x94\x93\x40\xa5\x85\x99\xa2\x89\x96\x95\x7e\x7f\xf1\x4b\xf0\x7f\x40" +
+		"\x85\x95\x83\x96\x84\x89\x95\x87\x7e\x7f\xc9\xc2\xd4\xf0\xf3\xf7\x7f\x6f\x6e" +
+		"\x4c\x81\x6e\x51\x4c\x61\x81\x6e"
+	r, cs, err := charset.NewXMLReader(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("cannot make reader: %v", err)
+	}
+	if cs != "IBM037" {
+		t.Errorf("expected IBM037, got %q", cs)
+	}
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	if want := `<?xml version="1.0" encoding="UTF-8"?><a>é</a>`; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
 func TestCharsets(t *testing.T) {
 	for i, test := range tests {
 		t.Logf("test %d", i)
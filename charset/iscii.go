@@ -0,0 +1,381 @@
+package charset
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("iscii", fromISCII, toISCII)
+}
+
+// encoding details
+// ISCII-91 (IS 13194:1991)
+//
+// 00..7f	ASCII
+// a1..fa	Indic letters, signs and digits (see isciiTable)
+// d9		INV (invisible consonant)
+// e8		halant (virama)
+// e9		nukta
+// ef		ATR (attribute) - followed by a script or display code
+// f0		EXT - followed by a Vedic extension code
+//
+// The same code points are used for all the Brahmi-derived scripts.
+// The current script is selected by an ATR sequence and is reset
+// to the default script (given by the class argument) at the
+// end of each line. The Unicode blocks for the scripts have
+// the same layout as Devanagari, offset by a multiple of 0x80.
+//
+// Notes
+//
+// Several characters are encoded as a pair of codes in ISCII:
+//
+//	a1 e9	OM (U+0950)
+//	a6 e9	vocalic L (U+090C)
+//	a7 e9	vocalic LL (U+0961)
+//	aa e9	vocalic RR (U+0960)
+//	db e9	vowel sign vocalic L (U+0962)
+//	dc e9	vowel sign vocalic LL (U+0963)
+//	df e9	vowel sign vocalic RR (U+0944)
+//	ea e9	avagraha (U+093D)
+//	ea ea	double danda (U+0965)
+//	e8 e8	explicit halant (virama, ZWNJ)
+//	e8 e9	soft halant (virama, ZWJ)
+//	f0 b8	anudatta (U+0952)
+//	f0 bf	abbreviation sign (U+0970)
+//
+// A consonant followed by nukta is decoded to the consonant
+// and U+093C, which is the canonical (NFC) form of the
+// precomposed nukta letters.
+
+const (
+	isciiINV    = 0xd9
+	isciiHalant = 0xe8
+	isciiNukta  = 0xe9
+	isciiDanda  = 0xea
+	isciiATR    = 0xef
+	isciiEXT    = 0xf0
+
+	zwnj = 0x200c
+	zwj  = 0x200d
+)
+
+// isciiTable maps ISCII codes a0..ff to Devanagari.
+// Zero entries are undefined.
+var isciiTable = [96]rune{
+	0x00a0, 0x0901, 0x0902, 0x0903, 0x0905, 0x0906, 0x0907, 0x0908, // a0
+	0x0909, 0x090a, 0x090b, 0x090e, 0x090f, 0x0910, 0x090d, 0x0912, // a8
+	0x0913, 0x0914, 0x0911, 0x0915, 0x0916, 0x0917, 0x0918, 0x0919, // b0
+	0x091a, 0x091b, 0x091c, 0x091d, 0x091e, 0x091f, 0x0920, 0x0921, // b8
+	0x0922, 0x0923, 0x0924, 0x0925, 0x0926, 0x0927, 0x0928, 0x0929, // c0
+	0x092a, 0x092b, 0x092c, 0x092d, 0x092e, 0x092f, 0x095f, 0x0930, // c8
+	0x0931, 0x0932, 0x0933, 0x0934, 0x0935, 0x0936, 0x0937, 0x0938, // d0
+	0x0939, zwj, 0x093e, 0x093f, 0x0940, 0x0941, 0x0942, 0x0943, // d8
+	0x0946, 0x0947, 0x0948, 0x0945, 0x094a, 0x094b, 0x094c, 0x0949, // e0
+	0x094d, 0x093c, 0x0964, 0, 0, 0, 0, 0, // e8
+	0, 0x0966, 0x0967, 0x0968, 0x0969, 0x096a, 0x096b, 0x096c, // f0
+	0x096d, 0x096e, 0x096f, 0, 0, 0, 0, 0, // f8
+}
+
+// isciiNuktaForms maps a code followed by nukta
+// to the Devanagari character it represents.
+var isciiNuktaForms = map[byte]rune{
+	0xa1: 0x0950,
+	0xa6: 0x090c,
+	0xa7: 0x0961,
+	0xaa: 0x0960,
+	0xdb: 0x0962,
+	0xdc: 0x0963,
+	0xdf: 0x0944,
+	0xea: 0x093d,
+}
+
+// isciiNuktaConsonants holds the base consonants of
+// the precomposed nukta letters U+0958..U+095E.
+var isciiNuktaConsonants = []rune{0x0915, 0x0916, 0x0917, 0x091c, 0x0921, 0x0922, 0x092b}
+
+// isciiExt maps codes following EXT.
+var isciiExt = map[byte]rune{
+	0xb8: 0x0952,
+	0xbf: 0x0970,
+}
+
+// isciiScript describes one of the scripts that
+// can be selected with an ATR sequence.
+type isciiScript struct {
+	name  string
+	atr   byte
+	delta rune
+	table *unicode.RangeTable
+}
+
+var isciiScripts = []isciiScript{
+	{"dev", 0x42, 0x000, unicode.Devanagari},
+	{"bng", 0x43, 0x080, unicode.Bengali},
+	{"tml", 0x44, 0x280, unicode.Tamil},
+	{"tlg", 0x45, 0x300, unicode.Telugu},
+	{"asm", 0x46, 0x080, unicode.Bengali},
+	{"ori", 0x47, 0x200, unicode.Oriya},
+	{"knd", 0x48, 0x380, unicode.Kannada},
+	{"mlm", 0x49, 0x400, unicode.Malayalam},
+	{"gjr", 0x4a, 0x180, unicode.Gujarati},
+	{"pnj", 0x4b, 0x100, unicode.Gurmukhi},
+}
+
+func isciiScriptByName(name string) *isciiScript {
+	for i := range isciiScripts {
+		if isciiScripts[i].name == name {
+			return &isciiScripts[i]
+		}
+	}
+	return nil
+}
+
+func isciiScriptByATR(atr byte) *isciiScript {
+	for i := range isciiScripts {
+		if isciiScripts[i].atr == atr {
+			return &isciiScripts[i]
+		}
+	}
+	return nil
+}
+
+// toScript converts a Devanagari rune to the equivalent
+// rune in the given script. It returns utf8.RuneError
+// if the script has no such character.
+func (s *isciiScript) toScript(r rune) rune {
+	if r < 0x0900 || r > 0x097f || r == 0x0964 || r == 0x0965 {
+		return r
+	}
+	if s.name == "asm" {
+		// Assamese has its own RA and WA.
+		switch r {
+		case 0x0930:
+			return 0x09f0
+		case 0x0935:
+			return 0x09f1
+		}
+	}
+	r += s.delta
+	if !unicode.Is(s.table, r) {
+		return utf8.RuneError
+	}
+	return r
+}
+
+type translateFromISCII struct {
+	def     *isciiScript
+	script  *isciiScript
+	scratch []byte
+}
+
+func (p *translateFromISCII) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		b := data[n]
+		if b < 0xa0 {
+			if b == '\n' || b == '\r' {
+				p.script = p.def
+			}
+			p.scratch = append(p.scratch, b)
+			n++
+			continue
+		}
+		if n+1 >= len(data) && !eof {
+			// all the multi-byte sequences start with a
+			// code >= a0, so we need to see the next byte.
+			break
+		}
+		next := -1
+		if n+1 < len(data) {
+			next = int(data[n+1])
+		}
+		var r, r2 rune
+		size := 1
+		switch {
+		case b == isciiATR:
+			if next == -1 {
+				r = utf8.RuneError
+				break
+			}
+			if s := isciiScriptByATR(byte(next)); s != nil {
+				p.script = s
+			}
+			// display attributes and unknown scripts are ignored.
+			n += 2
+			continue
+		case b == isciiEXT:
+			if next == -1 {
+				r = utf8.RuneError
+				break
+			}
+			size = 2
+			if x, ok := isciiExt[byte(next)]; ok {
+				r = x
+			} else {
+				r = utf8.RuneError
+			}
+		case b == isciiHalant && next == isciiHalant:
+			r, r2, size = 0x094d, zwnj, 2
+		case b == isciiHalant && next == isciiNukta:
+			r, r2, size = 0x094d, zwj, 2
+		case b == isciiDanda && next == isciiDanda:
+			r, size = 0x0965, 2
+		case next == isciiNukta && isciiNuktaForms[b] != 0:
+			r, size = isciiNuktaForms[b], 2
+		default:
+			r = isciiTable[b-0xa0]
+			if r == 0 {
+				r = utf8.RuneError
+			}
+		}
+		if r != utf8.RuneError {
+			r = p.script.toScript(r)
+		}
+		p.scratch = appendRune(p.scratch, r)
+		if r2 != 0 {
+			p.scratch = appendRune(p.scratch, r2)
+		}
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+type isciiKey bool
+
+// isciiCodes holds the reverse mapping of isciiTable,
+// including the two-code sequences.
+type isciiCodes map[rune]string
+
+func getISCIICodes() isciiCodes {
+	m, _ := cache(isciiKey(true), func() (interface{}, error) {
+		m := make(isciiCodes)
+		for i, r := range isciiTable {
+			if r != 0 {
+				m[r] = string([]byte{byte(i + 0xa0)})
+			}
+		}
+		for b, r := range isciiNuktaForms {
+			m[r] = string([]byte{b, isciiNukta})
+		}
+		for b, r := range isciiExt {
+			m[r] = string([]byte{isciiEXT, b})
+		}
+		m[0x0965] = string([]byte{isciiDanda, isciiDanda})
+		for i, base := range isciiNuktaConsonants {
+			m[0x0958+rune(i)] = m[base] + string([]byte{isciiNukta})
+		}
+		return m, nil
+	})
+	return m.(isciiCodes)
+}
+
+type translateToISCII struct {
+	def     *isciiScript
+	script  *isciiScript
+	codes   isciiCodes
+	scratch []byte
+}
+
+// isciiFromScript returns the script containing r and the
+// Devanagari equivalent of r. It returns nil if r is not
+// in one of the ISCII scripts.
+func isciiFromScript(r rune, current *isciiScript) (*isciiScript, rune) {
+	switch r {
+	case 0x0964, 0x0965:
+		return current, r
+	case 0x09f0:
+		return isciiScriptByName("asm"), 0x0930
+	case 0x09f1:
+		return isciiScriptByName("asm"), 0x0935
+	}
+	if r < 0x0900 || r > 0x0d7f {
+		return nil, r
+	}
+	for i := range isciiScripts {
+		s := &isciiScripts[i]
+		if s.name == "asm" || r < 0x0900+s.delta || r >= 0x0980+s.delta {
+			continue
+		}
+		r -= s.delta
+		if current.name == "asm" && s.name == "bng" && r != 0x0930 && r != 0x0935 {
+			// Assamese and Bengali share a block, so
+			// stay in Assamese when possible.
+			s = current
+		}
+		return s, r
+	}
+	return nil, r
+}
+
+func (p *translateToISCII) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if r < utf8.RuneSelf {
+			if r == '\n' || r == '\r' {
+				p.script = p.def
+			}
+			p.scratch = append(p.scratch, byte(r))
+			n += size
+			continue
+		}
+		if r == zwj {
+			p.scratch = append(p.scratch, isciiINV)
+			n += size
+			continue
+		}
+		s, dr := isciiFromScript(r, p.script)
+		code := p.codes[dr]
+		if s == nil || code == "" {
+			p.scratch = append(p.scratch, errorByte)
+			n += size
+			continue
+		}
+		if dr == 0x094d {
+			// a halant followed by a joiner needs
+			// to see the next character.
+			rest := data[n+size:]
+			if !eof && !utf8.FullRune(rest) {
+				break
+			}
+			switch r, rsize := utf8.DecodeRune(rest); r {
+			case zwnj:
+				code = string([]byte{isciiHalant, isciiHalant})
+				size += rsize
+			case zwj:
+				code = string([]byte{isciiHalant, isciiNukta})
+				size += rsize
+			}
+		}
+		if s != p.script {
+			p.scratch = append(p.scratch, isciiATR, s.atr)
+			p.script = s
+		}
+		p.scratch = append(p.scratch, code...)
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func fromISCII(arg string) (Translator, error) {
+	s := isciiScriptByName(arg)
+	if s == nil {
+		return nil, fmt.Errorf("charset: unknown iscii script %q", arg)
+	}
+	return &translateFromISCII{def: s, script: s}, nil
+}
+
+func toISCII(arg string) (Translator, error) {
+	s := isciiScriptByName(arg)
+	if s == nil {
+		return nil, fmt.Errorf("charset: unknown iscii script %q", arg)
+	}
+	return &translateToISCII{def: s, script: s, codes: getISCIICodes()}, nil
+}
@@ -0,0 +1,264 @@
+package charset
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("iso6937", fromISO6937, toISO6937)
+}
+
+// encoding details
+// ISO/IEC 6937 and ITU-T T.61 (Teletex)
+//
+// 00..7f	ASCII
+// a0..bf	symbols (see iso6937Table)
+// c1..cf	non-spacing diacritics, which precede the letter they modify
+// d0..ff	symbols and letters (see iso6937Table)
+//
+// A diacritic followed by a space is the spacing form of the
+// diacritic. The class argument is "t61" for T.61, whose
+// repertoire is a subset of ISO 6937: it lacks the ASCII
+// characters # $ \ ^ ` { } ~ (# and $ are found at a6 and a4)
+// and most of the symbols.
+//
+// Notes
+//
+// The decoder returns text in normalization form C;
+// in particular e0 (OHM SIGN) is decoded as U+03A9,
+// its canonical equivalent. The encoder decomposes
+// its input to find the diacritic for each letter.
+// The encoder also accepts U+00D0 (ETH) for e2,
+// which is decoded as U+0110 (D WITH STROKE).
+
+// iso6937Table maps codes a0..ff to Unicode.
+// Zero entries are undefined.
+var iso6937Table = [96]rune{
+	0x00a0, 0x00a1, 0x00a2, 0x00a3, 0, 0x00a5, 0, 0x00a7, // a0
+	0x00a4, 0x2018, 0x201c, 0x00ab, 0x2190, 0x2191, 0x2192, 0x2193, // a8
+	0x00b0, 0x00b1, 0x00b2, 0x00b3, 0x00d7, 0x00b5, 0x00b6, 0x00b7, // b0
+	0x00f7, 0x2019, 0x201d, 0x00bb, 0x00bc, 0x00bd, 0x00be, 0x00bf, // b8
+	0, 0, 0, 0, 0, 0, 0, 0, // c0
+	0, 0, 0, 0, 0, 0, 0, 0, // c8
+	0x2015, 0x00b9, 0x00ae, 0x00a9, 0x2122, 0x266a, 0x00ac, 0x00a6, // d0
+	0, 0, 0, 0, 0x215b, 0x215c, 0x215d, 0x215e, // d8
+	0x03a9, 0x00c6, 0x0110, 0x00aa, 0x0126, 0, 0x0132, 0x013f, // e0
+	0x0141, 0x00d8, 0x0152, 0x00ba, 0x00de, 0x0166, 0x014a, 0x0149, // e8
+	0x0138, 0x00e6, 0x0111, 0x00f0, 0x0127, 0x0131, 0x0133, 0x0140, // f0
+	0x0142, 0x00f8, 0x0153, 0x00df, 0x00fe, 0x0167, 0x014b, 0x00ad, // f8
+}
+
+// iso6937Accents maps the diacritic codes c0..cf to
+// combining marks and to their spacing forms.
+// Zero entries are undefined.
+var iso6937Accents = [16]struct {
+	mark, spacing rune
+}{
+	{0, 0},
+	{0x0300, '`'},
+	{0x0301, 0x00b4},
+	{0x0302, '^'},
+	{0x0303, '~'},
+	{0x0304, 0x00af},
+	{0x0306, 0x02d8},
+	{0x0307, 0x02d9},
+	{0x0308, 0x00a8},
+	{0, 0},
+	{0x030a, 0x02da},
+	{0x0327, 0x00b8},
+	{0, 0},
+	{0x030b, 0x02dd},
+	{0x0328, 0x02db},
+	{0x030c, 0x02c7},
+}
+
+// t61Missing holds the codes defined by ISO 6937 but not by T.61.
+const t61Missing = "#$\\^`{}~\xa0\xa9\xaa\xac\xad\xae\xaf\xb9\xba" +
+	"\xd0\xd1\xd2\xd3\xd4\xd5\xd6\xd7\xdc\xdd\xde\xdf\xff"
+
+// iso6937Charset holds the decoding and encoding
+// tables for ISO 6937 or T.61.
+type iso6937Charset struct {
+	table [256]rune // single byte codes; -1 for diacritics.
+	codes map[rune]string
+	marks map[rune]byte
+	norm  *normTables
+}
+
+type iso6937Key bool
+
+func getISO6937(t61 bool) (*iso6937Charset, error) {
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	cs, _ := cache(iso6937Key(t61), func() (interface{}, error) {
+		cs := &iso6937Charset{
+			codes: make(map[rune]string),
+			marks: make(map[rune]byte),
+			norm:  norm,
+		}
+		for i := 0; i < 0xa0; i++ {
+			cs.table[i] = rune(i)
+		}
+		for i, r := range iso6937Table {
+			if r == 0 {
+				r = utf8.RuneError
+			}
+			cs.table[i+0xa0] = r
+		}
+		for i, a := range iso6937Accents {
+			if a.mark != 0 {
+				cs.table[i+0xc0] = -1
+			}
+		}
+		if t61 {
+			for i := 0; i < len(t61Missing); i++ {
+				cs.table[t61Missing[i]] = utf8.RuneError
+			}
+			cs.table[0xa4] = '$'
+			cs.table[0xa6] = '#'
+		}
+		for i, r := range cs.table {
+			if r >= 0 && r != utf8.RuneError {
+				cs.codes[r] = string([]byte{byte(i)})
+			}
+		}
+		// e2 stands for both the Croatian and the Icelandic D.
+		cs.codes[0x00d0] = cs.codes[0x0110]
+		for i, a := range iso6937Accents {
+			if a.mark == 0 {
+				continue
+			}
+			cs.marks[a.mark] = byte(i + 0xc0)
+			if cs.codes[a.spacing] == "" {
+				cs.codes[a.spacing] = string([]byte{byte(i + 0xc0), ' '})
+			}
+		}
+		return cs, nil
+	})
+	return cs.(*iso6937Charset), nil
+}
+
+type translateFromISO6937 struct {
+	*iso6937Charset
+	cluster []rune
+	scratch []byte
+}
+
+func (p *translateFromISO6937) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		r := p.table[data[n]]
+		switch {
+		case r >= 0:
+			p.scratch = appendRune(p.scratch, r)
+		case n+1 >= len(data) && !eof:
+			// a diacritic needs to see the next byte.
+			return n, p.scratch, nil
+		default:
+			mark := iso6937Accents[data[n]-0xc0]
+			base := rune(0)
+			if n+1 < len(data) {
+				base = p.table[data[n+1]]
+			}
+			switch {
+			case base == ' ':
+				p.scratch = appendRune(p.scratch, mark.spacing)
+				n++
+			case base > ' ':
+				p.cluster = p.norm.nfc(p.cluster[:0], []rune{base, mark.mark})
+				for _, r := range p.cluster {
+					p.scratch = appendRune(p.scratch, r)
+				}
+				n++
+			default:
+				p.scratch = appendRune(p.scratch, mark.mark)
+			}
+		}
+		n++
+	}
+	return n, p.scratch, nil
+}
+
+type translateToISO6937 struct {
+	*iso6937Charset
+	runes   []rune
+	nfd     []rune
+	scratch []byte
+}
+
+func (p *translateToISO6937) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := completeClusters(data, eof)
+	p.runes = p.runes[:0]
+	for _, r := range string(data[:n]) {
+		p.runes = append(p.runes, r)
+	}
+	p.nfd = p.norm.nfd(p.nfd[:0], p.runes)
+	s := p.nfd
+	for i := 0; i < len(s); i++ {
+		r := s[i]
+		if i+1 < len(s) && isMark(s[i+1]) {
+			// only one diacritic can be applied to a letter.
+			accent, ok := p.marks[s[i+1]]
+			code := p.codes[r]
+			i++
+			for i+1 < len(s) && isMark(s[i+1]) {
+				ok = false
+				i++
+			}
+			if ok && len(code) == 1 && code[0] > ' ' {
+				p.scratch = append(p.scratch, accent, code[0])
+			} else {
+				p.scratch = append(p.scratch, errorByte)
+			}
+			continue
+		}
+		if code := p.codes[r]; code != "" {
+			p.scratch = append(p.scratch, code...)
+		} else if accent, ok := p.marks[r]; ok {
+			// a diacritic with no letter to apply it to.
+			p.scratch = append(p.scratch, accent)
+		} else {
+			p.scratch = append(p.scratch, errorByte)
+		}
+	}
+	return n, p.scratch, nil
+}
+
+func iso6937Arg(arg string) (bool, error) {
+	switch arg {
+	case "":
+		return false, nil
+	case "t61":
+		return true, nil
+	}
+	return false, fmt.Errorf("charset: unknown iso6937 variant %q", arg)
+}
+
+func fromISO6937(arg string) (Translator, error) {
+	t61, err := iso6937Arg(arg)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := getISO6937(t61)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromISO6937{iso6937Charset: cs}, nil
+}
+
+func toISO6937(arg string) (Translator, error) {
+	t61, err := iso6937Arg(arg)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := getISO6937(t61)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToISO6937{iso6937Charset: cs}, nil
+}
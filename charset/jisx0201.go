@@ -0,0 +1,246 @@
+package charset
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("jisx0201", fromJISX0201, toJISX0201)
+}
+
+// encoding details
+// JIS X 0201
+//
+// The class argument selects the form:
+//
+//	""	8-bit: 00..7f Roman, a1..df katakana
+//	"roman"	7-bit Roman (ISO 646-JP)
+//	"kana"	7-bit katakana: 21..5f katakana
+//
+// The Roman set is ASCII apart from 5c (YEN SIGN) and
+// 7e (OVERLINE). The encoder also accepts U+00AF (MACRON),
+// which the shiftjis variant of the cp932 class uses for 7e.
+//
+// Width folding
+//
+// The FoldWidth option folds half-width katakana in the text
+// read from a Reader to full-width, combining a following
+// half-width voiced sound mark (dakuten) or semi-voiced sound
+// mark (handakuten) with the kana where possible, and
+// full-width ASCII and the ideographic space to their ASCII
+// equivalents. It is meant for text decoded from the Japanese
+// character sets, but works after any of them.
+
+// FoldWidth returns an Option that folds the width of the
+// UTF-8 text read from a Reader, as described above.
+// It has no effect on a Writer.
+func FoldWidth() Option {
+	return func(o *options) {
+		o.foldWidth = true
+	}
+}
+
+// fullWidthKana holds the full-width forms of the
+// half-width characters U+FF61..U+FF9F.
+const fullWidthKana = "。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソ" +
+	"タチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜"
+
+const (
+	halfWidthKana0      = 0xff61
+	halfWidthVoiced     = 0xff9e
+	halfWidthSemiVoiced = 0xff9f
+)
+
+type widthTables struct {
+	kana []rune
+	norm *normTables
+}
+
+type widthKey bool
+
+func getWidthTables() (*widthTables, error) {
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	t, _ := cache(widthKey(true), func() (interface{}, error) {
+		return &widthTables{[]rune(fullWidthKana), norm}, nil
+	})
+	return t.(*widthTables), nil
+}
+
+// foldWidth returns a translator that folds the width
+// of the output of tr.
+func foldWidth(tr Translator) (Translator, error) {
+	t, err := getWidthTables()
+	if err != nil {
+		return nil, err
+	}
+	return &widthFolder{widthTables: t, tr: tr, pending: -1}, nil
+}
+
+type widthFolder struct {
+	*widthTables
+	tr Translator
+	// pending holds a full-width kana that has not been
+	// written, because a sound mark may follow; otherwise -1.
+	pending rune
+	scratch []byte
+}
+
+func (p *widthFolder) flush() {
+	if p.pending != -1 {
+		p.scratch = appendRune(p.scratch, p.pending)
+		p.pending = -1
+	}
+}
+
+func (p *widthFolder) Translate(data []byte, eof bool) (int, []byte, error) {
+	n, cdata, err := p.tr.Translate(data, eof)
+	p.scratch = p.scratch[:0]
+	for len(cdata) > 0 {
+		r, size := utf8.DecodeRune(cdata)
+		cdata = cdata[size:]
+		switch {
+		case r == halfWidthVoiced || r == halfWidthSemiVoiced:
+			mark := rune(0x3099) + r - halfWidthVoiced
+			if p.pending != -1 {
+				if c, ok := p.norm.composePair(p.pending, mark); ok {
+					p.pending = -1
+					p.scratch = appendRune(p.scratch, c)
+					continue
+				}
+			}
+			p.flush()
+			p.scratch = appendRune(p.scratch, p.kana[r-halfWidthKana0])
+		case r >= halfWidthKana0 && r < halfWidthVoiced:
+			p.flush()
+			p.pending = p.kana[r-halfWidthKana0]
+		case r >= 0xff01 && r <= 0xff5e:
+			p.flush()
+			p.scratch = append(p.scratch, byte(r-0xff01+'!'))
+		case r == 0x3000:
+			p.flush()
+			p.scratch = append(p.scratch, ' ')
+		default:
+			p.flush()
+			p.scratch = appendRune(p.scratch, r)
+		}
+	}
+	if eof {
+		p.flush()
+	}
+	return n, p.scratch, err
+}
+
+type jisx0201Key string
+
+type jisx0201Charset struct {
+	table [256]rune
+	codes map[rune]byte
+}
+
+func getJISX0201(arg string) (*jisx0201Charset, error) {
+	if arg != "" && arg != "roman" && arg != "kana" {
+		return nil, fmt.Errorf("charset: unknown jisx0201 variant %q", arg)
+	}
+	cs, err := cache(jisx0201Key(arg), func() (interface{}, error) {
+		kana, err := jisGetMap("jisx0201kana.dat", kanaPageSize, kanaPages)
+		if err != nil {
+			return nil, err
+		}
+		cs := &jisx0201Charset{codes: make(map[rune]byte)}
+		for i := range cs.table {
+			cs.table[i] = utf8.RuneError
+		}
+		for i := 0; i < 0x80; i++ {
+			cs.table[i] = rune(i)
+		}
+		switch arg {
+		case "kana":
+			for i := 0x21; i < 0x80; i++ {
+				cs.table[i] = utf8.RuneError
+			}
+			for i, r := range kana {
+				cs.table[i+0x21] = r
+			}
+		default:
+			cs.table['\\'] = '¥'
+			cs.table['~'] = '‾'
+			cs.codes['¯'] = '~'
+			if arg == "" {
+				for i, r := range kana {
+					cs.table[i+kanaChar0] = r
+				}
+			}
+		}
+		for i, r := range cs.table {
+			if r != utf8.RuneError {
+				cs.codes[r] = byte(i)
+			}
+		}
+		return cs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cs.(*jisx0201Charset), nil
+}
+
+type translateFromJISX0201 struct {
+	*jisx0201Charset
+	scratch []byte
+}
+
+func (p *translateFromJISX0201) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	for _, b := range data {
+		p.scratch = appendRune(p.scratch, p.table[b])
+	}
+	return len(data), p.scratch, nil
+}
+
+type translateToJISX0201 struct {
+	*jisx0201Charset
+	scratch []byte
+}
+
+func (p *translateToJISX0201) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		n += size
+		if b, ok := p.codes[r]; ok {
+			p.scratch = append(p.scratch, b)
+		} else {
+			p.scratch = append(p.scratch, errorByte)
+		}
+	}
+	return n, p.scratch, nil
+}
+
+func (p *translateToJISX0201) encodes(r rune) bool {
+	_, ok := p.codes[r]
+	return ok
+}
+
+func fromJISX0201(arg string) (Translator, error) {
+	cs, err := getJISX0201(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromJISX0201{jisx0201Charset: cs}, nil
+}
+
+func toJISX0201(arg string) (Translator, error) {
+	cs, err := getJISX0201(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToJISX0201{jisx0201Charset: cs}, nil
+}
@@ -0,0 +1,435 @@
+package charset
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("jisx0213", fromJISX0213, toJISX0213)
+}
+
+// encoding details
+// Shift_JIS-2004 and EUC-JIS-2004 (JIS X 0213:2004)
+//
+// JIS X 0213 has two planes of 94x94 characters. Plane 1 is a
+// superset of JIS X 0208; plane 2 uses rows 1, 3-5, 8, 12-15
+// and 78-94 only.
+//
+// Shift_JIS-2004
+//
+// 00..7f	JIS X 0201 roman (0x5c is '¥', 0x7e is '‾')
+// 81..9f	lead byte of plane 1, rows 1..62
+// a1..df	JIS X 0201 katakana
+// e0..ef	lead byte of plane 1, rows 63..94
+// f0..fc	lead byte of plane 2
+//
+// The trail byte is 40..7e or 80..fc; each lead byte covers two
+// rows. In plane 2, the lead bytes f0..f4 cover rows 1 and 8,
+// 3 and 4, 5 and 12, 13 and 14, and 15 and 78.
+//
+// EUC-JIS-2004
+//
+// 00..7f	ASCII
+// 8e xx	JIS X 0201 katakana
+// 8f xx xx	plane 2
+// a1..fe xx	plane 1
+//
+// Notes
+//
+// A few codes stand for a base character followed by a
+// combining character (see jisx0213Pairs). The encoder
+// looks ahead to find these sequences.
+//
+// Because 0x5c and 0x7e are not ASCII in Shift_JIS-2004,
+// plane 1 codes 1-1-32 and 1-2-18 decode to '\' and '~'
+// there, rather than to their fullwidth forms.
+
+const (
+	jisx0213Data      = "jisx0213.dat"
+	jisx0213PlaneSize = 94 * 94
+)
+
+// jisx0213Pairs lists the codes that decode to
+// two code points, by plane, row and cell.
+var jisx0213Pairs = []struct {
+	plane, row, cell int
+	r                [2]rune
+}{
+	{1, 4, 87, [2]rune{0x304b, 0x309a}},
+	{1, 4, 88, [2]rune{0x304d, 0x309a}},
+	{1, 4, 89, [2]rune{0x304f, 0x309a}},
+	{1, 4, 90, [2]rune{0x3051, 0x309a}},
+	{1, 4, 91, [2]rune{0x3053, 0x309a}},
+	{1, 5, 87, [2]rune{0x30ab, 0x309a}},
+	{1, 5, 88, [2]rune{0x30ad, 0x309a}},
+	{1, 5, 89, [2]rune{0x30af, 0x309a}},
+	{1, 5, 90, [2]rune{0x30b1, 0x309a}},
+	{1, 5, 91, [2]rune{0x30b3, 0x309a}},
+	{1, 5, 92, [2]rune{0x30bb, 0x309a}},
+	{1, 5, 93, [2]rune{0x30c4, 0x309a}},
+	{1, 5, 94, [2]rune{0x30c8, 0x309a}},
+	{1, 6, 88, [2]rune{0x31f7, 0x309a}},
+	{1, 11, 36, [2]rune{0x00e6, 0x0300}},
+	{1, 11, 40, [2]rune{0x0254, 0x0300}},
+	{1, 11, 41, [2]rune{0x0254, 0x0301}},
+	{1, 11, 42, [2]rune{0x028c, 0x0300}},
+	{1, 11, 43, [2]rune{0x028c, 0x0301}},
+	{1, 11, 44, [2]rune{0x0259, 0x0300}},
+	{1, 11, 45, [2]rune{0x0259, 0x0301}},
+	{1, 11, 46, [2]rune{0x025a, 0x0300}},
+	{1, 11, 47, [2]rune{0x025a, 0x0301}},
+	{1, 11, 69, [2]rune{0x02e9, 0x02e5}},
+	{1, 11, 70, [2]rune{0x02e5, 0x02e9}},
+}
+
+// jisx0213Plane2Rows gives the plane 2 rows covered
+// by Shift_JIS-2004 lead bytes f0..f4.
+var jisx0213Plane2Rows = [5][2]int{{1, 8}, {3, 4}, {5, 12}, {13, 14}, {15, 78}}
+
+// kuten returns the index in the JIS X 0213 table
+// of the given plane, row and cell.
+func kuten(plane, row, cell int) int {
+	return (plane-1)*jisx0213PlaneSize + (row-1)*94 + cell - 1
+}
+
+// sjisToKuten returns the table index of the Shift_JIS-2004
+// code b1 b2, or -1 if it is not a valid code.
+func sjisToKuten(b1, b2 byte) int {
+	if b2 < 0x40 || b2 == 0x7f || b2 > 0xfc {
+		return -1
+	}
+	t := int(b2) - 0x40
+	if b2 > 0x7f {
+		t--
+	}
+	second := 0
+	if t >= 94 {
+		second = 1
+		t -= 94
+	}
+	switch {
+	case b1 >= 0x81 && b1 <= 0x9f:
+		return kuten(1, int(b1-0x81)*2+1+second, t+1)
+	case b1 >= 0xe0 && b1 <= 0xef:
+		return kuten(1, int(b1-0xe0)*2+63+second, t+1)
+	case b1 >= 0xf0 && b1 <= 0xf4:
+		return kuten(2, jisx0213Plane2Rows[b1-0xf0][second], t+1)
+	case b1 >= 0xf5 && b1 <= 0xfc:
+		return kuten(2, int(b1-0xf5)*2+79+second, t+1)
+	}
+	return -1
+}
+
+// kutenToSJIS returns the Shift_JIS-2004 code for the
+// given table index.
+func kutenToSJIS(k int) (byte, byte, bool) {
+	plane := k/jisx0213PlaneSize + 1
+	row := k%jisx0213PlaneSize/94 + 1
+	cell := k%94 + 1
+	var b1 byte
+	second := 0
+	switch {
+	case plane == 1:
+		b1 = byte((row+1)/2 + 0x80)
+		if row > 62 {
+			b1 += 0x40
+		}
+		second = 1 - row%2
+	case row >= 79:
+		b1 = byte((row-79)/2 + 0xf5)
+		second = (row - 79) % 2
+	default:
+		found := false
+		for i, rows := range jisx0213Plane2Rows {
+			for j, r := range rows {
+				if r == row {
+					b1, second, found = byte(0xf0+i), j, true
+				}
+			}
+		}
+		if !found {
+			return 0, 0, false
+		}
+	}
+	b2 := byte(cell - 1 + second*94 + 0x40)
+	if b2 >= 0x7f {
+		b2++
+	}
+	return b1, b2, true
+}
+
+type jisx0213Tables struct {
+	runes     []rune
+	kana      []rune
+	pairs     map[int][2]rune
+	codes     map[rune]int
+	pairCodes map[[2]rune]int
+	bases     map[rune]bool // first characters of pairCodes.
+}
+
+type jisx0213Key bool
+
+func getJISX0213Tables() (*jisx0213Tables, error) {
+	t, err := cache(jisx0213Key(false), func() (interface{}, error) {
+		runes, err := jisGetMap(jisx0213Data, jisx0213PlaneSize, 2)
+		if err != nil {
+			return nil, err
+		}
+		kana, err := jisGetMap("jisx0201kana.dat", kanaPageSize, kanaPages)
+		if err != nil {
+			return nil, err
+		}
+		t := &jisx0213Tables{
+			runes:     runes,
+			kana:      kana,
+			pairs:     make(map[int][2]rune),
+			codes:     make(map[rune]int),
+			pairCodes: make(map[[2]rune]int),
+			bases:     make(map[rune]bool),
+		}
+		for k, r := range runes {
+			if r == utf8.RuneError {
+				continue
+			}
+			if _, ok := t.codes[r]; !ok {
+				t.codes[r] = k
+			}
+		}
+		for _, p := range jisx0213Pairs {
+			k := kuten(p.plane, p.row, p.cell)
+			t.pairs[k] = p.r
+			t.pairCodes[p.r] = k
+			t.bases[p.r[0]] = true
+		}
+		return t, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.(*jisx0213Tables), nil
+}
+
+// Codes 1-1-32 and 1-2-18 as decoded in Shift_JIS-2004.
+var (
+	sjis2004Backslash = kuten(1, 1, 32)
+	sjis2004Tilde     = kuten(1, 2, 18)
+)
+
+type translateFromJISX0213 struct {
+	*jisx0213Tables
+	sjis    bool
+	scratch []byte
+}
+
+// appendKuten appends the characters for the given table index.
+func (p *translateFromJISX0213) appendKuten(k int) {
+	if pair, ok := p.pairs[k]; ok {
+		p.scratch = appendRune(p.scratch, pair[0])
+		p.scratch = appendRune(p.scratch, pair[1])
+		return
+	}
+	r := utf8.RuneError
+	switch {
+	case k < 0:
+	case p.sjis && k == sjis2004Backslash:
+		r = '\\'
+	case p.sjis && k == sjis2004Tilde:
+		r = '~'
+	default:
+		r = p.runes[k]
+	}
+	p.scratch = appendRune(p.scratch, r)
+}
+
+func (p *translateFromJISX0213) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		b := data[n]
+		if b < 0x80 {
+			switch {
+			case p.sjis && b == '\\':
+				p.scratch = appendRune(p.scratch, '¥')
+			case p.sjis && b == '~':
+				p.scratch = appendRune(p.scratch, '‾')
+			default:
+				p.scratch = append(p.scratch, b)
+			}
+			n++
+			continue
+		}
+		if p.sjis && (b >= kanaChar0 && b < kanaChar0+kanaPageSize || b == 0x80 || b == 0xa0 || b > 0xfc) {
+			r := utf8.RuneError
+			if b >= kanaChar0 && b < kanaChar0+kanaPageSize {
+				r = p.kana[b-kanaChar0]
+			}
+			p.scratch = appendRune(p.scratch, r)
+			n++
+			continue
+		}
+		size := 2
+		if !p.sjis && b == 0x8f {
+			size = 3
+		}
+		if n+size > len(data) {
+			if !eof {
+				break
+			}
+			size = len(data) - n
+		}
+		k := -1
+		if p.sjis {
+			if size == 2 {
+				k = sjisToKuten(b, data[n+1])
+				if k == -1 && data[n+1] < 0x40 {
+					size = 1
+				}
+			}
+		} else {
+			code := data[n : n+size]
+			switch {
+			case size == 2 && b == 0x8e:
+				if c := code[1]; c >= kanaChar0 && c < kanaChar0+kanaPageSize {
+					p.scratch = appendRune(p.scratch, p.kana[c-kanaChar0])
+					n += size
+					continue
+				}
+			case size == 3 && isEUCByte(code[1]) && isEUCByte(code[2]):
+				k = kuten(2, int(code[1]-0xa0), int(code[2]-0xa0))
+			case size == 2 && isEUCByte(b) && isEUCByte(code[1]):
+				k = kuten(1, int(b-0xa0), int(code[1]-0xa0))
+			}
+			if k == -1 {
+				size = 1
+			}
+		}
+		p.appendKuten(k)
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func isEUCByte(b byte) bool {
+	return b >= 0xa1 && b <= 0xfe
+}
+
+type translateToJISX0213 struct {
+	*jisx0213Tables
+	sjis    bool
+	scratch []byte
+}
+
+// appendKuten appends the code for the given table index.
+func (p *translateToJISX0213) appendKuten(k int) {
+	if p.sjis {
+		b1, b2, ok := kutenToSJIS(k)
+		if !ok {
+			p.scratch = append(p.scratch, errorByte)
+			return
+		}
+		p.scratch = append(p.scratch, b1, b2)
+		return
+	}
+	row := byte(k%jisx0213PlaneSize/94 + 0xa1)
+	cell := byte(k%94 + 0xa1)
+	if k >= jisx0213PlaneSize {
+		p.scratch = append(p.scratch, 0x8f)
+	}
+	p.scratch = append(p.scratch, row, cell)
+}
+
+func (p *translateToJISX0213) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if p.sjis {
+			switch r {
+			case '\\':
+				p.appendKuten(sjis2004Backslash)
+				n += size
+				continue
+			case '~':
+				p.appendKuten(sjis2004Tilde)
+				n += size
+				continue
+			case '¥':
+				r = '\\'
+			case '‾':
+				r = '~'
+			}
+		}
+		if r < utf8.RuneSelf {
+			p.scratch = append(p.scratch, byte(r))
+			n += size
+			continue
+		}
+		if r >= 0xff61 && r <= 0xff9f {
+			// JIS X 0201 katakana.
+			if !p.sjis {
+				p.scratch = append(p.scratch, 0x8e)
+			}
+			p.scratch = append(p.scratch, byte(r-0xff61+kanaChar0))
+			n += size
+			continue
+		}
+		if p.bases[r] {
+			// look for a sequence that has a single code.
+			rest := data[n+size:]
+			if !eof && !utf8.FullRune(rest) {
+				break
+			}
+			r2, size2 := utf8.DecodeRune(rest)
+			if k, ok := p.pairCodes[[2]rune{r, r2}]; ok {
+				p.appendKuten(k)
+				n += size + size2
+				continue
+			}
+		}
+		if k, ok := p.codes[r]; ok {
+			p.appendKuten(k)
+		} else {
+			p.scratch = append(p.scratch, errorByte)
+		}
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func jisx0213Arg(arg string) (bool, error) {
+	switch arg {
+	case "sjis":
+		return true, nil
+	case "euc":
+		return false, nil
+	}
+	return false, fmt.Errorf("charset: unknown jisx0213 encoding scheme %q", arg)
+}
+
+func fromJISX0213(arg string) (Translator, error) {
+	sjis, err := jisx0213Arg(arg)
+	if err != nil {
+		return nil, err
+	}
+	t, err := getJISX0213Tables()
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromJISX0213{jisx0213Tables: t, sjis: sjis}, nil
+}
+
+func toJISX0213(arg string) (Translator, error) {
+	sjis, err := jisx0213Arg(arg)
+	if err != nil {
+		return nil, err
+	}
+	t, err := getJISX0213Tables()
+	if err != nil {
+		return nil, err
+	}
+	return &translateToJISX0213{jisx0213Tables: t, sjis: sjis}, nil
+}
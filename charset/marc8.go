@@ -0,0 +1,574 @@
+package charset
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("marc8", fromMARC8, toMARC8)
+}
+
+// encoding details
+// MARC-8 (MARC 21 Specifications for Record Structure,
+// Character Sets, and Exchange Media)
+//
+// 00..1f	control characters
+// 20		space
+// 21..7e	G0 graphic character set (ASCII by default)
+// 88, 89	non-sort begin and end (mapped to U+0098, U+009C)
+// 8d, 8e	joiner and non-joiner (U+200D, U+200C)
+// a1..fe	G1 graphic character set (ANSEL by default)
+//
+// The G0 and G1 sets are changed with ISO 2022 escape sequences:
+//
+//	ESC ( F, ESC , F	designate set F as G0
+//	ESC ) F, ESC - F	designate set F as G1
+//	ESC $ F, ESC $ , F	designate multibyte set F as G0
+//	ESC $ ) F, ESC $ - F	designate multibyte set F as G1
+//	ESC g, ESC b, ESC p	greek symbols, subscripts or superscripts as G0
+//	ESC s	ASCII as G0
+//
+// and the default sets are restored at the end of each field.
+//
+// Combining diacritics precede the character they modify.
+// The decoder moves them after the base character and
+// returns text in Unicode normalization form C; the
+// encoder decomposes its input and moves the diacritics
+// back in front of the base.
+//
+// Notes
+//
+// The final characters of the supported sets are:
+//
+//	B	Basic Latin (ASCII)
+//	E	Extended Latin (ANSEL)
+//	S	Basic Greek
+//	N	Basic Cyrillic
+//	Q	Extended Cyrillic
+//	2	Basic Hebrew
+//	3	Basic Arabic
+//	4	Extended Arabic
+//	1	East Asian Character Code (EACC)
+//	g	Greek symbols
+//	b	Subscripts
+//	p	Superscripts
+//
+// The Extended Arabic and EACC mappings are not bundled.
+// They are read from the Library of Congress code tables
+// (codetables.xml, from the MARC 21 character set pages),
+// registered or installed as the data file
+// marc8-codetables.xml; copying it into datafiles and
+// running data/generate.go bundles it. Without that file,
+// characters in those sets decode to U+FFFD (the decoder
+// still consumes three bytes for each EACC character) and
+// cannot be encoded.
+
+const (
+	marc8Data       = "marc8.dat"
+	marc8CodeTables = "marc8-codetables.xml"
+
+	marc8ASCII     = 'B'
+	marc8ANSEL     = 'E'
+	marc8EACC      = '1'
+	marc8ArabicExt = '4'
+	marc8Greek     = 'S'
+	marc8Symbol    = 'g'
+	marc8Sub       = 'b'
+	marc8Super     = 'p'
+
+	marc8PageSize = 94 // 21..7e
+
+	marc8FieldTerminator  = 0x1e
+	marc8RecordTerminator = 0x1d
+)
+
+// marc8Pages lists the final characters of the sets in
+// marc8.dat, in order.
+var marc8Pages = []byte{marc8ANSEL, marc8Greek, 'N', 'Q', '2', '3', marc8Symbol, marc8Sub, marc8Super}
+
+// marc8Pref gives the order in which the encoder
+// looks for a set that contains a character.
+var marc8Pref = []byte{marc8ASCII, marc8ANSEL, marc8Greek, 'N', 'Q', '2', '3', marc8ArabicExt, marc8Sub, marc8Super, marc8Symbol}
+
+// marc8G1 reports whether the encoder designates set f as G1.
+func marc8G1(f byte) bool {
+	return f == marc8ANSEL || f == 'Q' || f == marc8ArabicExt
+}
+
+// isTechnique1 reports whether set f is selected by
+// a single character escape sequence.
+func isTechnique1(f byte) bool {
+	return f == marc8Symbol || f == marc8Sub || f == marc8Super
+}
+
+type marc8Code struct {
+	set  byte
+	code byte // 21..7e
+}
+
+type marc8Tables struct {
+	sets   map[byte][]rune
+	codes  map[rune][]marc8Code // in order of marc8Pref.
+	eacc   map[uint32]rune      // EACC character by its three bytes.
+	toEACC map[rune]uint32
+	norm   *normTables
+}
+
+type marc8Key bool
+
+func getMARC8Tables() (*marc8Tables, error) {
+	// cache is not reentrant, so load the normalization
+	// tables first.
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	t, err := cache(marc8Key(false), func() (interface{}, error) {
+		data, err := readFile(marc8Data)
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open marc8 data file: %v", err)
+		}
+		// the code tables are optional.
+		codeTables, _ := readFile(marc8CodeTables)
+		return newMARC8Tables(data, codeTables, norm)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.(*marc8Tables), nil
+}
+
+// newMARC8Tables returns the tables read from the contents
+// of marc8.dat and, if it is not nil, of the LC code tables.
+func newMARC8Tables(data, codeTables []byte, norm *normTables) (*marc8Tables, error) {
+	runes := []rune(string(data))
+	if len(runes) != len(marc8Pages)*marc8PageSize {
+		return nil, fmt.Errorf("charset: corrupt marc8 data")
+	}
+	t := &marc8Tables{
+		sets:  make(map[byte][]rune),
+		codes: make(map[rune][]marc8Code),
+		norm:  norm,
+	}
+	ascii := make([]rune, marc8PageSize)
+	for i := range ascii {
+		ascii[i] = rune(i + 0x21)
+	}
+	t.sets[marc8ASCII] = ascii
+	for i, f := range marc8Pages {
+		t.sets[f] = runes[i*marc8PageSize : (i+1)*marc8PageSize]
+	}
+	if codeTables != nil {
+		if err := t.readCodeTables(codeTables); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range marc8Pref {
+		for i, r := range t.sets[f] {
+			if r != utf8.RuneError {
+				t.codes[r] = append(t.codes[r], marc8Code{f, byte(i + 0x21)})
+			}
+		}
+	}
+	return t, nil
+}
+
+// marc8CodeTable holds the parts of a characterSet element
+// of the LC code tables that are used here.
+type marc8CodeTable struct {
+	ISOCode string `xml:"ISOcode,attr"`
+	Codes   []struct {
+		MARC string `xml:"marc"`
+		UCS  string `xml:"ucs"`
+		Alt  string `xml:"alt"`
+	} `xml:"code"`
+}
+
+// readCodeTables adds the Extended Arabic and EACC sets
+// from the LC code tables in data.
+func (t *marc8Tables) readCodeTables(data []byte) error {
+	t.eacc = make(map[uint32]rune)
+	t.toEACC = make(map[rune]uint32)
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("charset: bad marc8 code tables: %v", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "characterSet" {
+			continue
+		}
+		var set marc8CodeTable
+		if err := d.DecodeElement(&set, &start); err != nil {
+			return fmt.Errorf("charset: bad marc8 code tables: %v", err)
+		}
+		f, err := strconv.ParseUint(set.ISOCode, 16, 8)
+		if err != nil || f != marc8EACC && f != marc8ArabicExt {
+			continue
+		}
+		page := make([]rune, marc8PageSize)
+		for i := range page {
+			page[i] = utf8.RuneError
+		}
+		for _, c := range set.Codes {
+			ucs := c.UCS
+			if ucs == "" {
+				ucs = c.Alt
+			}
+			code, err1 := strconv.ParseUint(c.MARC, 16, 32)
+			r, err2 := strconv.ParseUint(ucs, 16, 32)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			if f == marc8EACC {
+				t.eacc[uint32(code)] = rune(r)
+				if _, ok := t.toEACC[rune(r)]; !ok {
+					t.toEACC[rune(r)] = uint32(code)
+				}
+			} else if b := code & 0x7f; b >= 0x21 && b <= 0x7e {
+				page[b-0x21] = rune(r)
+			}
+		}
+		if f == marc8ArabicExt {
+			t.sets[marc8ArabicExt] = page
+		}
+	}
+}
+
+type translateFromMARC8 struct {
+	*marc8Tables
+	g0, g1  byte
+	marks   []rune // diacritics waiting for their base character.
+	base    []rune
+	cluster []rune
+	scratch []byte
+}
+
+// emit adds r to the output, along with any
+// diacritics that preceded it.
+func (p *translateFromMARC8) emit(r rune) {
+	if isMark(r) {
+		p.marks = append(p.marks, r)
+		return
+	}
+	if len(p.marks) == 0 {
+		p.scratch = appendRune(p.scratch, r)
+		return
+	}
+	p.base = append(p.base[:0], r)
+	p.base = append(p.base, p.marks...)
+	p.marks = p.marks[:0]
+	p.cluster = p.norm.nfc(p.cluster[:0], p.base)
+	for _, r := range p.cluster {
+		p.scratch = appendRune(p.scratch, r)
+	}
+}
+
+// flushMarks adds any diacritics without a base
+// character to the output.
+func (p *translateFromMARC8) flushMarks() {
+	for _, r := range p.marks {
+		p.scratch = appendRune(p.scratch, r)
+	}
+	p.marks = p.marks[:0]
+}
+
+// escape interprets the escape sequence at the start of data,
+// and returns its length, or 0 if data holds an incomplete
+// sequence.
+func (p *translateFromMARC8) escape(data []byte) int {
+	if len(data) < 2 {
+		return 0
+	}
+	switch c := data[1]; c {
+	case marc8Symbol, marc8Sub, marc8Super:
+		p.g0 = c
+		return 2
+	case 's':
+		p.g0 = marc8ASCII
+		return 2
+	}
+	i := 1
+	multi := data[i] == '$'
+	if multi {
+		i++
+		if i >= len(data) {
+			return 0
+		}
+	}
+	g := data[i]
+	switch g {
+	case '(', ',', ')', '-':
+	default:
+		if !multi {
+			// unknown escape sequence; ignore the escape.
+			return 1
+		}
+		// ESC $ F designates a multibyte G0 set.
+		p.g0 = g
+		return 3
+	}
+	i++
+	if i >= len(data) {
+		return 0
+	}
+	if g == ')' || g == '-' {
+		p.g1 = data[i]
+	} else {
+		p.g0 = data[i]
+	}
+	return i + 1
+}
+
+// lookup returns the character with the given code
+// in set f.
+func (p *translateFromMARC8) lookup(f, c byte) rune {
+	set := p.sets[f]
+	if set == nil || c < 0x21 || c > 0x7e {
+		return utf8.RuneError
+	}
+	return set[c-0x21]
+}
+
+// lookupEACC returns the EACC character with the given
+// three bytes.
+func (p *translateFromMARC8) lookupEACC(code []byte) rune {
+	c := uint32(code[0]&0x7f)<<16 | uint32(code[1]&0x7f)<<8 | uint32(code[2]&0x7f)
+	if r, ok := p.eacc[c]; ok {
+		return r
+	}
+	return utf8.RuneError
+}
+
+func (p *translateFromMARC8) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		b := data[n]
+		switch {
+		case b == 0x1b:
+			size := p.escape(data[n:])
+			if size == 0 {
+				if !eof {
+					return n, p.scratch, nil
+				}
+				size = len(data) - n
+			}
+			n += size
+			continue
+		case b < 0x20 || b == 0x7f:
+			p.flushMarks()
+			if b == marc8FieldTerminator || b == marc8RecordTerminator {
+				p.g0, p.g1 = marc8ASCII, marc8ANSEL
+			}
+			p.scratch = append(p.scratch, b)
+		case b == 0x20:
+			p.emit(' ')
+		case b < 0x7f && p.g0 == marc8EACC:
+			if n+3 > len(data) {
+				if !eof {
+					return n, p.scratch, nil
+				}
+				n = len(data)
+				p.emit(utf8.RuneError)
+				continue
+			}
+			p.emit(p.lookupEACC(data[n : n+3]))
+			n += 3
+			continue
+		case b < 0x7f:
+			p.emit(p.lookup(p.g0, b))
+		case b == 0x88:
+			p.emit(0x98)
+		case b == 0x89:
+			p.emit(0x9c)
+		case b == 0x8d:
+			p.emit(zwj)
+		case b == 0x8e:
+			p.emit(zwnj)
+		case b >= 0xa1 && b <= 0xfe && p.g1 == marc8EACC:
+			if n+3 > len(data) {
+				if !eof {
+					return n, p.scratch, nil
+				}
+				n = len(data)
+				p.emit(utf8.RuneError)
+				continue
+			}
+			p.emit(p.lookupEACC(data[n : n+3]))
+			n += 3
+			continue
+		case b >= 0xa1 && b <= 0xfe:
+			p.emit(p.lookup(p.g1, b&0x7f))
+		default:
+			p.emit(utf8.RuneError)
+		}
+		n++
+	}
+	if eof {
+		p.flushMarks()
+	}
+	return n, p.scratch, nil
+}
+
+type translateToMARC8 struct {
+	*marc8Tables
+	g0, g1  byte
+	runes   []rune
+	nfd     []rune
+	scratch []byte
+}
+
+// designate emits the escape sequence needed to make set f
+// available, if it is not already.
+func (p *translateToMARC8) designate(f byte) {
+	switch {
+	case f == marc8ASCII:
+		if p.g0 == marc8ASCII {
+			return
+		}
+		if isTechnique1(p.g0) {
+			p.scratch = append(p.scratch, 0x1b, 's')
+		} else {
+			p.scratch = append(p.scratch, 0x1b, '(', marc8ASCII)
+		}
+		p.g0 = f
+	case isTechnique1(f):
+		if p.g0 != f {
+			p.scratch = append(p.scratch, 0x1b, f)
+			p.g0 = f
+		}
+	case f == marc8EACC:
+		if p.g0 != f {
+			if isTechnique1(p.g0) {
+				p.scratch = append(p.scratch, 0x1b, 's')
+			}
+			p.scratch = append(p.scratch, 0x1b, '$', f)
+			p.g0 = f
+		}
+	case marc8G1(f):
+		if p.g1 != f {
+			p.scratch = append(p.scratch, 0x1b, ')', f)
+			p.g1 = f
+		}
+	default:
+		if p.g0 != f {
+			if isTechnique1(p.g0) {
+				p.scratch = append(p.scratch, 0x1b, 's')
+			}
+			p.scratch = append(p.scratch, 0x1b, '(', f)
+			p.g0 = f
+		}
+	}
+}
+
+// resetSets restores the default character sets.
+func (p *translateToMARC8) resetSets() {
+	p.designate(marc8ASCII)
+	p.designate(marc8ANSEL)
+}
+
+// encode emits the code for a single character.
+func (p *translateToMARC8) encode(r rune) {
+	if r < 0x20 || r == 0x7f {
+		if r == marc8FieldTerminator || r == marc8RecordTerminator {
+			p.resetSets()
+		}
+		p.scratch = append(p.scratch, byte(r))
+		return
+	}
+	switch r {
+	case ' ':
+		p.scratch = append(p.scratch, ' ')
+		return
+	case 0x98:
+		p.scratch = append(p.scratch, 0x88)
+		return
+	case 0x9c:
+		p.scratch = append(p.scratch, 0x89)
+		return
+	case zwj:
+		p.scratch = append(p.scratch, 0x8d)
+		return
+	case zwnj:
+		p.scratch = append(p.scratch, 0x8e)
+		return
+	}
+	codes := p.codes[r]
+	if len(codes) == 0 {
+		if c, ok := p.toEACC[r]; ok {
+			p.designate(marc8EACC)
+			p.scratch = append(p.scratch, byte(c>>16), byte(c>>8), byte(c))
+			return
+		}
+		p.encode('?')
+		return
+	}
+	code := codes[0]
+	// prefer a set that is already designated.
+	for _, c := range codes {
+		if c.set == p.g0 || c.set == p.g1 {
+			code = c
+			break
+		}
+	}
+	p.designate(code.set)
+	if code.set == p.g0 {
+		p.scratch = append(p.scratch, code.code)
+	} else {
+		p.scratch = append(p.scratch, code.code|0x80)
+	}
+}
+
+func (p *translateToMARC8) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	// Diacritics that follow a character must be
+	// emitted before it.
+	n := completeClusters(data, eof)
+	p.runes = p.runes[:0]
+	for _, r := range string(data[:n]) {
+		p.runes = append(p.runes, r)
+	}
+	p.nfd = p.norm.nfd(p.nfd[:0], p.runes)
+	s := p.nfd
+	for i := 0; i < len(s); {
+		j := i + 1
+		for j < len(s) && isMark(s[j]) {
+			j++
+		}
+		for _, r := range s[i+1 : j] {
+			p.encode(r)
+		}
+		p.encode(s[i])
+		i = j
+	}
+	if eof {
+		p.resetSets()
+	}
+	return n, p.scratch, nil
+}
+
+func fromMARC8(arg string) (Translator, error) {
+	t, err := getMARC8Tables()
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromMARC8{marc8Tables: t, g0: marc8ASCII, g1: marc8ANSEL}, nil
+}
+
+func toMARC8(arg string) (Translator, error) {
+	t, err := getMARC8Tables()
+	if err != nil {
+		return nil, err
+	}
+	return &translateToMARC8{marc8Tables: t, g0: marc8ASCII, g1: marc8ANSEL}, nil
+}
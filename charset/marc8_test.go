@@ -0,0 +1,64 @@
+package charset
+
+import (
+	"testing"
+)
+
+// marc8CodeTablesSample is a small file in the format of the
+// LC code tables. Its mappings only exercise the format and
+// are not a copy of the real tables.
+const marc8CodeTablesSample = `<?xml version="1.0" encoding="UTF-8"?>
+<codeTables>
+<codeTable name="Extended Arabic" dateCreated="">
+<characterSet name="Extended Arabic" ISOcode="34">
+<code><marc>A1</marc><ucs>06FD</ucs><utf-8>DBBD</utf-8><name>DOUBLE ALEF WITH HAMZA ABOVE / ARABIC LETTER ALEF FINAL FORM</name></code>
+</characterSet>
+</codeTable>
+<codeTable name="East Asian Ideographs" dateCreated="">
+<characterSet name="CJK" ISOcode="31">
+<code><marc>213021</marc><ucs>4E00</ucs><utf-8>E4B880</utf-8><name>one</name></code>
+</characterSet>
+</codeTable>
+</codeTables>
+`
+
+var marc8CodeTableTests = []struct {
+	canRoundTrip bool
+	in           string
+	out          string
+}{
+	{true, "\x1b$1\x21\x30\x21\x1b(BA", "一A"},
+	{false, "\x1b$)1\xa1\xb0\xa1\x1b$1\x21\x30\x22", "一\ufffd"},
+	{true, "\x1b)4\xa1\x1b)E", "\u06fd"},
+}
+
+// TestMARC8CodeTables checks the EACC and Extended Arabic sets
+// read from a code tables file, without registering the file,
+// which would change the marc-8 charset for the other tests.
+func TestMARC8CodeTables(t *testing.T) {
+	norm, err := getNormTables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := readFile(marc8Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables, err := newMARC8Tables(data, []byte(marc8CodeTablesSample), norm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range marc8CodeTableTests {
+		dec := &translateFromMARC8{marc8Tables: tables, g0: marc8ASCII, g1: marc8ANSEL}
+		if _, out, _ := dec.Translate([]byte(test.in), true); string(out) != test.out {
+			t.Errorf("decoding %q: expected %q, got %q", test.in, test.out, out)
+		}
+		if !test.canRoundTrip {
+			continue
+		}
+		enc := &translateToMARC8{marc8Tables: tables, g0: marc8ASCII, g1: marc8ANSEL}
+		if _, out, _ := enc.Translate([]byte(test.out), true); string(out) != test.in {
+			t.Errorf("encoding %q: expected %q, got %q", test.out, test.in, out)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package charset
+
+import (
+	"unicode/utf8"
+)
+
+// Mojibake
+//
+// Text encoded in UTF-8 but decoded in another character set
+// turns each non-ASCII character into two to four characters:
+// "Café" becomes "CafÃ©" in Windows-1252, and "’" becomes
+// "â€™". FixMojibake reverses this. It encodes each run of
+// characters that the suspected character set holds, and
+// decodes the valid UTF-8 sequences in the result; a repair is
+// kept only if it lowers the mojibake cost of the text (see
+// mojibakeCost), and repairs are repeated until the text no
+// longer changes, to undo text that was mis-decoded more than
+// once.
+
+// mojibakeCharsets holds the character sets that UTF-8 text is
+// suspected to have been decoded in, in order of preference.
+var mojibakeCharsets = []string{"windows-1252", "iso-8859-1"}
+
+// maxMojibakeLayers is the most times that text is repaired.
+const maxMojibakeLayers = 8
+
+type mojibakeKey string
+
+// mojibakeBytes returns the byte that each character of the
+// named character set is encoded as. Bytes that the character
+// set leaves undefined are taken to stand for the C1 control
+// with the same value, as in lenient decoders.
+func mojibakeBytes(name string) (map[rune]byte, error) {
+	tr, err := TranslatorFrom(name)
+	if err != nil {
+		return nil, err
+	}
+	m, err := cache(mojibakeKey(name), func() (interface{}, error) {
+		var all [256]byte
+		for i := range all {
+			all[i] = byte(i)
+		}
+		_, data, err := tr.Translate(all[:], true)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[rune]byte)
+		for i := 0; len(data) > 0; i++ {
+			r, size := utf8.DecodeRune(data)
+			data = data[size:]
+			if r == utf8.RuneError {
+				r = rune(i)
+			}
+			if _, ok := m[r]; !ok {
+				m[r] = byte(i)
+			}
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.(map[rune]byte), nil
+}
+
+// FixMojibake returns s with any text that was encoded in
+// UTF-8 and wrongly decoded as Windows-1252 or ISO 8859-1
+// repaired. Text that does not look like mojibake is
+// returned unchanged.
+func FixMojibake(s string) string {
+	var tables []map[rune]byte
+	for _, name := range mojibakeCharsets {
+		if m, err := mojibakeBytes(name); err == nil {
+			tables = append(tables, m)
+		}
+	}
+	for i := 0; i < maxMojibakeLayers; i++ {
+		best, cost := s, mojibakeCost(s)
+		for _, m := range tables {
+			if t := fixMojibake(s, m); t != s {
+				if c := mojibakeCost(t); c < cost {
+					best, cost = t, c
+				}
+			}
+		}
+		if best == s {
+			break
+		}
+		s = best
+	}
+	return s
+}
+
+// fixMojibake encodes each run of the characters of s that m
+// holds, and decodes the valid multibyte UTF-8 sequences in
+// the result.
+func fixMojibake(s string, m map[rune]byte) string {
+	var out, run []rune
+	var enc []byte
+	flush := func() {
+		for i := 0; i < len(enc); {
+			if enc[i] >= utf8.RuneSelf {
+				if r, size := utf8.DecodeRune(enc[i:]); size > 1 {
+					out = append(out, r)
+					i += size
+					continue
+				}
+			}
+			out = append(out, run[i])
+			i++
+		}
+		run, enc = run[:0], enc[:0]
+	}
+	for _, r := range s {
+		if b, ok := m[r]; ok {
+			run = append(run, r)
+			enc = append(enc, b)
+			continue
+		}
+		flush()
+		out = append(out, r)
+	}
+	flush()
+	return string(out)
+}
+
+// mojibakeCost returns a measure of how much s looks like
+// mojibake: the number of C1 controls, which count double,
+// and of pairs of adjacent suspect characters (see
+// isMojibakeChar), which are rare in correct text but are
+// produced by every mis-decoded UTF-8 sequence.
+func mojibakeCost(s string) int {
+	cost := 0
+	prev := false
+	for _, r := range s {
+		if r >= 0x80 && r < 0xa0 {
+			cost += 2
+		}
+		suspect := isMojibakeChar(r)
+		if suspect && prev {
+			cost++
+		}
+		prev = suspect
+	}
+	return cost
+}
+
+// isMojibakeChar reports whether r is a non-ASCII character
+// of Windows-1252 or ISO 8859-1.
+func isMojibakeChar(r rune) bool {
+	switch {
+	case r >= 0x80 && r < 0x100, r >= 0x2013 && r <= 0x203a:
+		return true
+	}
+	switch r {
+	case 0x152, 0x153, 0x160, 0x161, 0x178, 0x17d, 0x17e, 0x192, 0x2c6, 0x2dc, 0x20ac, 0x2122:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// This file implements Unicode decomposition and composition
+// (the normalization forms NFC, NFD, NFKC and NFKD), for the
+// use of translators whose character sets encode accented
+// letters as a sequence of base letter and combining marks,
+// and of the Normalize option. The data comes from the file
+// normalize.dat, which holds the canonical combining class,
+// canonical decomposition and compatibility decomposition of
+// every character that has any of them.
+
+const normData = "normalize.dat"
+
+// Hangul syllables are composed and decomposed algorithmically.
+const (
+	hangulSBase  = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+type normTables struct {
+	ccc    map[rune]uint8
+	decomp map[rune][]rune // full canonical decompositions.
+	// kdecomp holds the full compatibility decompositions
+	// that differ from the canonical ones.
+	kdecomp    map[rune][]rune
+	composites map[[2]rune]rune
+	// seconds holds the characters that can be the second
+	// character of a primary composite.
+	seconds map[rune]bool
+}
+
+type normKey bool
+
+func getNormTables() (*normTables, error) {
+	t, err := cache(normKey(false), func() (interface{}, error) {
+		data, err := readFile(normData)
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open normalization data: %v", err)
+		}
+		return parseNormTables(data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.(*normTables), nil
+}
+
+func parseNormTables(data []byte) (*normTables, error) {
+	t := &normTables{
+		ccc:        make(map[rune]uint8),
+		decomp:     make(map[rune][]rune),
+		kdecomp:    make(map[rune][]rune),
+		composites: make(map[[2]rune]rune),
+		seconds:    make(map[rune]bool),
+	}
+	compat := make(map[rune][]rune)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Split(line, ";")
+		if len(f) < 3 {
+			return nil, fmt.Errorf("charset: bad normalization data line %q", line)
+		}
+		r, err := parseHexRune(f[0])
+		if err != nil {
+			return nil, err
+		}
+		ccc, err := strconv.ParseUint(f[1], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("charset: bad normalization data line %q", line)
+		}
+		if ccc != 0 {
+			t.ccc[r] = uint8(ccc)
+		}
+		if len(f) > 4 {
+			d, err := parseHexRunes(f[4])
+			if err != nil {
+				return nil, err
+			}
+			compat[r] = d
+		}
+		if f[2] == "" {
+			continue
+		}
+		d, err := parseHexRunes(f[2])
+		if err != nil {
+			return nil, err
+		}
+		t.decomp[r] = d
+		if len(d) == 2 && (len(f) < 4 || f[3] != "x") {
+			t.composites[[2]rune{d[0], d[1]}] = r
+			t.seconds[d[1]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	// Expand the decompositions recursively, so that
+	// each one need only be looked up once.
+	// A compatibility decomposition can hold characters
+	// that have canonical decompositions, and vice versa.
+	for _, m := range []map[rune][]rune{compat, t.decomp} {
+		for r := range m {
+			d := expand(nil, r, compat, t.decomp)
+			if string(d) != string(expand(nil, r, t.decomp, nil)) {
+				t.kdecomp[r] = d
+			}
+		}
+	}
+	for r := range t.decomp {
+		t.decomp[r] = expand(nil, r, t.decomp, nil)
+	}
+	return t, nil
+}
+
+// expand appends the full decomposition of r to buf, using
+// the decompositions in m, or failing that, in m2.
+func expand(buf []rune, r rune, m, m2 map[rune][]rune) []rune {
+	d, ok := m[r]
+	if !ok {
+		d, ok = m2[r]
+	}
+	if !ok {
+		return append(buf, r)
+	}
+	for _, dr := range d {
+		buf = expand(buf, dr, m, m2)
+	}
+	return buf
+}
+
+func parseHexRunes(s string) ([]rune, error) {
+	var d []rune
+	for _, h := range strings.Fields(s) {
+		r, err := parseHexRune(h)
+		if err != nil {
+			return nil, err
+		}
+		d = append(d, r)
+	}
+	return d, nil
+}
+
+func parseHexRune(s string) (rune, error) {
+	x, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("charset: bad code point %q in normalization data", s)
+	}
+	return rune(x), nil
+}
+
+// decomposes reports whether r has a canonical decomposition.
+func (t *normTables) decomposes(r rune) bool {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		return true
+	}
+	_, ok := t.decomp[r]
+	return ok
+}
+
+// decompose appends the full canonical decomposition of r to buf.
+func (t *normTables) decompose(buf []rune, r rune) []rune {
+	return t.decomposeForm(buf, r, false)
+}
+
+// decomposeForm appends the full decomposition of r to buf: the
+// compatibility decomposition if compat is true, otherwise the
+// canonical one.
+func (t *normTables) decomposeForm(buf []rune, r rune, compat bool) []rune {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		buf = append(buf, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if tr := s % hangulTCount; tr != 0 {
+			buf = append(buf, hangulTBase+tr)
+		}
+		return buf
+	}
+	if compat {
+		if d, ok := t.kdecomp[r]; ok {
+			return append(buf, d...)
+		}
+	}
+	if d, ok := t.decomp[r]; ok {
+		return append(buf, d...)
+	}
+	return append(buf, r)
+}
+
+// reorder puts the combining marks in s into canonical order.
+func (t *normTables) reorder(s []rune) {
+	for i := 0; i < len(s); {
+		if t.ccc[s[i]] == 0 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(s) && t.ccc[s[j]] != 0 {
+			j++
+		}
+		marks := s[i:j]
+		sort.Stable(byCCC{marks, t.ccc})
+		i = j
+	}
+}
+
+type byCCC struct {
+	s   []rune
+	ccc map[rune]uint8
+}
+
+func (b byCCC) Len() int           { return len(b.s) }
+func (b byCCC) Less(i, j int) bool { return b.ccc[b.s[i]] < b.ccc[b.s[j]] }
+func (b byCCC) Swap(i, j int)      { b.s[i], b.s[j] = b.s[j], b.s[i] }
+
+// composePair returns the primary composite of a and b,
+// if there is one.
+func (t *normTables) composePair(a, b rune) (rune, bool) {
+	if l := a - hangulLBase; l >= 0 && l < hangulLCount {
+		if v := b - hangulVBase; v >= 0 && v < hangulVCount {
+			return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+		}
+	}
+	if s := a - hangulSBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 {
+		if tr := b - hangulTBase; tr > 0 && tr < hangulTCount {
+			return a + tr, true
+		}
+	}
+	c, ok := t.composites[[2]rune{a, b}]
+	return c, ok
+}
+
+// compose applies the canonical composition algorithm to s,
+// which must be in canonical order, and returns the result,
+// which reuses the storage of s.
+func (t *normTables) compose(s []rune) []rune {
+	if len(s) == 0 {
+		return s
+	}
+	starter := -1
+	lastCCC := 256
+	if t.ccc[s[0]] == 0 {
+		starter, lastCCC = 0, 0
+	}
+	out := s[:1]
+	for _, r := range s[1:] {
+		ccc := int(t.ccc[r])
+		// r is blocked from the last starter if a character
+		// between them has the same or higher class.
+		if starter >= 0 && (lastCCC == 0 || lastCCC < ccc) {
+			if c, ok := t.composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if ccc == 0 {
+			starter = len(out)
+		}
+		lastCCC = ccc
+		out = append(out, r)
+	}
+	return out
+}
+
+// nfd appends the canonical decomposition of s to buf,
+// in canonical order.
+func (t *normTables) nfd(buf []rune, s []rune) []rune {
+	start := len(buf)
+	for _, r := range s {
+		buf = t.decompose(buf, r)
+	}
+	t.reorder(buf[start:])
+	return buf
+}
+
+// nfc appends the canonical composition of s to buf.
+func (t *normTables) nfc(buf []rune, s []rune) []rune {
+	start := len(buf)
+	buf = t.nfd(buf, s)
+	return append(buf[:start], t.compose(buf[start:])...)
+}
+
+// isMark reports whether r is a combining mark.
+func isMark(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me)
+}
+
+// completeClusters returns the length of the prefix of
+// data that can be translated without seeing the rest of
+// the input: unless eof is true, the last character and
+// any combining marks that follow it are left out, in case
+// more marks follow.
+func completeClusters(data []byte, eof bool) int {
+	n, last := 0, 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if !isMark(r) {
+			last = n
+		}
+		n += size
+	}
+	if !eof {
+		n = last
+	}
+	return n
+}
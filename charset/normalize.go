@@ -0,0 +1,197 @@
+package charset
+
+import (
+	"unicode/utf8"
+)
+
+// A Form is a Unicode normalization form (see Unicode
+// Standard Annex #15).
+type Form int
+
+const (
+	NFC  Form = iota + 1 // Canonical composition.
+	NFD                  // Canonical decomposition.
+	NFKC                 // Compatibility composition.
+	NFKD                 // Compatibility decomposition.
+)
+
+func (f Form) compat() bool {
+	return f == NFKC || f == NFKD
+}
+
+func (f Form) compose() bool {
+	return f == NFC || f == NFKC
+}
+
+// An Option changes the translation done by NewReader,
+// NewWriter or TranslatorTo.
+type Option func(*options)
+
+type options struct {
+	form      Form
+	translit  bool
+	romanize  []string // romanization schemes.
+	bestFit   bool
+	foldWidth bool
+
+	htmlFallback string // see NewHTMLReader.
+}
+
+func getOptions(opts []Option) *options {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Normalize returns an Option that puts the UTF-8 text
+// read from a Reader, or written to a Writer, into the
+// given normalization form.
+func Normalize(f Form) Option {
+	return func(o *options) {
+		o.form = f
+	}
+}
+
+// maxNormSegment is the length of the longest run of text
+// without a normalization boundary that is held back when
+// more input may follow. Longer runs (which in practice are
+// only found in malicious text) are normalized piecemeal.
+const maxNormSegment = 4096
+
+// boundaryBefore reports whether text normalized to form f
+// can be split before r, without changing the result.
+func (t *normTables) boundaryBefore(r rune, f Form) bool {
+	if r < utf8.RuneSelf {
+		// ASCII characters neither decompose nor combine.
+		return true
+	}
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		return true
+	}
+	first := r
+	if d, ok := t.kdecomp[r]; ok && f.compat() {
+		first = d[0]
+	} else if d, ok := t.decomp[r]; ok {
+		first = d[0]
+	}
+	if t.ccc[first] != 0 {
+		return false
+	}
+	if f.compose() {
+		if t.seconds[first] {
+			return false
+		}
+		if v := first - hangulVBase; v >= 0 && v < hangulVCount {
+			return false
+		}
+		if tr := first - hangulTBase; tr > 0 && tr < hangulTCount {
+			return false
+		}
+	}
+	return true
+}
+
+// segment returns the length of the first segment of data,
+// which ends at the next boundary (see boundaryBefore), and
+// whether the boundary was found. Unless eof is true, an
+// incomplete character at the end of data is left out.
+func (t *normTables) segment(data []byte, f Form, eof bool) (int, bool) {
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if n > 0 && t.boundaryBefore(r, f) {
+			return n, true
+		}
+		n += size
+	}
+	return n, false
+}
+
+// segments calls fn for each complete segment of data
+// and returns the number of bytes used.
+func (t *normTables) segments(data []byte, f Form, eof bool, fn func(seg []byte)) int {
+	n := 0
+	for n < len(data) {
+		size, ok := t.segment(data[n:], f, eof)
+		if !ok && !eof && (n > 0 || size < maxNormSegment) {
+			break
+		}
+		if size == 0 {
+			break
+		}
+		fn(data[n : n+size])
+		n += size
+	}
+	return n
+}
+
+// normalize appends the form f normalization of s to buf.
+func (t *normTables) normalize(buf []rune, s []rune, f Form) []rune {
+	start := len(buf)
+	for _, r := range s {
+		buf = t.decomposeForm(buf, r, f.compat())
+	}
+	t.reorder(buf[start:])
+	if f.compose() {
+		buf = append(buf[:start], t.compose(buf[start:])...)
+	}
+	return buf
+}
+
+type normalizer struct {
+	*normTables
+	form    Form
+	runes   []rune
+	norm    []rune
+	scratch []byte
+}
+
+func newNormalizer(f Form) (Translator, error) {
+	t, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	return &normalizer{normTables: t, form: f}, nil
+}
+
+func (p *normalizer) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := p.segments(data, p.form, eof, func(seg []byte) {
+		p.runes = p.runes[:0]
+		for _, r := range string(seg) {
+			p.runes = append(p.runes, r)
+		}
+		p.norm = p.normalize(p.norm[:0], p.runes, p.form)
+		for _, r := range p.norm {
+			p.scratch = appendRune(p.scratch, r)
+		}
+	})
+	return n, p.scratch, nil
+}
+
+// chainedTranslator passes the output of one translator
+// through another.
+type chainedTranslator struct {
+	first, second Translator
+	buf           []byte // output of first not yet used by second.
+}
+
+func (p *chainedTranslator) Translate(data []byte, eof bool) (int, []byte, error) {
+	n, cdata, err := p.first.Translate(data, eof)
+	if err != nil {
+		return n, nil, err
+	}
+	in := cdata
+	if len(p.buf) > 0 {
+		p.buf = append(p.buf, cdata...)
+		in = p.buf
+	}
+	m, out, err := p.second.Translate(in, eof && n == len(data))
+	p.buf = append(p.buf[:0], in[m:]...)
+	return n, out, err
+}
@@ -0,0 +1,409 @@
+package charset
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("punycode", fromPunycode, toPunycode)
+}
+
+// encoding details
+// Punycode (RFC 3492) and IDNA labels (RFC 5890)
+//
+// The class argument selects the mode:
+//
+//	""	each run of characters between white space is
+//		a Punycode string; white space is unchanged.
+//	"idna"	only the labels of dotted names are rewritten:
+//		when decoding, labels that start with "xn--"
+//		are decoded, and when encoding, labels that hold
+//		non-ASCII characters are encoded with the "xn--"
+//		prefix. All other text is unchanged.
+//
+// Notes
+//
+// A dotted name is a run of letters, digits, marks, hyphens and
+// full stops that holds a full stop between two labels, such as
+// "bücher.example"; names longer than 253 bytes are left alone.
+// The IDNA encoder lower-cases labels and puts them into
+// normalization form C, but does not check the other IDNA
+// rules. The IDNA decoder leaves labels that are not valid
+// Punycode unchanged; the plain decoder returns U+FFFD for
+// them.
+
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+	punyDelimiter   = '-'
+
+	idnaPrefix  = "xn--"
+	idnaMaxName = 253
+)
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > (punyBase-punyTMin)*punyTMax/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyDigitValue(c byte) (int, bool) {
+	switch {
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A'), true
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26, true
+	}
+	return 0, false
+}
+
+// appendPunycode appends the Punycode encoding of s to buf.
+func appendPunycode(buf []byte, s []rune) ([]byte, error) {
+	b := 0
+	for _, r := range s {
+		if r < 0x80 {
+			buf = append(buf, byte(r))
+			b++
+		}
+	}
+	if b > 0 {
+		buf = append(buf, punyDelimiter)
+	}
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for h := b; h < len(s); {
+		m := rune(unicode.MaxRune + 1)
+		for _, r := range s {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if int(m-n) > (1<<31-1-delta)/(h+1) {
+			return buf, fmt.Errorf("charset: punycode overflow")
+		}
+		delta += int(m-n) * (h + 1)
+		n = m
+		for _, r := range s {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				buf = append(buf, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			buf = append(buf, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return buf, nil
+}
+
+// decodePunycode appends the runes encoded by the
+// Punycode string s to buf.
+func decodePunycode(buf []rune, s string) ([]rune, error) {
+	start := len(buf)
+	in := 0
+	if i := strings.LastIndex(s, string(punyDelimiter)); i >= 0 {
+		for j := 0; j < i; j++ {
+			if s[j] >= 0x80 {
+				return buf, fmt.Errorf("charset: bad punycode %q", s)
+			}
+			buf = append(buf, rune(s[j]))
+		}
+		in = i + 1
+	}
+	n, i, bias := rune(punyInitialN), 0, punyInitialBias
+	for in < len(s) {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if in >= len(s) {
+				return buf, fmt.Errorf("charset: bad punycode %q", s)
+			}
+			digit, ok := punyDigitValue(s[in])
+			in++
+			if !ok || digit > (1<<31-1-i)/w {
+				return buf, fmt.Errorf("charset: bad punycode %q", s)
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > (1<<31-1)/(punyBase-t) {
+				return buf, fmt.Errorf("charset: bad punycode %q", s)
+			}
+			w *= punyBase - t
+		}
+		count := len(buf) - start + 1
+		bias = punyAdapt(i-oldi, count, oldi == 0)
+		if i/count > int(unicode.MaxRune-n) {
+			return buf, fmt.Errorf("charset: bad punycode %q", s)
+		}
+		n += rune(i / count)
+		i %= count
+		if n < 0x80 || n > unicode.MaxRune || n >= 0xd800 && n < 0xe000 {
+			return buf, fmt.Errorf("charset: bad punycode %q", s)
+		}
+		pos := start + i
+		buf = append(buf, 0)
+		copy(buf[pos+1:], buf[pos:])
+		buf[pos] = n
+		i++
+	}
+	return buf, nil
+}
+
+// isWordByte reports whether b belongs to a Punycode
+// string in the plain mode.
+func isWordByte(b byte) bool {
+	return b != ' ' && (b < '\t' || b > '\r')
+}
+
+// isNameRune reports whether r can appear in a dotted name.
+func isNameRune(r rune) bool {
+	return r == '.' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// A punycodeTranslator splits its input into runs of
+// characters for which inRun is true and translates each
+// run with translate, copying the text in between.
+type punycodeTranslator struct {
+	inRun     func(data []byte) (bool, int)
+	translate func(buf []byte, run []byte) []byte
+	// max is the longest run that is translated,
+	// or 0 if there is no limit.
+	max     int
+	skip    bool // in a run that is too long.
+	scratch []byte
+}
+
+func (p *punycodeTranslator) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		in, size := p.inRun(data[n:])
+		if !in {
+			p.skip = false
+			p.scratch = append(p.scratch, data[n:n+size]...)
+			n += size
+			continue
+		}
+		if p.skip {
+			p.scratch = append(p.scratch, data[n:n+size]...)
+			n += size
+			continue
+		}
+		// find the end of the run.
+		end := n + size
+		for end < len(data) {
+			if !eof && !utf8.FullRune(data[end:]) {
+				// wait for the rest of the character.
+				end = len(data)
+				break
+			}
+			in, size := p.inRun(data[end:])
+			if !in {
+				break
+			}
+			end += size
+		}
+		if p.max > 0 && end-n > p.max {
+			p.skip = true
+			continue
+		}
+		if end == len(data) && !eof {
+			// the run may continue.
+			break
+		}
+		p.scratch = p.translate(p.scratch, data[n:end])
+		n = end
+	}
+	return n, p.scratch, nil
+}
+
+func wordRun(data []byte) (bool, int) {
+	return isWordByte(data[0]), 1
+}
+
+func nameRun(data []byte) (bool, int) {
+	r, size := utf8.DecodeRune(data)
+	return isNameRune(r), size
+}
+
+// isDottedName reports whether name holds a full
+// stop between two labels.
+func isDottedName(name string) bool {
+	i := strings.Index(strings.Trim(name, "."), ".")
+	return i > 0
+}
+
+func fromPunycodeWord(buf, word []byte) []byte {
+	runes, err := decodePunycode(nil, string(word))
+	if err != nil {
+		return appendRune(buf, utf8.RuneError)
+	}
+	for _, r := range runes {
+		buf = appendRune(buf, r)
+	}
+	return buf
+}
+
+func toPunycodeWord(buf, word []byte) []byte {
+	buf, err := appendPunycode(buf, []rune(string(word)))
+	if err != nil {
+		return append(buf, errorByte)
+	}
+	return buf
+}
+
+type idnaTranslator struct {
+	norm  *normTables
+	runes []rune
+	nfc   []rune
+}
+
+func (p *idnaTranslator) fromName(buf, name []byte) []byte {
+	if !isDottedName(string(name)) {
+		return append(buf, name...)
+	}
+	for i, label := range strings.Split(string(name), ".") {
+		if i > 0 {
+			buf = append(buf, '.')
+		}
+		if len(label) > len(idnaPrefix) && strings.EqualFold(label[:len(idnaPrefix)], idnaPrefix) {
+			runes, err := decodePunycode(p.runes[:0], label[len(idnaPrefix):])
+			if err == nil {
+				p.runes = runes
+				for _, r := range runes {
+					buf = appendRune(buf, r)
+				}
+				continue
+			}
+		}
+		buf = append(buf, label...)
+	}
+	return buf
+}
+
+func (p *idnaTranslator) toName(buf, name []byte) []byte {
+	if !isDottedName(string(name)) {
+		return append(buf, name...)
+	}
+	for i, label := range strings.Split(string(name), ".") {
+		if i > 0 {
+			buf = append(buf, '.')
+		}
+		ascii := true
+		for j := 0; j < len(label); j++ {
+			if label[j] >= utf8.RuneSelf {
+				ascii = false
+				break
+			}
+		}
+		if ascii {
+			buf = append(buf, label...)
+			continue
+		}
+		p.runes = p.runes[:0]
+		for _, r := range label {
+			p.runes = append(p.runes, unicode.ToLower(r))
+		}
+		start := len(buf)
+		buf = append(buf, idnaPrefix...)
+		var err error
+		p.nfc = p.norm.nfc(p.nfc[:0], p.runes)
+		buf, err = appendPunycode(buf, p.nfc)
+		if err != nil {
+			buf = append(buf[:start], label...)
+		}
+	}
+	return buf
+}
+
+func punycodeArg(arg string) (bool, error) {
+	switch arg {
+	case "":
+		return false, nil
+	case "idna":
+		return true, nil
+	}
+	return false, fmt.Errorf("charset: unknown punycode mode %q", arg)
+}
+
+func fromPunycode(arg string) (Translator, error) {
+	idna, err := punycodeArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	if !idna {
+		return &punycodeTranslator{inRun: wordRun, translate: fromPunycodeWord}, nil
+	}
+	p := new(idnaTranslator)
+	return &punycodeTranslator{inRun: nameRun, translate: p.fromName, max: idnaMaxName}, nil
+}
+
+func toPunycode(arg string) (Translator, error) {
+	idna, err := punycodeArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	if !idna {
+		return &punycodeTranslator{inRun: wordRun, translate: toPunycodeWord}, nil
+	}
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	p := &idnaTranslator{norm: norm}
+	return &punycodeTranslator{inRun: nameRun, translate: p.toName, max: idnaMaxName}, nil
+}
@@ -0,0 +1,556 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Romanization
+//
+// The Romanize option adds a fallback stage to an encoder
+// (see fallback.go) that writes the letters of other scripts,
+// which the character set does not hold, in Latin letters.
+// The schemes are:
+//
+//	"iso9"	Cyrillic: ISO 9:1995, which is also GOST 7.79-2000
+//		system A. It uses diacritics, so that each Cyrillic
+//		letter has a distinct romanization.
+//	"icao"	Cyrillic: ICAO Doc 9303, as used in passports.
+//	"elot743"	Greek: ELOT 743 (ISO 843) transcription.
+//	"hepburn"	Japanese kana: modified Hepburn.
+//	"rr"	Korean Hangul: Revised Romanization.
+//
+// The tables come from the files romanize-<scheme>.dat.
+//
+// Notes
+//
+// The case of a letter carries over to its romanization: an
+// upper-case letter is written in capitals if the letter
+// after it is upper case, and in title case otherwise, so
+// that "Щука" becomes "Shchuka" and "ЩУКА" "SHCHUKA".
+// The Revised Romanization follows the sound changes between
+// syllables (see hangulRule). The Hepburn romanization writes
+// long vowels with a macron, whether they are spelled with the
+// long vowel mark (コーヒー kōhī) or with kana (とうきょう
+// tōkyō, おおさか ōsaka); an encoder for a character set
+// without the macron vowels, such as ASCII, drops the macron.
+// Kana are only taken for a long vowel when they double the
+// vowel before them (ああ, うう, ええ, おお) or make ou, and not
+// when a hiragana う ends a word after a syllable that is not
+// contracted, which is how verbs end: おもう is written omou,
+// and いう iu.
+
+type romanScheme struct {
+	// table maps letters, in canonical decomposition and
+	// lower case, to their romanizations; the second
+	// romanization of a letter, if any, is used by rule.
+	table  map[string][]string
+	maxKey int           // longest key, in runes.
+	starts map[rune]bool // first runes of the keys.
+	rule   romanRule     // context-dependent romanizations.
+}
+
+// A romanRule returns the romanization of the letters at the
+// start of s[i:], and the number of runes used, or 0 if the
+// rule does not apply. It can change the output already
+// written to p.out.
+type romanRule func(p *romanizer, sc *romanScheme, s []rune, i int) (string, int)
+
+var romanRules = map[string]romanRule{
+	"iso9":    nil,
+	"icao":    nil,
+	"elot743": greekRule,
+	"hepburn": hepburnRule,
+	"rr":      hangulRule,
+}
+
+type romanKey string
+
+func getRomanScheme(name string) (*romanScheme, error) {
+	rule, ok := romanRules[name]
+	if !ok {
+		return nil, fmt.Errorf("charset: unknown romanization scheme %q", name)
+	}
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	sc, err := cache(romanKey(name), func() (interface{}, error) {
+		data, err := readFile("romanize-" + name + ".dat")
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open romanization data: %v", err)
+		}
+		sc := &romanScheme{
+			table:  make(map[string][]string),
+			starts: make(map[rune]bool),
+			rule:   rule,
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			f := strings.Split(line, "\t")
+			if len(f) < 2 || f[0] == "" {
+				return nil, fmt.Errorf("charset: bad romanization data line %q", line)
+			}
+			key := norm.nfd(nil, []rune(f[0]))
+			sc.table[string(key)] = f[1:]
+			sc.starts[key[0]] = true
+			if len(key) > sc.maxKey {
+				sc.maxKey = len(key)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return sc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sc.(*romanScheme), nil
+}
+
+// foldRoman returns the rune that r is looked up as: its lower
+// case, or for katakana, the hiragana.
+func foldRoman(r rune) rune {
+	if r >= 0x30a1 && r <= 0x30f6 {
+		return r - 0x60
+	}
+	return unicode.ToLower(r)
+}
+
+// lookup returns the romanization of the longest key at
+// the start of s[i:], and the number of runes it holds.
+func (sc *romanScheme) lookup(s []rune, i int) ([]string, int) {
+	var key [8]rune
+	for k := sc.maxKey; k > 0; k-- {
+		if i+k > len(s) || k > len(key) {
+			continue
+		}
+		for j, r := range s[i : i+k] {
+			key[j] = foldRoman(r)
+		}
+		if v, ok := sc.table[string(key[:k])]; ok {
+			return v, k
+		}
+	}
+	return nil, 0
+}
+
+// romanizer writes runs of letters that the encoder cannot
+// encode in Latin letters.
+type romanizer struct {
+	*normTables
+	schemes []*romanScheme
+	enc     runeEncoder
+	prev    rune // the last character before the current run.
+	runes   []rune
+	decomp  []rune
+	out     []rune
+	scratch []byte
+}
+
+func newRomanizer(names []string, enc runeEncoder) (Translator, error) {
+	norm, err := getNormTables()
+	if err != nil {
+		return nil, err
+	}
+	p := &romanizer{normTables: norm, enc: enc}
+	for _, name := range names {
+		sc, err := getRomanScheme(name)
+		if err != nil {
+			return nil, err
+		}
+		p.schemes = append(p.schemes, sc)
+	}
+	return p, nil
+}
+
+// scheme returns the scheme that romanizes r, or nil.
+func (p *romanizer) scheme(r rune) *romanScheme {
+	var buf [4]rune
+	r = foldRoman(p.decompose(buf[:0], r)[0])
+	for _, sc := range p.schemes {
+		if sc.starts[r] {
+			return sc
+		}
+	}
+	return nil
+}
+
+// inRun reports whether r starts or continues a run of
+// letters to romanize.
+func (p *romanizer) inRun(r rune, started bool) bool {
+	if started && (p.ccc[r] != 0 || isMark(r)) {
+		return true
+	}
+	return !p.enc.encodes(r) && p.scheme(r) != nil
+}
+
+func (p *romanizer) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		if !p.inRun(r, false) {
+			p.scratch = append(p.scratch, data[n:n+size]...)
+			p.prev = r
+			n += size
+			continue
+		}
+		// find the end of the run.
+		end := n + size
+		for end < len(data) {
+			if !eof && !utf8.FullRune(data[end:]) {
+				end = len(data)
+				break
+			}
+			r, size := utf8.DecodeRune(data[end:])
+			if !p.inRun(r, true) {
+				break
+			}
+			end += size
+		}
+		if end == len(data) && !eof && (n > 0 || end < maxNormSegment) {
+			// the run may continue.
+			break
+		}
+		p.romanize(data[n:end])
+		n = end
+	}
+	return n, p.scratch, nil
+}
+
+func (p *romanizer) romanize(run []byte) {
+	p.runes = p.runes[:0]
+	for _, r := range string(run) {
+		p.runes = append(p.runes, r)
+	}
+	s := p.nfd(p.decomp[:0], p.runes)
+	p.decomp = s
+	p.out = p.out[:0]
+	for i := 0; i < len(s); {
+		sc := p.scheme(s[i])
+		if sc == nil || p.ccc[s[i]] != 0 {
+			p.out = append(p.out, s[i])
+			i++
+			continue
+		}
+		var rom string
+		size := 0
+		if sc.rule != nil {
+			rom, size = sc.rule(p, sc, s, i)
+		}
+		if size == 0 {
+			var v []string
+			if v, size = sc.lookup(s, i); size == 0 {
+				p.out = append(p.out, s[i])
+				i++
+				continue
+			}
+			rom = v[0]
+		}
+		p.appendCased(rom, s, i, size)
+		i += size
+	}
+	p.out = p.compose(p.out)
+	for _, r := range p.out {
+		p.scratch = appendRune(p.scratch, r)
+	}
+	p.prev = p.runes[len(p.runes)-1]
+}
+
+// appendCased appends rom to p.out, in the case of the
+// letters s[i:i+size] (see Notes above).
+func (p *romanizer) appendCased(rom string, s []rune, i, size int) {
+	if !unicode.IsUpper(s[i]) {
+		p.out = append(p.out, []rune(rom)...)
+		return
+	}
+	upper := false
+	if next := nextLetter(s, i+size); next >= 0 {
+		upper = unicode.IsUpper(s[next])
+	} else if i > 0 {
+		upper = unicode.IsUpper(s[i-1])
+	}
+	for j, r := range rom {
+		if upper || j == 0 {
+			r = unicode.ToUpper(r)
+		}
+		p.out = append(p.out, r)
+	}
+}
+
+// nextLetter returns the index of the first letter in s[i:],
+// or -1.
+func nextLetter(s []rune, i int) int {
+	for ; i < len(s); i++ {
+		if unicode.IsLetter(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextBase returns the index of the first rune in s[i:] that is
+// not a combining mark, or len(s).
+func (p *romanizer) nextBase(s []rune, i int) int {
+	for i < len(s) && p.ccc[s[i]] != 0 {
+		i++
+	}
+	return i
+}
+
+// atWordStart reports whether s[i] starts a word.
+func (p *romanizer) atWordStart(s []rune, i int) bool {
+	prev := p.prev
+	for j := i - 1; j >= 0; j-- {
+		if p.ccc[s[j]] == 0 {
+			prev = s[j]
+			break
+		}
+	}
+	return !unicode.IsLetter(prev)
+}
+
+const greekVoiceless = "θκξπστφχψς"
+
+func greekRule(p *romanizer, sc *romanScheme, s []rune, i int) (string, int) {
+	j := i + 1
+	if j >= len(s) {
+		return "", 0
+	}
+	a, b := foldRoman(s[i]), foldRoman(s[j])
+	switch {
+	case b == 'υ' && (a == 'α' || a == 'ε' || a == 'η' || a == 'ο'):
+		if j+1 < len(s) && s[j+1] == 0x308 {
+			// a diaeresis separates the vowels.
+			return "", 0
+		}
+		if a == 'ο' {
+			return "ou", 2
+		}
+		// αυ, ευ and ηυ are read as av, ev and iv before a
+		// voiced sound, and af, ef and if otherwise.
+		v, _ := sc.lookup(s, i)
+		k := p.nextBase(s, j+1)
+		if k == len(s) || !unicode.IsLetter(s[k]) || strings.ContainsRune(greekVoiceless, foldRoman(s[k])) {
+			return v[0] + "f", 2
+		}
+		return v[0] + "v", 2
+	case a == 'μ' && b == 'π':
+		if p.atWordStart(s, i) {
+			return "b", 2
+		}
+		return "mb", 2
+	case a == 'ν' && b == 'τ':
+		if p.atWordStart(s, i) {
+			return "d", 2
+		}
+		return "nt", 2
+	}
+	return "", 0
+}
+
+const (
+	sokuon     = 'っ'
+	moraicN    = 'ん'
+	longVowel  = 'ー'
+	macronMark = 0x304
+)
+
+func hepburnRule(p *romanizer, sc *romanScheme, s []rune, i int) (string, int) {
+	switch foldRoman(s[i]) {
+	case sokuon:
+		// double the consonant that follows.
+		v, size := sc.lookup(s, i+1)
+		if size == 0 || v[0] == "" || strings.ContainsAny(v[0][:1], "aiueo") {
+			return "", 1
+		}
+		if strings.HasPrefix(v[0], "ch") {
+			return "t", 1
+		}
+		return v[0][:1], 1
+	case moraicN:
+		v, size := sc.lookup(s, i+1)
+		if size > 0 && v[0] != "" && strings.ContainsAny(v[0][:1], "aiueoy") {
+			return "n'", 1
+		}
+		return "n", 1
+	case longVowel:
+		if n := len(p.out); n > 0 && strings.ContainsRune("aiueoAIUEO", p.out[n-1]) {
+			p.out = append(p.out, macronMark)
+		}
+		return "", 1
+	}
+	// a long vowel spelled with kana is written with a macron.
+	v, size := sc.lookup(s, i)
+	if size == 0 || v[0] == "" {
+		return "", 0
+	}
+	j := i + size
+	if j >= len(s) || j+1 < len(s) && p.ccc[s[j+1]] != 0 {
+		return "", 0
+	}
+	last := v[0][len(v[0])-1]
+	second, ok := hepburnLong[last]
+	if !ok || !strings.ContainsRune(second, foldRoman(s[j])) {
+		return "", 0
+	}
+	if s[j] == 'う' && p.nextBase(s, j+1) == len(s) && !strings.ContainsRune(hepburnSmallY, s[j-1]) {
+		// a final う after a syllable without a small ya, yu
+		// or yo is taken for a verb ending (おもう omou, くう kuu),
+		// not for part of a long vowel.
+		return "", 0
+	}
+	return v[0] + string(rune(macronMark)), size + 1
+}
+
+// hepburnLong holds, for each vowel, the kana that lengthen
+// it when they follow it. In modified Hepburn, ii and ei are
+// written as they are.
+var hepburnLong = map[byte]string{
+	'a': "あ",
+	'u': "う",
+	'e': "え",
+	'o': "うお",
+}
+
+// hepburnSmallY holds the small kana of the contracted
+// syllables (きゃ kya, きゅ kyu, きょ kyo).
+const hepburnSmallY = "ゃゅょャュョ"
+
+// Initial consonants (choseong) and final consonants (jongseong)
+// that take part in the sound changes of Revised Romanization.
+const (
+	hangulKiyeokL = 0x1100 // ᄀ
+	hangulNieunL  = 0x1102 // ᄂ
+	hangulTikeutL = 0x1103 // ᄃ
+	hangulRieulL  = 0x1105 // ᄅ
+	hangulMieumL  = 0x1106 // ᄆ
+	hangulSiosL   = 0x1109 // ᄉ
+	hangulSilentL = 0x110b // ᄋ, silent as an initial.
+	hangulCieucL  = 0x110c // ᄌ
+	hangulHieuhL  = 0x1112 // ᄒ
+	hangulIV      = 0x1175 // ᅵ
+
+	hangulNieunHieuhT = 0x11ad // final ㄶ
+	hangulTikeutT     = 0x11ae // final ㄷ
+	hangulRieulHieuhT = 0x11b6 // final ㅀ
+	hangulThieuthT    = 0x11c0 // final ㅌ
+	hangulHieuhT      = 0x11c2 // final ㅎ
+)
+
+// hangulRule romanizes a final consonant together with the
+// initial consonant of the next syllable, applying the sound
+// changes that Revised Romanization writes:
+//
+//	liaison	a final consonant before ᄋ is carried over
+//		to the vowel (한국어 hangugeo)
+//	nasalization	k, t and p become ng, n and m before
+//		ᄂ and ᄆ (백마 baengma)
+//	ᄅ after a consonant	ᄅ is read as n after consonants
+//		other than ㄴ and ㄹ (종로 jongno, 독립 dongnip)
+//	lateralization	ㄴ and ㄹ together are read as ll
+//		(신라 silla, 별내 byeollae)
+//	palatalization	ㄷ and ㅌ before 이 or 히 are read as
+//		j and ch (해돋이 haedoji, 같이 gachi)
+//	aspiration	ㅎ before ᄀ, ᄃ and ᄌ makes them k, t
+//		and ch (좋고 joko); it is silent before ᄉ
+//
+// Changes that depend on the words, rather than the sounds,
+// are not made: aspiration after ㄱ, ㄷ and ㅂ is not written,
+// as in nouns (묵호 Mukho), and the ll of ㄴ and ㄹ is used even
+// in words read with nn (신문로, officially Sinmunno).
+func hangulRule(p *romanizer, sc *romanScheme, s []rune, i int) (string, int) {
+	r := s[i]
+	if r <= hangulTBase || r >= hangulTBase+hangulTCount || i+1 >= len(s) {
+		return "", 0
+	}
+	v, _ := sc.lookup(s, i)
+	final := v[0]
+	next := s[i+1]
+	palatal := (r == hangulTikeutT || r == hangulThieuthT) &&
+		i+2 < len(s) && s[i+2] == hangulIV
+	var initial string
+	switch next {
+	case hangulSilentL:
+		if palatal {
+			if r == hangulTikeutT {
+				return "j", 1
+			}
+			return "ch", 1
+		}
+		if len(v) > 1 {
+			return v[1], 1
+		}
+		return "", 0
+	case hangulHieuhL:
+		if palatal && r == hangulTikeutT {
+			return "ch", 2
+		}
+		return "", 0
+	case hangulNieunL, hangulMieumL:
+		iv, _ := sc.lookup(s, i+1)
+		initial = iv[0]
+		switch final {
+		case "k":
+			final = "ng"
+		case "t":
+			final = "n"
+		case "p":
+			final = "m"
+		case "l":
+			if next == hangulNieunL {
+				initial = "l"
+			}
+		}
+	case hangulRieulL:
+		initial = "n"
+		switch final {
+		case "k":
+			final = "ng"
+		case "t":
+			final = "n"
+		case "p":
+			final = "m"
+		case "n", "l":
+			final, initial = "l", "l"
+		}
+	case hangulKiyeokL, hangulTikeutL, hangulCieucL, hangulSiosL:
+		switch r {
+		case hangulHieuhT:
+			final = ""
+		case hangulNieunHieuhT:
+			final = "n"
+		case hangulRieulHieuhT:
+			final = "l"
+		default:
+			return "", 0
+		}
+		switch next {
+		case hangulKiyeokL:
+			initial = "k"
+		case hangulTikeutL:
+			initial = "t"
+		case hangulCieucL:
+			initial = "ch"
+		case hangulSiosL:
+			// ㅎ makes ᄉ tense, which is not written.
+			initial = "s"
+		}
+	default:
+		return "", 0
+	}
+	return final + initial, 2
+}
@@ -0,0 +1,256 @@
+package charset
+
+import (
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("tscii", fromTSCII, toTSCII)
+}
+
+// encoding details
+// TSCII 1.7 (Tamil Standard Code for Information Interchange)
+//
+// 00..7f	ASCII
+// 80..ff	Tamil letters, ligatures and digits (see tsciiTable)
+//
+// Many TSCII codes represent a consonant cluster or a consonant
+// with a vowel sign, and decode to more than one code point.
+//
+// TSCII stores text in glyph order, so the vowel signs
+// e (a6), ee (a7) and ai (a8), which are written to the left of
+// a consonant, precede it in the byte stream. The two-part vowel
+// signs o, oo and au are encoded as a6 or a7 before the consonant,
+// and aa (a1) or the au length mark (aa) after it. In Unicode
+// the vowel sign always follows the consonant, so the decoder
+// and encoder reorder these sequences.
+
+const (
+	tsciiPrefixE  = 0xa6
+	tsciiPrefixEE = 0xa7
+	tsciiPrefixAI = 0xa8
+	tsciiSignAA   = 0xa1
+	tsciiAULength = 0xaa
+
+	// tsciiMaxSeq holds the maximum number of bytes of UTF-8
+	// input that the encoder needs to see at once.
+	tsciiMaxSeq = 6 * 3
+)
+
+// tsciiTable maps TSCII codes 80..ff to Unicode.
+// Empty entries are undefined.
+var tsciiTable = [128]string{
+	"\u0be6", "\u0be7", "\u0bb8\u0bcd\u0bb0\u0bc0", "\u0b9c", // 80
+	"\u0bb7", "\u0bb8", "\u0bb9", "\u0b95\u0bcd\u0bb7", // 84
+	"\u0b9c\u0bcd", "\u0bb7\u0bcd", "\u0bb8\u0bcd", "\u0bb9\u0bcd", // 88
+	"\u0b95\u0bcd\u0bb7\u0bcd", "\u0be8", "\u0be9", "\u0bea", // 8c
+	"\u0beb", "\u2018", "\u2019", "\u201c", // 90
+	"\u201d", "\u0bec", "\u0bed", "\u0bee", // 94
+	"\u0bef", "\u0b99\u0bc1", "\u0b9e\u0bc1", "\u0b99\u0bc2", // 98
+	"\u0b9e\u0bc2", "\u0bf0", "\u0bf1", "\u0bf2", // 9c
+	"", "\u0bbe", "\u0bbf", "\u0bc0", // a0
+	"\u0bc1", "\u0bc2", "\u0bc6", "\u0bc7", // a4
+	"\u0bc8", "\u00a9", "\u0bd7", "\u0b85", // a8
+	"\u0b86", "\u0b87", "\u0b88", "\u0b89", // ac
+	"\u0b8a", "\u0b8e", "\u0b8f", "\u0b90", // b0
+	"\u0b92", "\u0b93", "\u0b94", "\u0b83", // b4
+	"\u0b95", "\u0b99", "\u0b9a", "\u0b9e", // b8
+	"\u0b9f", "\u0ba3", "\u0ba4", "\u0ba8", // bc
+	"\u0baa", "\u0bae", "\u0baf", "\u0bb0", // c0
+	"\u0bb2", "\u0bb5", "\u0bb4", "\u0bb3", // c4
+	"\u0bb1", "\u0ba9", "\u0b9f\u0bbf", "\u0b9f\u0bc0", // c8
+	"\u0b95\u0bc1", "\u0b9a\u0bc1", "\u0b9f\u0bc1", "\u0ba3\u0bc1", // cc
+	"\u0ba4\u0bc1", "\u0ba8\u0bc1", "\u0baa\u0bc1", "\u0bae\u0bc1", // d0
+	"\u0baf\u0bc1", "\u0bb0\u0bc1", "\u0bb2\u0bc1", "\u0bb5\u0bc1", // d4
+	"\u0bb4\u0bc1", "\u0bb3\u0bc1", "\u0bb1\u0bc1", "\u0ba9\u0bc1", // d8
+	"\u0b95\u0bc2", "\u0b9a\u0bc2", "\u0b9f\u0bc2", "\u0ba3\u0bc2", // dc
+	"\u0ba4\u0bc2", "\u0ba8\u0bc2", "\u0baa\u0bc2", "\u0bae\u0bc2", // e0
+	"\u0baf\u0bc2", "\u0bb0\u0bc2", "\u0bb2\u0bc2", "\u0bb5\u0bc2", // e4
+	"\u0bb4\u0bc2", "\u0bb3\u0bc2", "\u0bb1\u0bc2", "\u0ba9\u0bc2", // e8
+	"\u0b95\u0bcd", "\u0b99\u0bcd", "\u0b9a\u0bcd", "\u0b9e\u0bcd", // ec
+	"\u0b9f\u0bcd", "\u0ba3\u0bcd", "\u0ba4\u0bcd", "\u0ba8\u0bcd", // f0
+	"\u0baa\u0bcd", "\u0bae\u0bcd", "\u0baf\u0bcd", "\u0bb0\u0bcd", // f4
+	"\u0bb2\u0bcd", "\u0bb5\u0bcd", "\u0bb4\u0bcd", "\u0bb3\u0bcd", // f8
+	"\u0bb1\u0bcd", "\u0ba9\u0bcd", "\u0b87", "", // fc
+}
+
+// tsciiIsConsonant reports whether the TSCII code b
+// represents a consonant or a consonant cluster that
+// can take a vowel sign.
+func tsciiIsConsonant(b byte) bool {
+	if b < 0x80 {
+		return false
+	}
+	s := tsciiTable[b-0x80]
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r >= 0x0b95 && r <= 0x0bb9
+}
+
+type translateFromTSCII struct {
+	scratch []byte
+}
+
+func (p *translateFromTSCII) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		b := data[n]
+		if b < 0x80 {
+			p.scratch = append(p.scratch, b)
+			n++
+			continue
+		}
+		if b == tsciiPrefixE || b == tsciiPrefixEE || b == tsciiPrefixAI {
+			if !eof && n+2 >= len(data) {
+				break
+			}
+			if n+1 < len(data) && tsciiIsConsonant(data[n+1]) {
+				vowel := rune(0)
+				size := 2
+				if n+2 < len(data) {
+					switch {
+					case b == tsciiPrefixE && data[n+2] == tsciiSignAA:
+						vowel = 0x0bca
+					case b == tsciiPrefixEE && data[n+2] == tsciiSignAA:
+						vowel = 0x0bcb
+					case b == tsciiPrefixE && data[n+2] == tsciiAULength:
+						vowel = 0x0bcc
+					}
+				}
+				p.scratch = append(p.scratch, tsciiTable[data[n+1]-0x80]...)
+				if vowel != 0 {
+					p.scratch = appendRune(p.scratch, vowel)
+					size++
+				} else {
+					p.scratch = append(p.scratch, tsciiTable[b-0x80]...)
+				}
+				n += size
+				continue
+			}
+		}
+		if s := tsciiTable[b-0x80]; s != "" {
+			p.scratch = append(p.scratch, s...)
+		} else {
+			p.scratch = append(p.scratch, errorBytes...)
+		}
+		n++
+	}
+	return n, p.scratch, nil
+}
+
+type tsciiKey bool
+
+// tsciiCodes holds the reverse mapping of tsciiTable.
+type tsciiCodes struct {
+	codes  map[string]byte
+	maxLen int // maximum number of runes in a key.
+}
+
+func getTSCIICodes() *tsciiCodes {
+	m, _ := cache(tsciiKey(true), func() (interface{}, error) {
+		m := &tsciiCodes{codes: make(map[string]byte)}
+		for i, s := range tsciiTable {
+			if s == "" {
+				continue
+			}
+			if _, ok := m.codes[s]; !ok {
+				m.codes[s] = byte(i + 0x80)
+			}
+			if n := utf8.RuneCountInString(s); n > m.maxLen {
+				m.maxLen = n
+			}
+		}
+		return m, nil
+	})
+	return m.(*tsciiCodes)
+}
+
+// tsciiSplitVowels maps the Unicode vowel signs that are written
+// to the left of a consonant to the TSCII codes that precede and
+// follow the consonant.
+var tsciiSplitVowels = map[rune][2]byte{
+	0x0bc6: {tsciiPrefixE, 0},
+	0x0bc7: {tsciiPrefixEE, 0},
+	0x0bc8: {tsciiPrefixAI, 0},
+	0x0bca: {tsciiPrefixE, tsciiSignAA},
+	0x0bcb: {tsciiPrefixEE, tsciiSignAA},
+	0x0bcc: {tsciiPrefixE, tsciiAULength},
+}
+
+type translateToTSCII struct {
+	*tsciiCodes
+	scratch []byte
+}
+
+// match returns the TSCII code for the longest sequence of
+// runes at the start of data, and the number of bytes in it.
+func (p *translateToTSCII) match(data []byte) (byte, int) {
+	end := 0
+	var code byte
+	size := 0
+	for i := 0; i < p.maxLen && end < len(data); i++ {
+		_, rsize := utf8.DecodeRune(data[end:])
+		end += rsize
+		if c, ok := p.codes[string(data[:end])]; ok {
+			code, size = c, end
+		}
+	}
+	return code, size
+}
+
+func (p *translateToTSCII) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	for n < len(data) {
+		if !eof && len(data)-n < tsciiMaxSeq {
+			break
+		}
+		if b := data[n]; b < utf8.RuneSelf {
+			p.scratch = append(p.scratch, b)
+			n++
+			continue
+		}
+		code, size := p.match(data[n:])
+		if size == 0 {
+			_, size = utf8.DecodeRune(data[n:])
+			p.scratch = append(p.scratch, errorByte)
+			n += size
+			continue
+		}
+		if tsciiIsConsonant(code) {
+			// look for a vowel sign that must be
+			// moved in front of the consonant.
+			rest := data[n+size:]
+			r, rsize := utf8.DecodeRune(rest)
+			if split, ok := tsciiSplitVowels[r]; ok {
+				// accept the decomposed forms of o, oo and au too.
+				r2, r2size := utf8.DecodeRune(rest[rsize:])
+				switch {
+				case (r == 0x0bc6 || r == 0x0bc7) && r2 == 0x0bbe:
+					split[1] = tsciiSignAA
+					rsize += r2size
+				case r == 0x0bc6 && r2 == 0x0bd7:
+					split[1] = tsciiAULength
+					rsize += r2size
+				}
+				p.scratch = append(p.scratch, split[0], code)
+				if split[1] != 0 {
+					p.scratch = append(p.scratch, split[1])
+				}
+				n += size + rsize
+				continue
+			}
+		}
+		p.scratch = append(p.scratch, code)
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func fromTSCII(arg string) (Translator, error) {
+	return new(translateFromTSCII), nil
+}
+
+func toTSCII(arg string) (Translator, error) {
+	return &translateToTSCII{tsciiCodes: getTSCIICodes()}, nil
+}
@@ -106,5 +106,9 @@ func toUTF16(arg string) (Translator, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &translateToUTF16{first: false, endian: endian}, nil
+	if endian == nil {
+		// write a byte order mark, as the decoder expects.
+		return &translateToUTF16{first: true, endian: binary.BigEndian}, nil
+	}
+	return &translateToUTF16{endian: endian}, nil
 }
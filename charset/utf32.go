@@ -0,0 +1,124 @@
+package charset
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("utf32", fromUTF32, toUTF32)
+}
+
+// encoding details
+// UTF-32
+//
+// The class argument gives the byte order: "le" or "be".
+// With no argument, the decoder takes the byte order from a
+// leading byte order mark, which it removes, and otherwise
+// uses big-endian order; the encoder writes a byte order
+// mark followed by big-endian text.
+// Code units that are not Unicode scalar values, and
+// incomplete code units at the end of the input, are decoded
+// as U+FFFD.
+
+type translateFromUTF32 struct {
+	first   bool
+	endian  binary.ByteOrder
+	scratch []byte
+}
+
+func (p *translateFromUTF32) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = p.scratch[:0]
+	n := 0
+	if p.first {
+		if len(data) < 4 && !eof {
+			return 0, nil, nil
+		}
+		p.first = false
+		if len(data) >= 4 {
+			switch binary.BigEndian.Uint32(data) {
+			case 0xfeff:
+				p.endian = binary.BigEndian
+				n = 4
+			case 0xfffe0000:
+				p.endian = binary.LittleEndian
+				n = 4
+			}
+		}
+		if p.endian == nil {
+			p.endian = binary.BigEndian
+		}
+	}
+	for ; len(data)-n >= 4; n += 4 {
+		r := rune(p.endian.Uint32(data[n:]))
+		if !utf8.ValidRune(r) {
+			r = utf8.RuneError
+		}
+		p.scratch = appendRune(p.scratch, r)
+	}
+	if eof && n < len(data) {
+		// incomplete code unit.
+		p.scratch = appendRune(p.scratch, utf8.RuneError)
+		n = len(data)
+	}
+	return n, p.scratch, nil
+}
+
+type translateToUTF32 struct {
+	first   bool
+	endian  binary.ByteOrder
+	scratch []byte
+}
+
+func (p *translateToUTF32) Translate(data []byte, eof bool) (int, []byte, error) {
+	p.scratch = ensureCap(p.scratch[:0], (len(data)+1)*4)
+	if p.first {
+		p.scratch = p.scratch[0:4]
+		p.endian.PutUint32(p.scratch, 0xfeff)
+		p.first = false
+	}
+	n := 0
+	for n < len(data) {
+		if !eof && !utf8.FullRune(data[n:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(data[n:])
+		slen := len(p.scratch)
+		p.scratch = p.scratch[0 : slen+4]
+		p.endian.PutUint32(p.scratch[slen:], uint32(r))
+		n += size
+	}
+	return n, p.scratch, nil
+}
+
+func getUTF32Endian(arg string) (binary.ByteOrder, error) {
+	switch arg {
+	case "le":
+		return binary.LittleEndian, nil
+	case "be":
+		return binary.BigEndian, nil
+	case "":
+		return nil, nil
+	}
+	return nil, errors.New("charset: unknown utf32 endianness")
+}
+
+func fromUTF32(arg string) (Translator, error) {
+	endian, err := getUTF32Endian(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromUTF32{first: endian == nil, endian: endian}, nil
+}
+
+func toUTF32(arg string) (Translator, error) {
+	endian, err := getUTF32Endian(arg)
+	if err != nil {
+		return nil, err
+	}
+	if endian == nil {
+		return &translateToUTF32{first: true, endian: binary.BigEndian}, nil
+	}
+	return &translateToUTF32{endian: endian}, nil
+}
@@ -1,15 +1,28 @@
 package charset
 
 import (
+	"bytes"
+	"fmt"
 	"unicode/utf8"
 )
 
 func init() {
-	registerClass("utf8", toUTF8, toUTF8)
+	registerClass("utf8", fromUTF8, toUTF8)
 }
 
+// encoding details
+// UTF-8
+//
+// With the class argument "sig", the decoder removes a
+// leading byte order mark (EF BB BF), and the encoder writes
+// one at the start of its output.
+
+var utf8BOM = []byte("\ufeff")
+
 type translateToUTF8 struct {
-	scratch []byte
+	stripBOM bool // a byte order mark at the start is removed.
+	writeBOM bool // a byte order mark is to be written.
+	scratch  []byte
 }
 
 var errorBytes = []byte(string(utf8.RuneError))
@@ -17,8 +30,27 @@ var errorBytes = []byte(string(utf8.RuneError))
 const errorRuneLen = len(string(utf8.RuneError))
 
 func (p *translateToUTF8) Translate(data []byte, eof bool) (int, []byte, error) {
-	p.scratch = ensureCap(p.scratch, (len(data))*errorRuneLen)
+	skip := 0
+	if p.stripBOM {
+		if !eof && len(data) < len(utf8BOM) && bytes.HasPrefix(utf8BOM, data) {
+			return 0, nil, nil
+		}
+		p.stripBOM = false
+		if bytes.HasPrefix(data, utf8BOM) {
+			skip = len(utf8BOM)
+		}
+	}
+	n, buf := p.translate(data[skip:], eof)
+	return skip + n, buf, nil
+}
+
+func (p *translateToUTF8) translate(data []byte, eof bool) (int, []byte) {
+	p.scratch = ensureCap(p.scratch, (len(data))*errorRuneLen+len(utf8BOM))
 	buf := p.scratch[:0]
+	if p.writeBOM {
+		buf = append(buf, utf8BOM...)
+		p.writeBOM = false
+	}
 	for i := 0; i < len(data); {
 		// fast path for ASCII
 		if b := data[i]; b < utf8.RuneSelf {
@@ -35,7 +67,7 @@ func (p *translateToUTF8) Translate(data []byte, eof bool) (int, []byte, error)
 				// If we aren't at EOF, and it's an incomplete
 				// rune encoding, then we return to process
 				// the final bytes in a subsequent call.
-				return i, buf, nil
+				return i, buf
 			}
 			buf = append(buf, errorBytes...)
 		} else {
@@ -43,9 +75,31 @@ func (p *translateToUTF8) Translate(data []byte, eof bool) (int, []byte, error)
 		}
 		i += size
 	}
-	return len(data), buf, nil
+	return len(data), buf
+}
+
+func utf8Sig(arg string) (bool, error) {
+	switch arg {
+	case "":
+		return false, nil
+	case "sig":
+		return true, nil
+	}
+	return false, fmt.Errorf("charset: unknown utf8 variant %q", arg)
+}
+
+func fromUTF8(arg string) (Translator, error) {
+	sig, err := utf8Sig(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToUTF8{stripBOM: sig}, nil
 }
 
 func toUTF8(arg string) (Translator, error) {
-	return new(translateToUTF8), nil
+	sig, err := utf8Sig(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToUTF8{writeBOM: sig}, nil
 }
@@ -0,0 +1,112 @@
+package charset
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+func init() {
+	registerClass("visual", fromVisual, toVisual)
+}
+
+// The visual class is used for code pages that store
+// bidirectional text in visual order, such as ISO-8859-8
+// (as distinct from ISO-8859-8-I, which is in logical order).
+// Its argument is the name of the code page file.
+//
+// Each line is converted between visual and logical order
+// using the Unicode bidirectional algorithm, with a
+// right-to-left paragraph direction.
+
+const visualParaLevel = 1
+
+type translateFromVisual struct {
+	cp      Translator
+	line    []rune
+	scratch []byte
+}
+
+func (p *translateFromVisual) Translate(data []byte, eof bool) (int, []byte, error) {
+	// The code page is a single-byte encoding that
+	// leaves ASCII intact, so we can find the
+	// end of the last complete line before decoding.
+	n := len(data)
+	if !eof {
+		n = bytes.LastIndexByte(data, '\n') + 1
+		if n == 0 {
+			return 0, nil, nil
+		}
+	}
+	_, cdata, err := p.cp.Translate(data[:n], true)
+	if err != nil {
+		return 0, nil, err
+	}
+	p.scratch, p.line = reorderLines(p.scratch[:0], p.line, cdata)
+	return n, p.scratch, nil
+}
+
+type translateToVisual struct {
+	cp      Translator
+	line    []rune
+	scratch []byte
+}
+
+func (p *translateToVisual) Translate(data []byte, eof bool) (int, []byte, error) {
+	n := len(data)
+	if !eof {
+		n = bytes.LastIndexByte(data, '\n') + 1
+		if n == 0 {
+			return 0, nil, nil
+		}
+	}
+	p.scratch, p.line = reorderLines(p.scratch[:0], p.line, data[:n])
+	_, cdata, err := p.cp.Translate(p.scratch, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, cdata, nil
+}
+
+// reorderLines appends the UTF-8 text in data to buf,
+// reordering each line with bidiReorder, and returns the
+// new buffer. The line slice is used as scratch space
+// and returned for reuse.
+func reorderLines(buf []byte, line []rune, data []byte) ([]byte, []rune) {
+	for len(data) > 0 {
+		line = line[:0]
+		for len(data) > 0 {
+			r, size := utf8.DecodeRune(data)
+			if r == '\n' || r == '\r' {
+				break
+			}
+			line = append(line, r)
+			data = data[size:]
+		}
+		bidiReorder(line, visualParaLevel)
+		for _, r := range line {
+			buf = appendRune(buf, r)
+		}
+		// copy the line terminator, if any.
+		for len(data) > 0 && (data[0] == '\n' || data[0] == '\r') {
+			buf = append(buf, data[0])
+			data = data[1:]
+		}
+	}
+	return buf, line
+}
+
+func fromVisual(arg string) (Translator, error) {
+	cp, err := fromCodePage(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateFromVisual{cp: cp}, nil
+}
+
+func toVisual(arg string) (Translator, error) {
+	cp, err := toCodePage(arg)
+	if err != nil {
+		return nil, err
+	}
+	return &translateToVisual{cp: cp}, nil
+}
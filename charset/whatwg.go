@@ -0,0 +1,937 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// WHATWG Encoding Standard
+//
+// Browsers decode web content in the encodings of the WHATWG
+// Encoding Standard (https://encoding.spec.whatwg.org/), which
+// differ from the IANA character sets: the labels "iso-8859-1",
+// "ascii" and "latin1", for example, all name windows-1252, and
+// the labels of ISO-2022-KR and other encodings that can hide
+// markup name the "replacement" encoding, which decodes any
+// input to a single U+FFFD. WHATWG returns the decoder of the
+// encoding with a given label, which follows the algorithm of
+// the standard byte for byte, including the number and position
+// of the U+FFFD characters that replace malformed input.
+//
+// The indexes of the standard are held in the whatwg-* data
+// files: single-byte encodings as code pages, and multi-byte
+// indexes as the code points of their pointers, with U+FFFD
+// for pointers that have none. They are generated from the
+// standard's index-*.txt files by data/generate-whatwg.go.
+// The tests check the decoders against the TextDecoder of a
+// browser, whose output data/generate-whatwg-tests.go records.
+
+// whatwgEncodings holds the encodings of the standard, with
+// their labels and decoders.
+var whatwgEncodings = []struct {
+	name    string
+	labels  []string
+	decoder func() (Translator, error)
+}{
+	{"UTF-8", []string{"unicode-1-1-utf-8", "unicode11utf8", "unicode20utf8", "utf-8", "utf8", "x-unicode20utf8"}, whatwgUTF8Decoder},
+	{"IBM866", []string{"866", "cp866", "csibm866", "ibm866"}, whatwgSingleByte("ibm866")},
+	{"ISO-8859-2", []string{"csisolatin2", "iso-8859-2", "iso-ir-101", "iso8859-2", "iso88592", "iso_8859-2", "iso_8859-2:1987", "l2", "latin2"}, whatwgSingleByte("iso-8859-2")},
+	{"ISO-8859-3", []string{"csisolatin3", "iso-8859-3", "iso-ir-109", "iso8859-3", "iso88593", "iso_8859-3", "iso_8859-3:1988", "l3", "latin3"}, whatwgSingleByte("iso-8859-3")},
+	{"ISO-8859-4", []string{"csisolatin4", "iso-8859-4", "iso-ir-110", "iso8859-4", "iso88594", "iso_8859-4", "iso_8859-4:1988", "l4", "latin4"}, whatwgSingleByte("iso-8859-4")},
+	{"ISO-8859-5", []string{"csisolatincyrillic", "cyrillic", "iso-8859-5", "iso-ir-144", "iso8859-5", "iso88595", "iso_8859-5", "iso_8859-5:1988"}, whatwgSingleByte("iso-8859-5")},
+	{"ISO-8859-6", []string{"arabic", "asmo-708", "csiso88596e", "csiso88596i", "csisolatinarabic", "ecma-114", "iso-8859-6", "iso-8859-6-e", "iso-8859-6-i", "iso-ir-127", "iso8859-6", "iso88596", "iso_8859-6", "iso_8859-6:1987"}, whatwgSingleByte("iso-8859-6")},
+	{"ISO-8859-7", []string{"csisolatingreek", "ecma-118", "elot_928", "greek", "greek8", "iso-8859-7", "iso-ir-126", "iso8859-7", "iso88597", "iso_8859-7", "iso_8859-7:1987", "sun_eu_greek"}, whatwgSingleByte("iso-8859-7")},
+	{"ISO-8859-8", []string{"csiso88598e", "csisolatinhebrew", "hebrew", "iso-8859-8", "iso-8859-8-e", "iso-ir-138", "iso8859-8", "iso88598", "iso_8859-8", "iso_8859-8:1988", "visual"}, whatwgSingleByte("iso-8859-8")},
+	{"ISO-8859-8-I", []string{"csiso88598i", "iso-8859-8-i", "logical"}, whatwgSingleByte("iso-8859-8")},
+	{"ISO-8859-10", []string{"csisolatin6", "iso-8859-10", "iso-ir-157", "iso8859-10", "iso885910", "l6", "latin6"}, whatwgSingleByte("iso-8859-10")},
+	{"ISO-8859-13", []string{"iso-8859-13", "iso8859-13", "iso885913"}, whatwgSingleByte("iso-8859-13")},
+	{"ISO-8859-14", []string{"iso-8859-14", "iso8859-14", "iso885914"}, whatwgSingleByte("iso-8859-14")},
+	{"ISO-8859-15", []string{"csisolatin9", "iso-8859-15", "iso8859-15", "iso885915", "iso_8859-15", "l9"}, whatwgSingleByte("iso-8859-15")},
+	{"ISO-8859-16", []string{"iso-8859-16"}, whatwgSingleByte("iso-8859-16")},
+	{"KOI8-R", []string{"cskoi8r", "koi", "koi8", "koi8-r", "koi8_r"}, whatwgSingleByte("koi8-r")},
+	{"KOI8-U", []string{"koi8-ru", "koi8-u"}, whatwgSingleByte("koi8-u")},
+	{"macintosh", []string{"csmacintosh", "mac", "macintosh", "x-mac-roman"}, whatwgSingleByte("macintosh")},
+	{"windows-874", []string{"dos-874", "iso-8859-11", "iso8859-11", "iso885911", "tis-620", "windows-874"}, whatwgSingleByte("windows-874")},
+	{"windows-1250", []string{"cp1250", "windows-1250", "x-cp1250"}, whatwgSingleByte("windows-1250")},
+	{"windows-1251", []string{"cp1251", "windows-1251", "x-cp1251"}, whatwgSingleByte("windows-1251")},
+	{"windows-1252", []string{"ansi_x3.4-1968", "ascii", "cp1252", "cp819", "csisolatin1", "ibm819", "iso-8859-1", "iso-ir-100", "iso8859-1", "iso88591", "iso_8859-1", "iso_8859-1:1987", "l1", "latin1", "us-ascii", "windows-1252", "x-cp1252"}, whatwgSingleByte("windows-1252")},
+	{"windows-1253", []string{"cp1253", "windows-1253", "x-cp1253"}, whatwgSingleByte("windows-1253")},
+	{"windows-1254", []string{"cp1254", "csisolatin5", "iso-8859-9", "iso-ir-148", "iso8859-9", "iso88599", "iso_8859-9", "iso_8859-9:1989", "l5", "latin5", "windows-1254", "x-cp1254"}, whatwgSingleByte("windows-1254")},
+	{"windows-1255", []string{"cp1255", "windows-1255", "x-cp1255"}, whatwgSingleByte("windows-1255")},
+	{"windows-1256", []string{"cp1256", "windows-1256", "x-cp1256"}, whatwgSingleByte("windows-1256")},
+	{"windows-1257", []string{"cp1257", "windows-1257", "x-cp1257"}, whatwgSingleByte("windows-1257")},
+	{"windows-1258", []string{"cp1258", "windows-1258", "x-cp1258"}, whatwgSingleByte("windows-1258")},
+	{"x-mac-cyrillic", []string{"x-mac-cyrillic", "x-mac-ukrainian"}, whatwgSingleByte("x-mac-cyrillic")},
+	{"GBK", []string{"chinese", "csgb2312", "csiso58gb231280", "gb2312", "gb_2312", "gb_2312-80", "gbk", "iso-ir-58", "x-gbk"}, whatwgGB18030Decoder},
+	{"gb18030", []string{"gb18030"}, whatwgGB18030Decoder},
+	{"Big5", []string{"big5", "big5-hkscs", "cn-big5", "csbig5", "x-x-big5"}, whatwgBig5Decoder},
+	{"EUC-JP", []string{"cseucpkdfmtjapanese", "euc-jp", "x-euc-jp"}, whatwgEUCJPDecoder},
+	{"ISO-2022-JP", []string{"csiso2022jp", "iso-2022-jp"}, whatwgISO2022JPDecoder},
+	{"Shift_JIS", []string{"csshiftjis", "ms932", "ms_kanji", "shift-jis", "shift_jis", "sjis", "windows-31j", "x-sjis"}, whatwgShiftJISDecoder},
+	{"EUC-KR", []string{"cseuckr", "csksc56011987", "euc-kr", "iso-ir-149", "korean", "ks_c_5601-1987", "ks_c_5601-1989", "ksc5601", "ksc_5601", "windows-949"}, whatwgEUCKRDecoder},
+	{"replacement", []string{"csiso2022kr", "hz-gb-2312", "iso-2022-cn", "iso-2022-cn-ext", "iso-2022-kr", "replacement"}, whatwgReplacementDecoder},
+	{"UTF-16BE", []string{"unicodefffe", "utf-16be"}, whatwgUTF16Decoder(true)},
+	{"UTF-16LE", []string{"csunicode", "iso-10646-ucs-2", "ucs-2", "unicode", "unicodefeff", "utf-16", "utf-16le"}, whatwgUTF16Decoder(false)},
+	{"x-user-defined", []string{"x-user-defined"}, whatwgUserDefinedDecoder},
+}
+
+// whatwgLabels maps each label to its index in whatwgEncodings.
+var whatwgLabels = func() map[string]int {
+	m := make(map[string]int)
+	for i, e := range whatwgEncodings {
+		for _, label := range e.labels {
+			m[label] = i
+		}
+	}
+	return m
+}()
+
+// lookupWHATWG returns the index in whatwgEncodings of the
+// encoding with the given label.
+func lookupWHATWG(label string) (int, bool) {
+	label = strings.ToLower(strings.Trim(label, "\t\n\f\r "))
+	i, ok := whatwgLabels[label]
+	return i, ok
+}
+
+// WHATWGName returns the name of the encoding with the given
+// label in the WHATWG Encoding Standard, and whether the label
+// is known. Labels are matched as in the standard: ignoring
+// the case of ASCII letters and surrounding white space.
+func WHATWGName(label string) (string, bool) {
+	i, ok := lookupWHATWG(label)
+	if !ok {
+		return "", false
+	}
+	return whatwgEncodings[i].name, true
+}
+
+// WHATWG returns a Translator that translates from the encoding
+// with the given label in the WHATWG Encoding Standard to UTF-8,
+// as the decoder of the standard does, replacing errors with
+// U+FFFD. A byte order mark is translated like any other
+// character; NewWHATWGReader removes it.
+func WHATWG(label string) (Translator, error) {
+	i, ok := lookupWHATWG(label)
+	if !ok {
+		return nil, fmt.Errorf("charset: unknown WHATWG encoding label %q", label)
+	}
+	return whatwgEncodings[i].decoder()
+}
+
+// NewWHATWGReader returns a new Reader that translates r to
+// UTF-8 as the "decode" algorithm of the WHATWG Encoding
+// Standard does: if r starts with a UTF-8 or UTF-16 byte order
+// mark, the mark is removed and the rest of r is decoded in
+// the encoding that it indicates; otherwise r is decoded in
+// the encoding with the given label.
+// The options are as for NewReader.
+func NewWHATWGReader(label string, r io.Reader, opts ...Option) (io.Reader, error) {
+	if _, ok := lookupWHATWG(label); !ok {
+		return nil, fmt.Errorf("charset: unknown WHATWG encoding label %q", label)
+	}
+	br := bufio.NewReader(r)
+	data, err := br.Peek(3)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if name, n := whatwgBOM(data); n > 0 {
+		label = name
+		br.Discard(n)
+	}
+	tr, err := WHATWG(label)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(tr, br, opts)
+}
+
+// whatwgBOM returns the encoding indicated by the byte order
+// mark at the start of data, and the length of the mark, as
+// "BOM sniff" in the standard does. It returns "", 0 if data
+// does not start with one.
+func whatwgBOM(data []byte) (string, int) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xef, 0xbb, 0xbf}):
+		return "UTF-8", 3
+	case bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		return "UTF-16BE", 2
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}):
+		return "UTF-16LE", 2
+	}
+	return "", 0
+}
+
+const (
+	// whatwgEOQ is given to a whatwgHandler at the end of the
+	// stream (the "end-of-queue" item of the standard).
+	whatwgEOQ = -1
+	// whatwgFinished is returned by a whatwgHandler when it
+	// has finished at the end of the stream.
+	whatwgFinished = -1
+	// maxPrepend is the largest number of bytes that a
+	// whatwgHandler prepends to the stream.
+	maxPrepend = 3
+)
+
+// A whatwgHandler is a decoder written as in the standard.
+// The handle method processes the next byte of the stream, or
+// whatwgEOQ, and appends the code points that it decodes to buf,
+// with U+FFFD for an error. It returns the number of the bytes
+// most recently read, including b, that are to be prepended to
+// the stream to be read again, or whatwgFinished.
+type whatwgHandler interface {
+	handle(buf []byte, b int) ([]byte, int)
+}
+
+// translateFromWHATWG runs a whatwgHandler over the stream.
+// It consumes all the data that it is given, keeping the
+// bytes that might be prepended to the stream.
+type translateFromWHATWG struct {
+	h       whatwgHandler
+	recent  []byte // the last bytes read, most recent last.
+	queue   []byte // the bytes prepended to the stream.
+	scratch []byte
+}
+
+func newWHATWGTranslator(h whatwgHandler) *translateFromWHATWG {
+	return &translateFromWHATWG{h: h, recent: make([]byte, 0, maxPrepend)}
+}
+
+func (p *translateFromWHATWG) Translate(data []byte, eof bool) (int, []byte, error) {
+	n := len(data)
+	buf := p.scratch[:0]
+	for {
+		for len(p.queue) > 0 || len(data) > 0 {
+			var b byte
+			if len(p.queue) > 0 {
+				b, p.queue = p.queue[0], p.queue[1:]
+			} else {
+				b, data = data[0], data[1:]
+			}
+			if len(p.recent) == maxPrepend {
+				p.recent = p.recent[:copy(p.recent, p.recent[1:])]
+			}
+			p.recent = append(p.recent, b)
+			var k int
+			buf, k = p.h.handle(buf, int(b))
+			p.prepend(k)
+		}
+		if !eof {
+			break
+		}
+		var k int
+		buf, k = p.h.handle(buf, whatwgEOQ)
+		if k == whatwgFinished {
+			break
+		}
+		p.prepend(k)
+	}
+	p.scratch = buf
+	return n, buf, nil
+}
+
+// prepend prepends the k bytes most recently read to the stream.
+func (p *translateFromWHATWG) prepend(k int) {
+	if k <= 0 {
+		return
+	}
+	i := len(p.recent) - k
+	queue := make([]byte, 0, k+len(p.queue))
+	queue = append(queue, p.recent[i:]...)
+	p.queue = append(queue, p.queue...)
+	p.recent = p.recent[:i]
+}
+
+func appendError(buf []byte) []byte {
+	return appendRune(buf, utf8.RuneError)
+}
+
+func isASCIIByte(b int) bool {
+	return b >= 0 && b < utf8.RuneSelf
+}
+
+// whatwgSingleByte returns the decoder of the single-byte
+// encoding whose index is in the code page whatwg-name.cp.
+func whatwgSingleByte(name string) func() (Translator, error) {
+	return func() (Translator, error) {
+		return fromCodePage("whatwg-" + name + ".cp")
+	}
+}
+
+type whatwgIndexKey string
+
+// whatwgIndex returns the index with the given name, which
+// has size pointers.
+func whatwgIndex(name string, size int) ([]rune, error) {
+	index, err := cache(whatwgIndexKey(name), func() (interface{}, error) {
+		data, err := readFile("whatwg-" + name + ".dat")
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open WHATWG index: %v", err)
+		}
+		index := []rune(string(data))
+		if len(index) != size {
+			return nil, fmt.Errorf("charset: corrupt WHATWG index %q", name)
+		}
+		return index, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index.([]rune), nil
+}
+
+// indexCodePoint returns the code point for pointer in index,
+// and false if there is none.
+func indexCodePoint(index []rune, pointer int) (rune, bool) {
+	if pointer < 0 || pointer >= len(index) || index[pointer] == utf8.RuneError {
+		return 0, false
+	}
+	return index[pointer], true
+}
+
+// UTF-8
+
+type whatwgUTF8 struct {
+	cp           rune
+	seen, needed int
+	lower, upper int
+}
+
+func whatwgUTF8Decoder() (Translator, error) {
+	return newWHATWGTranslator(&whatwgUTF8{lower: 0x80, upper: 0xbf}), nil
+}
+
+func (d *whatwgUTF8) reset() {
+	d.cp, d.seen, d.needed = 0, 0, 0
+	d.lower, d.upper = 0x80, 0xbf
+}
+
+func (d *whatwgUTF8) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.needed == 0 {
+			return buf, whatwgFinished
+		}
+		d.reset()
+		return appendError(buf), 0
+	}
+	if d.needed == 0 {
+		switch {
+		case b < 0x80:
+			return append(buf, byte(b)), 0
+		case b >= 0xc2 && b <= 0xdf:
+			d.needed, d.cp = 1, rune(b&0x1f)
+		case b >= 0xe0 && b <= 0xef:
+			if b == 0xe0 {
+				d.lower = 0xa0
+			}
+			if b == 0xed {
+				d.upper = 0x9f
+			}
+			d.needed, d.cp = 2, rune(b&0xf)
+		case b >= 0xf0 && b <= 0xf4:
+			if b == 0xf0 {
+				d.lower = 0x90
+			}
+			if b == 0xf4 {
+				d.upper = 0x8f
+			}
+			d.needed, d.cp = 3, rune(b&0x7)
+		default:
+			return appendError(buf), 0
+		}
+		return buf, 0
+	}
+	if b < d.lower || b > d.upper {
+		d.reset()
+		return appendError(buf), 1
+	}
+	d.lower, d.upper = 0x80, 0xbf
+	d.cp = d.cp<<6 | rune(b&0x3f)
+	d.seen++
+	if d.seen < d.needed {
+		return buf, 0
+	}
+	cp := d.cp
+	d.reset()
+	return appendRune(buf, cp), 0
+}
+
+// UTF-16BE and UTF-16LE
+
+type whatwgUTF16 struct {
+	bigEndian     bool
+	leadByte      int // -1 for none.
+	leadSurrogate rune
+}
+
+func whatwgUTF16Decoder(bigEndian bool) func() (Translator, error) {
+	return func() (Translator, error) {
+		return newWHATWGTranslator(&whatwgUTF16{bigEndian: bigEndian, leadByte: -1}), nil
+	}
+}
+
+func (d *whatwgUTF16) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.leadByte < 0 && d.leadSurrogate == 0 {
+			return buf, whatwgFinished
+		}
+		d.leadByte, d.leadSurrogate = -1, 0
+		return appendError(buf), 0
+	}
+	if d.leadByte < 0 {
+		d.leadByte = b
+		return buf, 0
+	}
+	cu := rune(b<<8 | d.leadByte)
+	if d.bigEndian {
+		cu = rune(d.leadByte<<8 | b)
+	}
+	d.leadByte = -1
+	if lead := d.leadSurrogate; lead != 0 {
+		d.leadSurrogate = 0
+		if cu >= 0xdc00 && cu <= 0xdfff {
+			return appendRune(buf, 0x10000+(lead-0xd800)<<10+(cu-0xdc00)), 0
+		}
+		return appendError(buf), 2
+	}
+	switch {
+	case cu >= 0xd800 && cu <= 0xdbff:
+		d.leadSurrogate = cu
+		return buf, 0
+	case cu >= 0xdc00 && cu <= 0xdfff:
+		return appendError(buf), 0
+	}
+	return appendRune(buf, cu), 0
+}
+
+// gb18030 and GBK
+
+// A gbRange is an entry of the index gb18030 ranges: the
+// first pointer of a range of four-byte codes, and its code
+// point.
+type gbRange struct {
+	pointer int
+	cp      rune
+}
+
+type whatwgGB18030 struct {
+	first, second, third int
+	index                []rune
+	ranges               []gbRange
+}
+
+type gbRangesKey struct{}
+
+func getGBRanges() ([]gbRange, error) {
+	ranges, err := cache(gbRangesKey{}, func() (interface{}, error) {
+		data, err := readFile("whatwg-gb18030-ranges.dat")
+		if err != nil {
+			return nil, fmt.Errorf("charset: cannot open WHATWG index: %v", err)
+		}
+		var ranges []gbRange
+		for _, line := range strings.Split(string(data), "\n") {
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			f := strings.Split(line, "\t")
+			if len(f) != 2 {
+				return nil, fmt.Errorf("charset: bad gb18030 ranges line %q", line)
+			}
+			pointer, err1 := strconv.Atoi(f[0])
+			cp, err2 := strconv.ParseInt(f[1], 0, 32)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("charset: bad gb18030 ranges line %q", line)
+			}
+			ranges = append(ranges, gbRange{pointer, rune(cp)})
+		}
+		return ranges, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ranges.([]gbRange), nil
+}
+
+func whatwgGB18030Decoder() (Translator, error) {
+	index, err := whatwgIndex("gb18030", 126*190)
+	if err != nil {
+		return nil, err
+	}
+	ranges, err := getGBRanges()
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgGB18030{index: index, ranges: ranges}), nil
+}
+
+// rangesCodePoint returns the code point for a four-byte
+// code, and false if there is none.
+func (d *whatwgGB18030) rangesCodePoint(pointer int) (rune, bool) {
+	if pointer > 39419 && pointer < 189000 || pointer > 1237575 {
+		return 0, false
+	}
+	if pointer == 7457 {
+		return 0xe7c7, true
+	}
+	r := d.ranges[0]
+	for _, e := range d.ranges {
+		if e.pointer > pointer {
+			break
+		}
+		r = e
+	}
+	return r.cp + rune(pointer-r.pointer), true
+}
+
+func (d *whatwgGB18030) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.first == 0 && d.second == 0 && d.third == 0 {
+			return buf, whatwgFinished
+		}
+		d.first, d.second, d.third = 0, 0, 0
+		return appendError(buf), 0
+	}
+	if d.third != 0 {
+		if b < 0x30 || b > 0x39 {
+			d.first, d.second, d.third = 0, 0, 0
+			return appendError(buf), 3
+		}
+		pointer := (d.first-0x81)*(10*126*10) + (d.second-0x30)*(10*126) + (d.third-0x81)*10 + b - 0x30
+		d.first, d.second, d.third = 0, 0, 0
+		cp, ok := d.rangesCodePoint(pointer)
+		if !ok {
+			return appendError(buf), 0
+		}
+		return appendRune(buf, cp), 0
+	}
+	if d.second != 0 {
+		if b >= 0x81 && b <= 0xfe {
+			d.third = b
+			return buf, 0
+		}
+		d.first, d.second = 0, 0
+		return appendError(buf), 2
+	}
+	if d.first != 0 {
+		if b >= 0x30 && b <= 0x39 {
+			d.second = b
+			return buf, 0
+		}
+		lead := d.first
+		d.first = 0
+		offset := 0x41
+		if b < 0x7f {
+			offset = 0x40
+		}
+		if b >= 0x40 && b <= 0x7e || b >= 0x80 && b <= 0xfe {
+			if cp, ok := indexCodePoint(d.index, (lead-0x81)*190+b-offset); ok {
+				return appendRune(buf, cp), 0
+			}
+		}
+		if isASCIIByte(b) {
+			return appendError(buf), 1
+		}
+		return appendError(buf), 0
+	}
+	switch {
+	case isASCIIByte(b):
+		return append(buf, byte(b)), 0
+	case b == 0x80:
+		return appendRune(buf, 0x20ac), 0
+	case b >= 0x81 && b <= 0xfe:
+		d.first = b
+		return buf, 0
+	}
+	return appendError(buf), 0
+}
+
+// Big5
+
+type whatwgBig5 struct {
+	lead  int
+	index []rune
+}
+
+func whatwgBig5Decoder() (Translator, error) {
+	index, err := whatwgIndex("big5", 126*157)
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgBig5{index: index}), nil
+}
+
+func (d *whatwgBig5) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.lead == 0 {
+			return buf, whatwgFinished
+		}
+		d.lead = 0
+		return appendError(buf), 0
+	}
+	if lead := d.lead; lead != 0 {
+		d.lead = 0
+		offset := 0x62
+		if b < 0x7f {
+			offset = 0x40
+		}
+		if b >= 0x40 && b <= 0x7e || b >= 0xa1 && b <= 0xfe {
+			// the pointers of four codes give two code points.
+			if pair, ok := big5HKSCSPairs[lead<<8|b]; ok {
+				return appendRune(appendRune(buf, pair[0]), pair[1]), 0
+			}
+			if cp, ok := indexCodePoint(d.index, (lead-0x81)*157+b-offset); ok {
+				return appendRune(buf, cp), 0
+			}
+		}
+		if isASCIIByte(b) {
+			return appendError(buf), 1
+		}
+		return appendError(buf), 0
+	}
+	switch {
+	case isASCIIByte(b):
+		return append(buf, byte(b)), 0
+	case b >= 0x81 && b <= 0xfe:
+		d.lead = b
+		return buf, 0
+	}
+	return appendError(buf), 0
+}
+
+// EUC-JP
+
+type whatwgEUCJP struct {
+	jis0212         bool
+	lead            int
+	jis0208, jis212 []rune
+}
+
+func whatwgEUCJPDecoder() (Translator, error) {
+	jis0208, err := whatwgIndex("jis0208", 11280)
+	if err != nil {
+		return nil, err
+	}
+	jis0212, err := whatwgIndex("jis0212", 94*94)
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgEUCJP{jis0208: jis0208, jis212: jis0212}), nil
+}
+
+func (d *whatwgEUCJP) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.lead == 0 {
+			return buf, whatwgFinished
+		}
+		d.lead = 0
+		return appendError(buf), 0
+	}
+	switch lead := d.lead; {
+	case lead == 0x8e && b >= 0xa1 && b <= 0xdf:
+		d.lead = 0
+		return appendRune(buf, 0xff61-0xa1+rune(b)), 0
+	case lead == 0x8f && b >= 0xa1 && b <= 0xfe:
+		d.jis0212 = true
+		d.lead = b
+		return buf, 0
+	case lead != 0:
+		d.lead = 0
+		index := d.jis0208
+		if d.jis0212 {
+			index = d.jis212
+		}
+		d.jis0212 = false
+		if lead >= 0xa1 && lead <= 0xfe && b >= 0xa1 && b <= 0xfe {
+			if cp, ok := indexCodePoint(index, (lead-0xa1)*94+b-0xa1); ok {
+				return appendRune(buf, cp), 0
+			}
+		}
+		if isASCIIByte(b) {
+			return appendError(buf), 1
+		}
+		return appendError(buf), 0
+	}
+	switch {
+	case isASCIIByte(b):
+		return append(buf, byte(b)), 0
+	case b == 0x8e || b == 0x8f || b >= 0xa1 && b <= 0xfe:
+		d.lead = b
+		return buf, 0
+	}
+	return appendError(buf), 0
+}
+
+// ISO-2022-JP
+
+type iso2022JPState int
+
+const (
+	iso2022JPASCII iso2022JPState = iota
+	iso2022JPRoman
+	iso2022JPKatakana
+	iso2022JPLeadByte
+	iso2022JPTrailByte
+	iso2022JPEscapeStart
+	iso2022JPEscape
+)
+
+type whatwgISO2022JP struct {
+	state, outputState iso2022JPState
+	lead               int
+	output             bool
+	jis0208            []rune
+}
+
+func whatwgISO2022JPDecoder() (Translator, error) {
+	jis0208, err := whatwgIndex("jis0208", 11280)
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgISO2022JP{jis0208: jis0208}), nil
+}
+
+func (d *whatwgISO2022JP) handle(buf []byte, b int) ([]byte, int) {
+	switch d.state {
+	case iso2022JPASCII, iso2022JPRoman, iso2022JPKatakana, iso2022JPLeadByte:
+		switch {
+		case b == 0x1b:
+			d.state = iso2022JPEscapeStart
+			return buf, 0
+		case b == whatwgEOQ:
+			return buf, whatwgFinished
+		}
+		d.output = false
+		switch d.state {
+		case iso2022JPASCII:
+			if isASCIIByte(b) && b != 0x0e && b != 0x0f {
+				return append(buf, byte(b)), 0
+			}
+		case iso2022JPRoman:
+			switch {
+			case b == 0x5c:
+				return appendRune(buf, 0xa5), 0
+			case b == 0x7e:
+				return appendRune(buf, 0x203e), 0
+			case isASCIIByte(b) && b != 0x0e && b != 0x0f:
+				return append(buf, byte(b)), 0
+			}
+		case iso2022JPKatakana:
+			if b >= 0x21 && b <= 0x5f {
+				return appendRune(buf, 0xff61-0x21+rune(b)), 0
+			}
+		case iso2022JPLeadByte:
+			if b >= 0x21 && b <= 0x7e {
+				d.lead = b
+				d.state = iso2022JPTrailByte
+				return buf, 0
+			}
+		}
+		return appendError(buf), 0
+	case iso2022JPTrailByte:
+		switch {
+		case b == 0x1b:
+			d.state = iso2022JPEscapeStart
+			return appendError(buf), 0
+		case b >= 0x21 && b <= 0x7e:
+			d.state = iso2022JPLeadByte
+			if cp, ok := indexCodePoint(d.jis0208, (d.lead-0x21)*94+b-0x21); ok {
+				return appendRune(buf, cp), 0
+			}
+			return appendError(buf), 0
+		}
+		d.state = iso2022JPLeadByte
+		return appendError(buf), 0
+	case iso2022JPEscapeStart:
+		if b == 0x24 || b == 0x28 {
+			d.lead = b
+			d.state = iso2022JPEscape
+			return buf, 0
+		}
+		d.output = false
+		d.state = d.outputState
+		if b == whatwgEOQ {
+			return appendError(buf), 0
+		}
+		return appendError(buf), 1
+	}
+	// iso2022JPEscape
+	lead := d.lead
+	d.lead = 0
+	state, ok := iso2022JPASCII, true
+	switch {
+	case lead == 0x28 && b == 0x42:
+		state = iso2022JPASCII
+	case lead == 0x28 && b == 0x4a:
+		state = iso2022JPRoman
+	case lead == 0x28 && b == 0x49:
+		state = iso2022JPKatakana
+	case lead == 0x24 && (b == 0x40 || b == 0x42):
+		state = iso2022JPLeadByte
+	default:
+		ok = false
+	}
+	if ok {
+		d.state, d.outputState = state, state
+		output := d.output
+		d.output = true
+		if output {
+			return appendError(buf), 0
+		}
+		return buf, 0
+	}
+	d.output = false
+	d.state = d.outputState
+	if b == whatwgEOQ {
+		return appendError(buf), 1
+	}
+	return appendError(buf), 2
+}
+
+// Shift_JIS
+
+type whatwgShiftJIS struct {
+	lead    int
+	jis0208 []rune
+}
+
+func whatwgShiftJISDecoder() (Translator, error) {
+	jis0208, err := whatwgIndex("jis0208", 11280)
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgShiftJIS{jis0208: jis0208}), nil
+}
+
+func (d *whatwgShiftJIS) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.lead == 0 {
+			return buf, whatwgFinished
+		}
+		d.lead = 0
+		return appendError(buf), 0
+	}
+	if lead := d.lead; lead != 0 {
+		d.lead = 0
+		offset, leadOffset := 0x41, 0xc1
+		if b < 0x7f {
+			offset = 0x40
+		}
+		if lead < 0xa0 {
+			leadOffset = 0x81
+		}
+		if b >= 0x40 && b <= 0x7e || b >= 0x80 && b <= 0xfc {
+			pointer := (lead-leadOffset)*188 + b - offset
+			if pointer >= 8836 && pointer <= 10715 {
+				// the user-defined area.
+				return appendRune(buf, 0xe000-8836+rune(pointer)), 0
+			}
+			if cp, ok := indexCodePoint(d.jis0208, pointer); ok {
+				return appendRune(buf, cp), 0
+			}
+		}
+		if isASCIIByte(b) {
+			return appendError(buf), 1
+		}
+		return appendError(buf), 0
+	}
+	switch {
+	case isASCIIByte(b) || b == 0x80:
+		return appendRune(buf, rune(b)), 0
+	case b >= 0xa1 && b <= 0xdf:
+		return appendRune(buf, 0xff61-0xa1+rune(b)), 0
+	case b >= 0x81 && b <= 0x9f || b >= 0xe0 && b <= 0xfc:
+		d.lead = b
+		return buf, 0
+	}
+	return appendError(buf), 0
+}
+
+// EUC-KR
+
+type whatwgEUCKR struct {
+	lead  int
+	index []rune
+}
+
+func whatwgEUCKRDecoder() (Translator, error) {
+	index, err := whatwgIndex("euc-kr", 126*190)
+	if err != nil {
+		return nil, err
+	}
+	return newWHATWGTranslator(&whatwgEUCKR{index: index}), nil
+}
+
+func (d *whatwgEUCKR) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		if d.lead == 0 {
+			return buf, whatwgFinished
+		}
+		d.lead = 0
+		return appendError(buf), 0
+	}
+	if lead := d.lead; lead != 0 {
+		d.lead = 0
+		if b >= 0x41 && b <= 0xfe {
+			if cp, ok := indexCodePoint(d.index, (lead-0x81)*190+b-0x41); ok {
+				return appendRune(buf, cp), 0
+			}
+		}
+		if isASCIIByte(b) {
+			return appendError(buf), 1
+		}
+		return appendError(buf), 0
+	}
+	switch {
+	case isASCIIByte(b):
+		return append(buf, byte(b)), 0
+	case b >= 0x81 && b <= 0xfe:
+		d.lead = b
+		return buf, 0
+	}
+	return appendError(buf), 0
+}
+
+// replacement
+
+type whatwgReplacement struct {
+	returned bool
+}
+
+func whatwgReplacementDecoder() (Translator, error) {
+	return newWHATWGTranslator(&whatwgReplacement{}), nil
+}
+
+func (d *whatwgReplacement) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		return buf, whatwgFinished
+	}
+	if !d.returned {
+		d.returned = true
+		return appendError(buf), 0
+	}
+	return buf, 0
+}
+
+// x-user-defined
+
+type whatwgUserDefined struct{}
+
+func whatwgUserDefinedDecoder() (Translator, error) {
+	return newWHATWGTranslator(whatwgUserDefined{}), nil
+}
+
+func (whatwgUserDefined) handle(buf []byte, b int) ([]byte, int) {
+	if b == whatwgEOQ {
+		return buf, whatwgFinished
+	}
+	if isASCIIByte(b) {
+		return append(buf, byte(b)), 0
+	}
+	return appendRune(buf, 0xf780+rune(b)-0x80), 0
+}
@@ -0,0 +1,247 @@
+package charset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// XML encoding detection
+//
+// NewXMLReader finds the character set of an XML document as
+// described in Appendix F of the XML 1.0 specification. A byte
+// order mark gives the encoding; failing that, the first four
+// bytes of the document, which must start "<?xml" if the
+// document is not in UTF-8 or UTF-16, tell the family of the
+// encoding:
+//
+//	00 00 00 3C	UTF-32, big-endian
+//	3C 00 00 00	UTF-32, little-endian
+//	00 3C 00 3F	UTF-16, big-endian
+//	3C 00 3F 00	UTF-16, little-endian
+//	3C 3F 78 6D	ASCII-compatible: the encoding declaration
+//			names the character set (UTF-8 if none)
+//	4C 6F A7 94	EBCDIC: the encoding declaration names
+//			the code page
+//
+// and other documents are in UTF-8.
+
+// xmlFamilies holds the encodings told by the first bytes of
+// an XML document.
+var xmlFamilies = []struct {
+	prefix  string
+	charset string
+}{
+	{"\x00\x00\xfe\xff", "utf-32"},
+	{"\xff\xfe\x00\x00", "utf-32"},
+	{"\xef\xbb\xbf", "utf-8-sig"},
+	{"\xfe\xff", "utf-16"},
+	{"\xff\xfe", "utf-16"},
+	{"\x00\x00\x00<", "utf-32be"},
+	{"<\x00\x00\x00", "utf-32le"},
+	{"\x00<\x00?", "utf-16be"},
+	{"<\x00?\x00", "utf-16le"},
+}
+
+// ebcdicInvariant maps the bytes of the characters that are
+// the same in all EBCDIC code pages, and which are enough to
+// read an encoding declaration, to ASCII.
+var ebcdicInvariant = func() map[byte]byte {
+	m := map[byte]byte{
+		0x05: '\t', 0x0d: '\r', 0x15: '\n', 0x25: '\n', 0x40: ' ',
+		0x4b: '.', 0x4c: '<', 0x60: '-', 0x6d: '_', 0x6e: '>',
+		0x6f: '?', 0x7a: ':', 0x7d: '\'', 0x7e: '=', 0x7f: '"',
+	}
+	for _, r := range []struct {
+		b  byte
+		cs string
+	}{
+		{0x81, "abcdefghi"}, {0x91, "jklmnopqr"}, {0xa2, "stuvwxyz"},
+		{0xc1, "ABCDEFGHI"}, {0xd1, "JKLMNOPQR"}, {0xe2, "STUVWXYZ"},
+		{0xf0, "0123456789"},
+	} {
+		for i := 0; i < len(r.cs); i++ {
+			m[r.b+byte(i)] = r.cs[i]
+		}
+	}
+	return m
+}()
+
+// maxXMLDecl is the length of the longest XML declaration that
+// is read.
+const maxXMLDecl = 512
+
+// XMLCharsetReader returns a Reader that translates input from
+// the named character set to UTF-8. It can be used as the
+// CharsetReader of an xml.Decoder:
+//
+//	d := xml.NewDecoder(r)
+//	d.CharsetReader = charset.XMLCharsetReader
+func XMLCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	return NewReader(charset, input)
+}
+
+// NewXMLReader returns a new Reader that translates the XML
+// document read from r to UTF-8, and the name of the character
+// set that the document is in. The encoding declaration of the
+// translated document, if any, names UTF-8, so that it can be
+// read by an xml.Decoder without a CharsetReader.
+// The options are passed to NewReader.
+func NewXMLReader(r io.Reader, opts ...Option) (io.Reader, string, error) {
+	br := bufio.NewReader(r)
+	data, err := br.Peek(maxXMLDecl)
+	if err != nil && err != io.EOF {
+		return nil, "", err
+	}
+	charset, err := xmlCharset(data)
+	if err != nil {
+		return nil, "", err
+	}
+	rd, err := NewReader(charset, br, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	rd, err = rewriteXMLDecl(rd, "UTF-8")
+	if err != nil {
+		return nil, "", err
+	}
+	return rd, charset, nil
+}
+
+// xmlCharset returns the character set of the XML document
+// that starts with data.
+func xmlCharset(data []byte) (string, error) {
+	for _, f := range xmlFamilies {
+		if bytes.HasPrefix(data, []byte(f.prefix)) {
+			return f.charset, nil
+		}
+	}
+	switch {
+	case bytes.HasPrefix(data, []byte("<?xm")):
+		if enc, ok := xmlDeclEncoding(data); ok {
+			return enc, nil
+		}
+	case bytes.HasPrefix(data, []byte{0x4c, 0x6f, 0xa7, 0x94}):
+		decl := make([]byte, 0, len(data))
+		for _, b := range data {
+			c, ok := ebcdicInvariant[b]
+			if !ok {
+				break
+			}
+			decl = append(decl, c)
+		}
+		if enc, ok := xmlDeclEncoding(decl); ok {
+			return enc, nil
+		}
+		return "", fmt.Errorf("charset: EBCDIC XML document has no encoding declaration")
+	}
+	return "utf-8", nil
+}
+
+// xmlDecl finds the XML declaration at the start of data,
+// and returns the start and end of the value of its encoding
+// pseudo-attribute, or -1, -1 if it has none. It returns false
+// if data does not start with a complete XML declaration.
+func xmlDecl(data []byte) (start, end int, ok bool) {
+	if !bytes.HasPrefix(data, []byte("<?xml")) || len(data) < 6 || !isXMLSpace(data[5]) {
+		return -1, -1, false
+	}
+	declEnd := bytes.Index(data, []byte("?>"))
+	if declEnd < 0 {
+		return -1, -1, false
+	}
+	decl := data[:declEnd]
+	for i := 5; i < len(decl); {
+		for i < len(decl) && isXMLSpace(decl[i]) {
+			i++
+		}
+		nameStart := i
+		for i < len(decl) && decl[i] != '=' && !isXMLSpace(decl[i]) {
+			i++
+		}
+		name := string(decl[nameStart:i])
+		for i < len(decl) && isXMLSpace(decl[i]) {
+			i++
+		}
+		if i >= len(decl) || decl[i] != '=' {
+			break
+		}
+		i++
+		for i < len(decl) && isXMLSpace(decl[i]) {
+			i++
+		}
+		if i >= len(decl) || decl[i] != '"' && decl[i] != '\'' {
+			break
+		}
+		q := decl[i]
+		i++
+		valEnd := bytes.IndexByte(decl[i:], q)
+		if valEnd < 0 {
+			break
+		}
+		if name == "encoding" {
+			return i, i + valEnd, true
+		}
+		i += valEnd + 1
+	}
+	return -1, -1, true
+}
+
+// xmlDeclEncoding returns the encoding named by the XML
+// declaration at the start of data, if there is one.
+func xmlDeclEncoding(data []byte) (string, bool) {
+	start, end, ok := xmlDecl(data)
+	if !ok || start < 0 || start == end {
+		return "", false
+	}
+	return string(data[start:end]), true
+}
+
+func isXMLSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+// rewriteXMLDecl returns a Reader that reads the XML document
+// read from r, with the encoding named by its XML declaration,
+// if any, replaced by enc.
+func rewriteXMLDecl(r io.Reader, enc string) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	data, err := br.Peek(maxXMLDecl)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	start, end, ok := xmlDecl(data)
+	if !ok || start < 0 {
+		return br, nil
+	}
+	decl := make([]byte, 0, end+len(enc))
+	decl = append(decl, data[:start]...)
+	decl = append(decl, enc...)
+	br.Discard(end)
+	return io.MultiReader(bytes.NewReader(decl), br), nil
+}
+
+// NewXMLWriter returns a new WriteCloser that writes an XML
+// declaration naming the given character set to w, and then
+// translates the UTF-8 text written to it into that character
+// set, as NewWriter does. The text should not have an XML
+// declaration of its own.
+func NewXMLWriter(charset string, w io.Writer, opts ...Option) (io.WriteCloser, error) {
+	name, _ := splitTranslit(charset)
+	if info := Info(name); info != nil {
+		name = info.Name
+	}
+	if NormalizedName(name) == NormalizedName("utf-8-sig") {
+		name = "utf-8"
+	}
+	wc, err := NewWriter(charset, w, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(wc, "<?xml version=\"1.0\" encoding=\"%s\"?>\n", strings.ToUpper(name)); err != nil {
+		return nil, err
+	}
+	return wc, nil
+}
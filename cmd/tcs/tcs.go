@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"flag"
 	"fmt"
@@ -16,11 +17,21 @@ var listFlag = flag.Bool("l", false, "list available character sets")
 var verboseFlag = flag.Bool("v", false, "list more information")
 var fromCharset = flag.String("f", "utf-8", "translate from this character set")
 var toCharset = flag.String("t", "utf-8", "translate to this character set")
+var normFlag = flag.String("n", "", "normalize to this form (nfc, nfd, nfkc or nfkd)")
+var widthFlag = flag.Bool("w", false, "fold half-width kana and full-width ASCII when reading")
+var fixFlag = flag.Bool("fix", false, "repair UTF-8 text that was decoded in the wrong character set")
+
+var forms = map[string]charset.Form{
+	"nfc":  charset.NFC,
+	"nfd":  charset.NFD,
+	"nfkc": charset.NFKC,
+	"nfkd": charset.NFKD,
+}
 
 func main() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "usage: tcs [-l] [-v] [charset]\n")
-		fmt.Fprintf(os.Stderr, "\ttcs [-f charset] [-t charset] [file]\n")
+		fmt.Fprintf(os.Stderr, "\ttcs [-f charset] [-t charset] [-n form] [-fix] [file]\n")
 	}
 	flag.Parse()
 	if *listFlag {
@@ -46,7 +57,18 @@ func main() {
 			fatalf("cannot open %q: %v", flag.Arg(0), err)
 		}
 	}
-	r, err := charset.NewReader(*fromCharset, f)
+	var opts []charset.Option
+	if *normFlag != "" {
+		form, ok := forms[strings.ToLower(*normFlag)]
+		if !ok {
+			fatalf("unknown normalization form %q", *normFlag)
+		}
+		opts = append(opts, charset.Normalize(form))
+	}
+	if *widthFlag {
+		opts = append(opts, charset.FoldWidth())
+	}
+	r, err := charset.NewReader(*fromCharset, f, opts...)
 	if err != nil {
 		fatalf("cannot translate from %q: %v", *fromCharset, err)
 	}
@@ -54,12 +76,37 @@ func main() {
 	if err != nil {
 		fatalf("cannot translate to %q: %v", *toCharset, err)
 	}
-	_, err = io.Copy(w, r)
+	if *fixFlag {
+		err = fixMojibake(w, r)
+	} else {
+		_, err = io.Copy(w, r)
+	}
+	if err == nil {
+		err = w.Close()
+	}
 	if err != nil {
 		fatalf("%v", err)
 	}
 }
 
+// fixMojibake copies r to w a line at a time,
+// repairing any mojibake.
+func fixMojibake(w io.Writer, r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if _, werr := io.WriteString(w, charset.FixMojibake(line)); werr != nil {
+			return werr
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func listCharsets(verbose bool, csname string) {
 	var buf bytes.Buffer
 	if !verbose {
@@ -92,6 +139,19 @@ func listCharsets(verbose bool, csname string) {
 			if cs.Desc != "" {
 				fmt.Fprintf(&buf, "\t%s\n", cs.Desc)
 			}
+			var ids []string
+			if cs.MIB != 0 {
+				ids = append(ids, fmt.Sprintf("mime=%s mib=%d", cs.MIMEName, cs.MIB))
+			}
+			if cs.CodePage != 0 {
+				ids = append(ids, fmt.Sprintf("codepage=%d", cs.CodePage))
+			}
+			if cs.CCSID != 0 {
+				ids = append(ids, fmt.Sprintf("ccsid=%d", cs.CCSID))
+			}
+			if len(ids) > 0 {
+				fmt.Fprintf(&buf, "\t%s\n", strings.Join(ids, " "))
+			}
 		}
 	}
 	os.Stdout.Write(buf.Bytes())
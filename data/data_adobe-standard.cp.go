@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("adobe-standard.cp", func() (io.ReadCloser, error) {
+		r := strings.NewReader("�������������������������������� !\"#$%&’()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_‘abcdefghijklmnopqrstuvwxyz{|}~����������������������������������¡¢£⁄¥ƒ§¤'“«‹›ﬁﬂ�–†‡·�¶•‚„”»…‰�¿�`´ˆ˜¯˘˙¨�˚¸�˝˛ˇ—����������������Æ�ª����ŁØŒº�����æ���ı��łøœß����")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("adobe-symbol.cp", func() (io.ReadCloser, error) {
+		r := strings.NewReader("�������������������������������� !∀#∃%&∋()∗+,−./0123456789:;<=>?≅ΑΒΧΔΕΦΓΗΙϑΚΛΜΝΟΠΘΡΣΤΥςΩΞΨΖ[∴]⊥_\uf8e5αβχδεφγηιϕκλμνοπθρστυϖωξψζ{|}∼���������������������������������€ϒ′≤⁄∞ƒ♣♦♥♠↔←↑→↓°±″≥×∝∂•÷≠≡≈…\uf8e6\uf8e7↵ℵℑℜ℘⊗⊕∅∩∪⊃⊇⊄⊂⊆∈∉∠∇\uf6da\uf6d9\uf6db∏√⋅¬∧∨⇔⇐⇑⇒⇓◊〈\uf8e8\uf8e9\uf8ea∑\uf8eb\uf8ec\uf8ed\uf8ee\uf8ef\uf8f0\uf8f1\uf8f2\uf8f3\uf8f4�〉∫⌠\uf8f5⌡\uf8f6\uf8f7\uf8f8\uf8f9\uf8fa\uf8fb\uf8fc\uf8fd\uf8fe�")
+		return ioutil.NopCloser(r), nil
+	})
+}
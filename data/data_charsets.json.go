@@ -12,7 +12,7 @@ import (
 
 func init() {
 	charset.RegisterDataFile("charsets.json", func() (io.ReadCloser, error) {
-		r := strings.NewReader("{\n\"8bit\": {\n\t\"Desc\": \"raw 8-bit data\",\n\t\"Class\": \"8bit\",\n\t\"Comment\": \"special class for raw 8bit data that has been converted to utf-8\"\n},\n\"big5\": {\n\t\"Desc\": \"Big 5 (HKU)\",\n\t\"Class\": \"big5\",\n\t\"Comment\": \"Traditional Chinese\"\n},\n\"euc-jp\": {\n\t\"Aliases\":[\"x-euc-jp\"],\n\t\"Desc\": \"Japanese Extended UNIX Code\",\n\t\"Class\": \"euc-jp\"\n},\n\"gb2312\": {\n\t\"Aliases\":[\"iso-ir-58\", \"chinese\", \"gb_2312-80\"],\n\t\"Desc\": \"Chinese mixed one byte\",\n\t\"Class\": \"gb2312\"\n},\n\"ibm437\": {\n\t\"Aliases\":[\"437\", \"cp437\"],\n\t\"Desc\": \"IBM PC: CP 437\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm437.cp\",\n\t\"Comment\": \"originally from jhelling@cs.ruu.nl (Jeroen Hellingman)\"\n},\n\"ibm850\": {\n\t\"Aliases\":[\"850\", \"cp850\"],\n\t\"Desc\": \"IBM PS/2: CP 850\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm850.cp\",\n\t\"Comment\": \"originally from jhelling@cs.ruu.nl (Jeroen Hellingman)\"\n},\n\"ibm866\": {\n\t\"Aliases\":[\"cp866\", \"866\"],\n\t\"Desc\": \"Russian MS-DOS CP 866\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm866.cp\"\n},\n\"iso-8859-1\": {\n\t\"Aliases\":[\"iso-ir-100\", \"ibm819\", \"l1\", \"iso8859-1\", \"iso-latin-1\", \"iso_8859-1:1987\", \"cp819\", \"iso_8859-1\", \"iso8859_1\", \"latin1\"],\n\t\"Desc\": \"Latin-1\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-1.cp\"\n},\n\"iso-8859-10\": {\n\t\"Aliases\":[\"iso_8859-10:1992\", \"l6\", \"iso-ir-157\", \"latin6\"],\n\t\"Desc\": \"Latin-6\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-10.cp\",\n\t\"Comment\": \"originally from dkuug.dk:i18n/charmaps/ISO_8859-10:1993\"\n},\n\"iso-8859-15\": {\n\t\"Aliases\":[\"l9-iso-8859-15\", \"latin9\"],\n\t\"Desc\": \"Latin-9\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-15.cp\"\n},\n\"iso-8859-2\": {\n\t\"Aliases\":[\"iso-ir-101\", \"iso_8859-2:1987\", \"l2\", \"iso_8859-2\", \"latin2\"],\n\t\"Desc\": \"Latin-2\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-2.cp\"\n},\n\"iso-8859-3\": {\n\t\"Aliases\":[\"iso-ir-109\", \"l3\", \"iso_8859-3:1988\", \"iso_8859-3\", \"latin3\"],\n\t\"Desc\": \"Latin-3\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-3.cp\"\n},\n\"iso-8859-4\": {\n\t\"Aliases\":[\"iso-ir-110\", \"iso_8859-4:1988\", \"l4\", \"iso_8859-4\", \"latin4\"],\n\t\"Desc\": \"Latin-4\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-4.cp\"\n},\n\"iso-8859-5\": {\n\t\"Aliases\":[\"cyrillic\", \"iso_8859-5\", \"iso-ir-144\", \"iso_8859-5:1988\"],\n\t\"Desc\": \"Part 5 (Cyrillic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-5.cp\"\n},\n\"iso-8859-6\": {\n\t\"Aliases\":[\"ecma-114\", \"iso_8859-6:1987\", \"arabic\", \"iso_8859-6\", \"asmo-708\", \"iso-ir-127\"],\n\t\"Desc\": \"Part 6 (Arabic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-6.cp\"\n},\n\"iso-8859-7\": {\n\t\"Aliases\":[\"greek8\", \"elot_928\", \"ecma-118\", \"greek\", \"iso_8859-7\", \"iso_8859-7:1987\", \"iso-ir-126\"],\n\t\"Desc\": \"Part 7 (Greek)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-7.cp\"\n},\n\"iso-8859-8\": {\n\t\"Aliases\":[\"iso_8859-8:1988\", \"hebrew\", \"iso_8859-8\", \"iso-ir-138\"],\n\t\"Desc\": \"Part 8 (Hebrew)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-8.cp\"\n},\n\"iso-8859-9\": {\n\t\"Aliases\":[\"l5\", \"iso_8859-9:1989\", \"iso_8859-9\", \"iso-ir-148\", \"latin5\"],\n\t\"Desc\": \"Latin-5\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-9.cp\"\n},\n\"koi8-r\": {\n\t\"Desc\": \"KOI8-R (RFC1489)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"koi8-r.cp\"\n},\n\"shift_jis\": {\n\t\"Aliases\":[\"sjis\", \"ms_kanji\", \"x-sjis\"],\n\t\"Desc\": \"Shift-JIS Japanese\",\n\t\"Class\": \"cp932\",\n\t\"Arg\": \"shiftjis\"\n},\n\"us-ascii\": {\n\t\"Aliases\":[\"ascii\"],\n\t\"Desc\": \"US-ASCII (RFC20)\",\n\t\"Class\": \"ascii\"\n},\n\"utf-16\": {\n\t\"Aliases\":[\"utf16\"],\n\t\"Desc\": \"Unicode UTF-16\",\n\t\"Class\": \"utf16\"\n},\n\"utf-16be\": {\n\t\"Aliases\":[\"utf16be\"],\n\t\"Desc\": \"Unicode UTF-16 big endian\",\n\t\"Class\": \"utf16\",\n\t\"Arg\": \"be\"\n},\n\"utf-16le\": {\n\t\"Aliases\":[\"utf16le\"],\n\t\"Desc\": \"Unicode UTF-16 little endian\",\n\t\"Class\": \"utf16\",\n\t\"Arg\": \"le\"\n},\n\"utf-8\": {\n\t\"Aliases\":[\"utf8\"],\n\t\"Desc\": \"Unicode UTF-8\",\n\t\"Class\": \"utf8\"\n},\n\"windows-1250\": {\n\t\"Desc\": \"MS Windows CP 1250 (Central Europe)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1250.cp\"\n},\n\"windows-1251\": {\n\t\"Desc\": \"MS Windows CP 1251 (Cyrillic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1251.cp\"\n},\n\"windows-1252\": {\n\t\"Desc\": \"MS Windows CP 1252 (Latin 1)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1252.cp\"\n},\n\"windows-31j\": {\n\t\"Aliases\":[\"cp932\"],\n\t\"Desc\": \"MS-Windows Japanese (cp932)\",\n\t\"Class\": \"cp932\",\n\t\"Arg\": \"cp932\"\n}\n}\n")
+		r := strings.NewReader("{\n\"8bit\": {\n\t\"Desc\": \"raw 8-bit data\",\n\t\"Class\": \"8bit\",\n\t\"Comment\": \"special class for raw 8bit data that has been converted to utf-8\"\n},\n\"adobe-standard-encoding\": {\n\t\"Aliases\":[\"standardencoding\", \"adobe-standard\", \"csadobestandardencoding\"],\n\t\"Desc\": \"Adobe StandardEncoding (PostScript and PDF font encoding)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"adobe-standard.cp\"\n},\n\"adobe-symbol-encoding\": {\n\t\"Aliases\":[\"symbol\", \"adobe-symbol\"],\n\t\"Desc\": \"Adobe Symbol font encoding\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"adobe-symbol.cp\"\n},\n\"armscii-8\": {\n\t\"Aliases\":[\"armscii8\"],\n\t\"Desc\": \"ARMSCII-8 (Armenian)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"armscii-8.cp\"\n},\n\"big5\": {\n\t\"Desc\": \"Big 5 (HKU)\",\n\t\"Class\": \"big5\",\n\t\"Comment\": \"Traditional Chinese\"\n},\n\"big5-hkscs\": {\n\t\"Aliases\":[\"big5hkscs\", \"big5-hkscs:2008\"],\n\t\"Desc\": \"Big5-HKSCS:2008 (Hong Kong)\",\n\t\"Class\": \"big5\",\n\t\"Arg\": \"hkscs\",\n\t\"Comment\": \"Traditional Chinese\"\n},\n\"c-escape\": {\n\t\"Aliases\":[\"x-c-escape\"],\n\t\"Desc\": \"UTF-8 with C string escapes\",\n\t\"Class\": \"escape\",\n\t\"Arg\": \"c\"\n},\n\"cp1125\": {\n\t\"Aliases\":[\"1125\", \"ibm1125\", \"ruscii\"],\n\t\"Desc\": \"Ukrainian MS-DOS CP 1125\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"cp1125.cp\"\n},\n\"cp950\": {\n\t\"Aliases\":[\"windows-950\", \"ms950\", \"x-windows-950\"],\n\t\"Desc\": \"MS-Windows Traditional Chinese (cp950)\",\n\t\"Class\": \"big5\",\n\t\"Arg\": \"cp950\",\n\t\"Comment\": \"Traditional Chinese\"\n},\n\"euc-jis-2004\": {\n\t\"Aliases\":[\"euc-jisx0213\"],\n\t\"Desc\": \"EUC-JIS-2004 (JIS X 0213)\",\n\t\"Class\": \"jisx0213\",\n\t\"Arg\": \"euc\"\n},\n\"euc-jp\": {\n\t\"Aliases\":[\"x-euc-jp\"],\n\t\"Desc\": \"Japanese Extended UNIX Code\",\n\t\"Class\": \"euc-jp\"\n},\n\"gb2312\": {\n\t\"Aliases\":[\"iso-ir-58\", \"chinese\", \"gb_2312-80\"],\n\t\"Desc\": \"Chinese mixed one byte\",\n\t\"Class\": \"gb2312\"\n},\n\"georgian-academy\": {\n\t\"Desc\": \"Georgian Academy\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"georgian-academy.cp\"\n},\n\"georgian-ps\": {\n\t\"Desc\": \"Georgian PS (Parliament)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"georgian-ps.cp\"\n},\n\"gsm0338\": {\n\t\"Aliases\":[\"gsm\", \"gsm-7bit\", \"gsm03.38\"],\n\t\"Desc\": \"GSM 03.38 7-bit default alphabet (unpacked)\",\n\t\"Class\": \"gsm0338\"\n},\n\"gsm0338-es\": {\n\t\"Desc\": \"GSM 03.38 with Spanish single shift table (unpacked)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"shift=es\"\n},\n\"gsm0338-es-packed\": {\n\t\"Desc\": \"GSM 03.38 with Spanish single shift table (packed septets)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"packed,shift=es\"\n},\n\"gsm0338-packed\": {\n\t\"Aliases\":[\"gsm-7bit-packed\"],\n\t\"Desc\": \"GSM 03.38 7-bit default alphabet (packed septets)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"packed\"\n},\n\"gsm0338-pt\": {\n\t\"Desc\": \"GSM 03.38 with Portuguese locking and single shift tables (unpacked)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"lock=pt,shift=pt\"\n},\n\"gsm0338-pt-packed\": {\n\t\"Desc\": \"GSM 03.38 with Portuguese locking and single shift tables (packed septets)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"packed,lock=pt,shift=pt\"\n},\n\"gsm0338-tr\": {\n\t\"Desc\": \"GSM 03.38 with Turkish locking and single shift tables (unpacked)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"lock=tr,shift=tr\"\n},\n\"gsm0338-tr-packed\": {\n\t\"Desc\": \"GSM 03.38 with Turkish locking and single shift tables (packed septets)\",\n\t\"Class\": \"gsm0338\",\n\t\"Arg\": \"packed,lock=tr,shift=tr\"\n},\n\"html-entities\": {\n\t\"Aliases\":[\"html-escape\", \"x-html-entities\"],\n\t\"Desc\": \"UTF-8 with HTML character references\",\n\t\"Class\": \"escape\",\n\t\"Arg\": \"html\"\n},\n\"ibm437\": {\n\t\"Aliases\":[\"437\", \"cp437\"],\n\t\"Desc\": \"IBM PC: CP 437\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm437.cp\",\n\t\"Comment\": \"originally from jhelling@cs.ruu.nl (Jeroen Hellingman)\"\n},\n\"ibm850\": {\n\t\"Aliases\":[\"850\", \"cp850\"],\n\t\"Desc\": \"IBM PS/2: CP 850\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm850.cp\",\n\t\"Comment\": \"originally from jhelling@cs.ruu.nl (Jeroen Hellingman)\"\n},\n\"ibm866\": {\n\t\"Aliases\":[\"cp866\", \"866\"],\n\t\"Desc\": \"Russian MS-DOS CP 866\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"ibm866.cp\"\n},\n\"idna\": {\n\t\"Aliases\":[\"x-idna\"],\n\t\"Desc\": \"Internationalized domain names: Punycode xn-- labels in dotted names\",\n\t\"Class\": \"punycode\",\n\t\"Arg\": \"idna\"\n},\n\"iscii-asm\": {\n\t\"Aliases\":[\"x-iscii-as\"],\n\t\"Desc\": \"ISCII-91 (Assamese)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"asm\"\n},\n\"iscii-bng\": {\n\t\"Aliases\":[\"x-iscii-be\"],\n\t\"Desc\": \"ISCII-91 (Bengali)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"bng\"\n},\n\"iscii-dev\": {\n\t\"Aliases\":[\"iscii\", \"iscii-91\", \"iscii91\", \"x-iscii-de\"],\n\t\"Desc\": \"ISCII-91 (Devanagari)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"dev\"\n},\n\"iscii-gjr\": {\n\t\"Aliases\":[\"x-iscii-gu\"],\n\t\"Desc\": \"ISCII-91 (Gujarati)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"gjr\"\n},\n\"iscii-knd\": {\n\t\"Aliases\":[\"x-iscii-ka\"],\n\t\"Desc\": \"ISCII-91 (Kannada)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"knd\"\n},\n\"iscii-mlm\": {\n\t\"Aliases\":[\"x-iscii-ma\"],\n\t\"Desc\": \"ISCII-91 (Malayalam)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"mlm\"\n},\n\"iscii-ori\": {\n\t\"Aliases\":[\"x-iscii-or\"],\n\t\"Desc\": \"ISCII-91 (Oriya)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"ori\"\n},\n\"iscii-pnj\": {\n\t\"Aliases\":[\"x-iscii-pa\"],\n\t\"Desc\": \"ISCII-91 (Gurmukhi)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"pnj\"\n},\n\"iscii-tlg\": {\n\t\"Aliases\":[\"x-iscii-te\"],\n\t\"Desc\": \"ISCII-91 (Telugu)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"tlg\"\n},\n\"iscii-tml\": {\n\t\"Aliases\":[\"x-iscii-ta\"],\n\t\"Desc\": \"ISCII-91 (Tamil)\",\n\t\"Class\": \"iscii\",\n\t\"Arg\": \"tml\"\n},\n\"iso-6937\": {\n\t\"Aliases\":[\"iso6937\", \"iso_6937\", \"iso_6937:2001\"],\n\t\"Desc\": \"ISO/IEC 6937 (Latin with non-spacing diacritics)\",\n\t\"Class\": \"iso6937\"\n},\n\"iso-8859-1\": {\n\t\"Aliases\":[\"iso-ir-100\", \"ibm819\", \"l1\", \"iso8859-1\", \"iso-latin-1\", \"iso_8859-1:1987\", \"cp819\", \"iso_8859-1\", \"iso8859_1\", \"latin1\"],\n\t\"Desc\": \"Latin-1\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-1.cp\"\n},\n\"iso-8859-10\": {\n\t\"Aliases\":[\"iso_8859-10:1992\", \"l6\", \"iso-ir-157\", \"latin6\"],\n\t\"Desc\": \"Latin-6\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-10.cp\",\n\t\"Comment\": \"originally from dkuug.dk:i18n/charmaps/ISO_8859-10:1993\"\n},\n\"iso-8859-15\": {\n\t\"Aliases\":[\"l9-iso-8859-15\", \"latin9\"],\n\t\"Desc\": \"Latin-9\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-15.cp\"\n},\n\"iso-8859-2\": {\n\t\"Aliases\":[\"iso-ir-101\", \"iso_8859-2:1987\", \"l2\", \"iso_8859-2\", \"latin2\"],\n\t\"Desc\": \"Latin-2\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-2.cp\"\n},\n\"iso-8859-3\": {\n\t\"Aliases\":[\"iso-ir-109\", \"l3\", \"iso_8859-3:1988\", \"iso_8859-3\", \"latin3\"],\n\t\"Desc\": \"Latin-3\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-3.cp\"\n},\n\"iso-8859-4\": {\n\t\"Aliases\":[\"iso-ir-110\", \"iso_8859-4:1988\", \"l4\", \"iso_8859-4\", \"latin4\"],\n\t\"Desc\": \"Latin-4\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-4.cp\"\n},\n\"iso-8859-5\": {\n\t\"Aliases\":[\"cyrillic\", \"iso_8859-5\", \"iso-ir-144\", \"iso_8859-5:1988\"],\n\t\"Desc\": \"Part 5 (Cyrillic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-5.cp\"\n},\n\"iso-8859-6\": {\n\t\"Aliases\":[\"ecma-114\", \"iso_8859-6:1987\", \"arabic\", \"iso_8859-6\", \"asmo-708\", \"iso-ir-127\"],\n\t\"Desc\": \"Part 6 (Arabic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-6.cp\"\n},\n\"iso-8859-7\": {\n\t\"Aliases\":[\"greek8\", \"elot_928\", \"ecma-118\", \"greek\", \"iso_8859-7\", \"iso_8859-7:1987\", \"iso-ir-126\"],\n\t\"Desc\": \"Part 7 (Greek)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-7.cp\"\n},\n\"iso-8859-8\": {\n\t\"Aliases\":[\"iso_8859-8:1988\", \"hebrew\", \"iso_8859-8\", \"iso-ir-138\", \"csisolatinhebrew\", \"visual\"],\n\t\"Desc\": \"Part 8 (Hebrew, visual order)\",\n\t\"Class\": \"visual\",\n\t\"Arg\": \"iso-8859-8.cp\",\n\t\"Comment\": \"lines are reordered between visual and logical order\"\n},\n\"iso-8859-8-i\": {\n\t\"Aliases\":[\"iso_8859-8-i\", \"csiso88598i\", \"logical\"],\n\t\"Desc\": \"Part 8 (Hebrew, logical order)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-8.cp\"\n},\n\"iso-8859-9\": {\n\t\"Aliases\":[\"l5\", \"iso_8859-9:1989\", \"iso_8859-9\", \"iso-ir-148\", \"latin5\"],\n\t\"Desc\": \"Latin-5\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"iso-8859-9.cp\"\n},\n\"java-escape\": {\n\t\"Aliases\":[\"x-java-escape\", \"native2ascii\", \"java-properties\"],\n\t\"Desc\": \"ISO 8859-1 with Java \\\\uXXXX escapes\",\n\t\"Class\": \"escape\",\n\t\"Arg\": \"java\"\n},\n\"jis_c6220-1969-jp\": {\n\t\"Aliases\":[\"jis_c6220-1969\", \"iso-ir-13\", \"katakana\", \"x0201-7\", \"jis_x0201-katakana\", \"csiso13jisc6220jp\"],\n\t\"Desc\": \"JIS X 0201 katakana (7-bit)\",\n\t\"Class\": \"jisx0201\",\n\t\"Arg\": \"kana\"\n},\n\"jis_c6220-1969-ro\": {\n\t\"Aliases\":[\"iso-ir-14\", \"jp\", \"iso646-jp\", \"jis_x0201-roman\", \"csiso14jisc6220ro\"],\n\t\"Desc\": \"JIS X 0201 Roman (7-bit)\",\n\t\"Class\": \"jisx0201\",\n\t\"Arg\": \"roman\"\n},\n\"jis_x0201\": {\n\t\"Aliases\":[\"x0201\", \"cshalfwidthkatakana\"],\n\t\"Desc\": \"JIS X 0201 Roman and half-width katakana (8-bit)\",\n\t\"Class\": \"jisx0201\"\n},\n\"koi8-r\": {\n\t\"Desc\": \"KOI8-R (RFC1489)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"koi8-r.cp\"\n},\n\"kz-1048\": {\n\t\"Aliases\":[\"kz1048\", \"rk1048\", \"strk1048-2002\", \"cskz1048\"],\n\t\"Desc\": \"KZ-1048 (Kazakh)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"kz-1048.cp\"\n},\n\"macexpertencoding\": {\n\t\"Aliases\":[\"macexpert\"],\n\t\"Desc\": \"MacExpertEncoding (PDF expert font encoding)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"macexpert.cp\"\n},\n\"marc-8\": {\n\t\"Aliases\":[\"marc8\", \"ansel\", \"z39.47\"],\n\t\"Desc\": \"MARC-8 (MARC 21 library records, ANSEL)\",\n\t\"Class\": \"marc8\"\n},\n\"pdfdocencoding\": {\n\t\"Aliases\":[\"pdfdoc\", \"pdf-doc-encoding\"],\n\t\"Desc\": \"PDFDocEncoding (PDF text strings)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"pdfdoc.cp\"\n},\n\"percent\": {\n\t\"Aliases\":[\"percent-encoding\", \"url-encoding\", \"x-percent\"],\n\t\"Desc\": \"UTF-8 with URL percent-encoding (RFC 3986)\",\n\t\"Class\": \"escape\",\n\t\"Arg\": \"percent\"\n},\n\"ptcp154\": {\n\t\"Aliases\":[\"pt154\", \"cp154\", \"csptcp154\", \"cyrillic-asian\"],\n\t\"Desc\": \"PTCP154 (Cyrillic Asian)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"pt154.cp\"\n},\n\"punycode\": {\n\t\"Aliases\":[\"x-punycode\", \"rfc3492\"],\n\t\"Desc\": \"Punycode (RFC 3492), each word separately\",\n\t\"Class\": \"punycode\"\n},\n\"shift_jis\": {\n\t\"Aliases\":[\"sjis\", \"ms_kanji\", \"x-sjis\"],\n\t\"Desc\": \"Shift-JIS Japanese\",\n\t\"Class\": \"cp932\",\n\t\"Arg\": \"shiftjis\"\n},\n\"shift_jis-2004\": {\n\t\"Aliases\":[\"shift_jisx0213\", \"sjis-2004\"],\n\t\"Desc\": \"Shift_JIS-2004 (JIS X 0213)\",\n\t\"Class\": \"jisx0213\",\n\t\"Arg\": \"sjis\"\n},\n\"t.61-8bit\": {\n\t\"Aliases\":[\"t.61\", \"t61\", \"teletex\"],\n\t\"Desc\": \"ITU-T T.61 (Teletex)\",\n\t\"Class\": \"iso6937\",\n\t\"Arg\": \"t61\"\n},\n\"tscii\": {\n\t\"Aliases\":[\"tscii-1.7\"],\n\t\"Desc\": \"TSCII 1.7 (Tamil)\",\n\t\"Class\": \"tscii\"\n},\n\"us-ascii\": {\n\t\"Aliases\":[\"ascii\"],\n\t\"Desc\": \"US-ASCII (RFC20)\",\n\t\"Class\": \"ascii\"\n},\n\"utf-16\": {\n\t\"Aliases\":[\"utf16\"],\n\t\"Desc\": \"Unicode UTF-16\",\n\t\"Class\": \"utf16\"\n},\n\"utf-16be\": {\n\t\"Aliases\":[\"utf16be\"],\n\t\"Desc\": \"Unicode UTF-16 big endian\",\n\t\"Class\": \"utf16\",\n\t\"Arg\": \"be\"\n},\n\"utf-16le\": {\n\t\"Aliases\":[\"utf16le\"],\n\t\"Desc\": \"Unicode UTF-16 little endian\",\n\t\"Class\": \"utf16\",\n\t\"Arg\": \"le\"\n},\n\"utf-32\": {\n\t\"Aliases\":[\"utf32\"],\n\t\"Desc\": \"Unicode UTF-32\",\n\t\"Class\": \"utf32\"\n},\n\"utf-32be\": {\n\t\"Aliases\":[\"utf32be\"],\n\t\"Desc\": \"Unicode UTF-32 big endian\",\n\t\"Class\": \"utf32\",\n\t\"Arg\": \"be\"\n},\n\"utf-32le\": {\n\t\"Aliases\":[\"utf32le\"],\n\t\"Desc\": \"Unicode UTF-32 little endian\",\n\t\"Class\": \"utf32\",\n\t\"Arg\": \"le\"\n},\n\"utf-8\": {\n\t\"Aliases\":[\"utf8\"],\n\t\"Desc\": \"Unicode UTF-8\",\n\t\"Class\": \"utf8\"\n},\n\"utf-8-sig\": {\n\t\"Aliases\":[\"utf8-sig\"],\n\t\"Desc\": \"Unicode UTF-8 with byte order mark\",\n\t\"Class\": \"utf8\",\n\t\"Arg\": \"sig\"\n},\n\"winansiencoding\": {\n\t\"Aliases\":[\"winansi\"],\n\t\"Desc\": \"WinAnsiEncoding (PDF font encoding)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1252.cp\"\n},\n\"windows-1250\": {\n\t\"Desc\": \"MS Windows CP 1250 (Central Europe)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1250.cp\"\n},\n\"windows-1251\": {\n\t\"Desc\": \"MS Windows CP 1251 (Cyrillic)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1251.cp\"\n},\n\"windows-1252\": {\n\t\"Desc\": \"MS Windows CP 1252 (Latin 1)\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"windows-1252.cp\"\n},\n\"windows-31j\": {\n\t\"Aliases\":[\"cp932\"],\n\t\"Desc\": \"MS-Windows Japanese (cp932)\",\n\t\"Class\": \"cp932\",\n\t\"Arg\": \"cp932\"\n},\n\"zapfdingbats\": {\n\t\"Aliases\":[\"zapf-dingbats\", \"adobe-zapf-dingbats\", \"dingbats\"],\n\t\"Desc\": \"ITC Zapf Dingbats font encoding\",\n\t\"Class\": \"cp\",\n\t\"Arg\": \"zapfdingbats.cp\"\n}\n}\n")
 		return ioutil.NopCloser(r), nil
 	})
 }
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("codepages.txt", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# The Windows code pages and IBM coded character set\n# identifiers (CCSIDs) of character sets, maintained by hand.\n# The code pages are those of Microsoft's list of code page\n# identifiers (https://learn.microsoft.com/en-us/windows/win32/intl/code-page-identifiers),\n# and the CCSIDs those of IBM's Character Data Representation\n# Architecture (CDRA) CCSID registry; \"-\" marks a character set\n# that a list does not hold.\n# name<TAB>code page, or \"-\"<TAB>CCSID, or \"-\"\nus-ascii\t20127\t367\niso-8859-1\t28591\t819\niso-8859-2\t28592\t912\niso-8859-3\t28593\t913\niso-8859-4\t28594\t914\niso-8859-5\t28595\t915\niso-8859-6\t28596\t1089\niso-8859-7\t28597\t813\niso-8859-8\t28598\t916\niso-8859-8-i\t38598\t-\niso-8859-9\t28599\t920\niso-8859-10\t-\t919\niso-8859-13\t28603\t921\niso-8859-14\t28604\t-\niso-8859-15\t28605\t923\nwindows-874\t874\t-\nwindows-1250\t1250\t1250\nwindows-1251\t1251\t1251\nwindows-1252\t1252\t1252\nwindows-1253\t1253\t1253\nwindows-1254\t1254\t1254\nwindows-1255\t1255\t1255\nwindows-1256\t1256\t1256\nwindows-1257\t1257\t1257\nwindows-1258\t1258\t1258\nkoi8-r\t20866\t878\nkoi8-u\t21866\t1168\nibm437\t437\t437\nibm775\t775\t775\nibm850\t850\t850\nibm852\t852\t852\nibm855\t855\t855\nibm857\t857\t857\nibm00858\t858\t858\nibm860\t860\t860\nibm861\t861\t861\nibm862\t862\t862\nibm863\t863\t863\nibm864\t864\t864\nibm865\t865\t865\nibm866\t866\t866\nibm869\t869\t869\ncp1125\t-\t1125\nibm037\t37\t37\nibm273\t20273\t273\nibm277\t20277\t277\nibm278\t20278\t278\nibm280\t20280\t280\nibm284\t20284\t284\nibm285\t20285\t285\nibm290\t20290\t290\nibm297\t20297\t297\nibm420\t20420\t420\nibm423\t20423\t423\nibm424\t20424\t424\nibm500\t500\t500\nibm870\t870\t870\nibm871\t20871\t871\nibm880\t20880\t880\nibm905\t20905\t905\nibm00924\t20924\t924\nibm1026\t1026\t1026\nibm1047\t1047\t1047\nibm01140\t1140\t1140\nibm01141\t1141\t1141\nibm01142\t1142\t1142\nibm01143\t1143\t1143\nibm01144\t1144\t1144\nibm01145\t1145\t1145\nibm01146\t1146\t1146\nibm01147\t1147\t1147\nibm01148\t1148\t1148\nibm01149\t1149\t1149\nmacintosh\t10000\t1275\nx-mac-cyrillic\t10007\t-\nwindows-31j\t932\t943\neuc-jp\t51932\t954\niso-2022-jp\t50220\t-\neuc-kr\t51949\t970\ncp949\t949\t1363\niso-2022-kr\t50225\t-\ngb2312\t20936\t1383\ngbk\t936\t1386\ngb18030\t54936\t1392\nhz-gb-2312\t52936\t-\ncp950\t950\t1370\nbig5\t-\t950\nt.61-8bit\t20261\t-\niso-6937\t20269\t-\niscii-dev\t57002\t-\niscii-bng\t57003\t-\niscii-tml\t57004\t-\niscii-tlg\t57005\t-\niscii-asm\t57006\t-\niscii-ori\t57007\t-\niscii-knd\t57008\t-\niscii-mlm\t57009\t-\niscii-gjr\t57010\t-\niscii-pnj\t57011\t-\nutf-7\t65000\t-\nutf-8\t65001\t1208\nutf-16le\t1200\t1202\nutf-16be\t1201\t1200\nutf-16\t-\t1204\nutf-32le\t12000\t1234\nutf-32be\t12001\t1232\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
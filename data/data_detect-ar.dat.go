@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-ar.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Arabic,\n# in per cent of all letters.\nا\t12.5\nل\t11.0\nي\t7.5\nم\t6.2\nو\t5.9\nن\t5.6\nر\t4.4\nه\t4.2\nت\t4.0\nب\t3.6\nع\t3.1\nة\t2.8\nد\t2.5\nف\t2.5\nأ\t2.5\nق\t2.3\nس\t2.3\nك\t2.0\nح\t2.0\nج\t1.3\nى\t1.2\nإ\t1.1\nش\t0.9\nص\t0.9\nخ\t0.8\nط\t0.8\nذ\t0.6\nض\t0.5\nز\t0.5\nث\t0.5\nئ\t0.4\nغ\t0.4\nء\t0.3\nظ\t0.2\nآ\t0.2\nؤ\t0.2\n،\t0.5\n؛\t0.02\n؟\t0.05\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-bg.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Bulgarian,\n# in per cent of all letters.\nа\t9.40\nо\t8.60\nе\t8.30\nи\t7.60\nн\t6.90\nт\t6.90\nр\t4.90\nс\t4.60\nв\t4.20\nк\t3.30\nл\t3.20\nд\t3.10\nп\t2.90\nм\t2.60\nъ\t2.40\nя\t2.10\nз\t1.70\nг\t1.40\nу\t1.30\nб\t1.30\nч\t1.20\nж\t0.70\nх\t0.70\nц\t0.50\nщ\t0.50\nш\t0.50\nй\t0.30\nю\t0.20\nф\t0.20\nь\t0.01\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
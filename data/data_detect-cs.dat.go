@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-cs.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Czech, in per cent of all letters.\na\t8.421\nb\t0.822\nc\t0.740\nd\t3.475\ne\t7.562\nf\t0.084\ng\t0.092\nh\t1.356\ni\t6.073\nj\t1.433\nk\t2.894\nl\t3.802\nm\t2.446\nn\t6.468\no\t6.695\np\t1.906\nq\t0.001\nr\t4.799\ns\t5.212\nt\t5.727\nu\t2.160\nv\t5.344\nw\t0.016\nx\t0.027\ny\t1.043\nz\t1.503\ná\t0.867\nč\t0.462\nď\t0.015\né\t0.633\ně\t1.222\ní\t1.643\nň\t0.007\nó\t0.024\nř\t0.380\nš\t0.688\nť\t0.006\nú\t0.045\nů\t0.204\ný\t0.995\nž\t0.721\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-da.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Danish, in per cent of all letters.\na\t6.025\nb\t2.000\nc\t0.565\nd\t5.858\ne\t15.453\nf\t2.406\ng\t4.077\nh\t1.621\ni\t6.000\nj\t0.730\nk\t3.395\nl\t5.229\nm\t3.237\nn\t7.240\no\t4.636\np\t1.756\nq\t0.007\nr\t8.956\ns\t5.805\nt\t6.862\nu\t1.979\nv\t2.332\nw\t0.069\nx\t0.028\ny\t0.698\nz\t0.034\næ\t0.872\nø\t0.939\nå\t1.190\né\t0.030\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
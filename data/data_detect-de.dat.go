@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-de.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of German, in per cent of all letters.\na\t6.516\nb\t1.886\nc\t2.732\nd\t5.076\ne\t16.396\nf\t1.656\ng\t3.009\nh\t4.577\ni\t6.550\nj\t0.268\nk\t1.417\nl\t3.437\nm\t2.534\nn\t9.776\no\t2.594\np\t0.670\nq\t0.018\nr\t7.003\ns\t7.270\nt\t6.154\nu\t4.166\nv\t0.846\nw\t1.921\nx\t0.034\ny\t0.039\nz\t1.134\nä\t0.578\nö\t0.443\nü\t0.995\nß\t0.307\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-el.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Greek,\n# in per cent of all letters.\nα\t10.0\nο\t8.2\nι\t7.0\nε\t6.8\nτ\t7.8\nν\t6.6\nσ\t4.0\nς\t2.5\nη\t4.0\nυ\t3.6\nρ\t4.5\nπ\t4.2\nκ\t4.1\nμ\t3.3\nλ\t2.8\nω\t1.5\nγ\t1.8\nδ\t1.6\nθ\t1.2\nχ\t1.1\nφ\t0.8\nβ\t0.7\nξ\t0.3\nζ\t0.4\nψ\t0.2\nά\t1.9\nέ\t1.4\nί\t1.9\nό\t1.7\nύ\t0.9\nή\t1.1\nώ\t0.6\nϊ\t0.05\nϋ\t0.02\nΐ\t0.01\nΰ\t0.01\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-en.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of English, in per cent of all letters.\na\t8.167\nb\t1.492\nc\t2.782\nd\t4.253\ne\t12.702\nf\t2.228\ng\t2.015\nh\t6.094\ni\t6.966\nj\t0.153\nk\t0.772\nl\t4.025\nm\t2.406\nn\t6.749\no\t7.507\np\t1.929\nq\t0.095\nr\t5.987\ns\t6.327\nt\t9.056\nu\t2.758\nv\t0.978\nw\t2.360\nx\t0.150\ny\t1.974\nz\t0.074\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-es.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Spanish, in per cent of all letters.\na\t11.525\nb\t2.215\nc\t4.019\nd\t5.010\ne\t12.181\nf\t0.692\ng\t1.768\nh\t0.703\ni\t6.247\nj\t0.493\nk\t0.011\nl\t4.967\nm\t3.157\nn\t6.712\no\t8.683\np\t2.510\nq\t0.877\nr\t6.871\ns\t7.977\nt\t4.632\nu\t2.927\nv\t1.138\nw\t0.017\nx\t0.215\ny\t1.008\nz\t0.467\ná\t0.502\né\t0.433\ní\t0.725\nñ\t0.311\nó\t0.827\nú\t0.168\nü\t0.012\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-fi.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Finnish, in per cent of all letters.\na\t12.217\nb\t0.281\nc\t0.281\nd\t1.043\ne\t7.968\nf\t0.194\ng\t0.392\nh\t1.851\ni\t10.817\nj\t2.042\nk\t4.973\nl\t5.761\nm\t3.202\nn\t8.826\no\t5.614\np\t1.842\nq\t0.013\nr\t2.872\ns\t7.862\nt\t8.750\nu\t5.008\nv\t2.250\nw\t0.094\nx\t0.031\ny\t1.745\nz\t0.051\nä\t3.577\nö\t0.444\nå\t0.003\nš\t0.001\nž\t0.001\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-fr.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of French, in per cent of all letters.\na\t7.636\nb\t0.901\nc\t3.260\nd\t3.669\ne\t14.715\nf\t1.066\ng\t0.866\nh\t0.737\ni\t7.529\nj\t0.613\nk\t0.074\nl\t5.456\nm\t2.968\nn\t7.095\no\t5.796\np\t2.521\nq\t1.362\nr\t6.693\ns\t7.948\nt\t7.244\nu\t6.311\nv\t1.838\nw\t0.049\nx\t0.427\ny\t0.128\nz\t0.326\nà\t0.486\nâ\t0.051\nç\t0.085\nè\t0.271\né\t1.504\nê\t0.218\në\t0.008\nî\t0.045\nï\t0.005\nô\t0.023\nù\t0.058\nû\t0.060\nœ\t0.018\nÿ\t0.001\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-he.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Hebrew,\n# in per cent of all letters.\nי\t11.0\nו\t10.0\nה\t8.7\nל\t7.1\nא\t6.3\nר\t5.6\nמ\t5.5\nת\t5.3\nב\t4.7\nש\t4.3\nנ\t3.9\nע\t3.3\nם\t2.9\nד\t2.7\nכ\t2.5\nח\t2.3\nק\t2.1\nפ\t1.7\nן\t1.3\nס\t1.2\nט\t1.2\nצ\t1.1\nג\t1.0\nז\t0.8\nך\t0.5\nף\t0.3\nץ\t0.2\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-hr.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Croatian, in per cent of all letters.\na\t11.5\nb\t1.5\nc\t1.0\nd\t3.4\ne\t8.5\nf\t0.3\ng\t1.6\nh\t0.8\ni\t9.8\nj\t5.1\nk\t3.5\nl\t3.0\nm\t3.2\nn\t6.0\no\t9.0\np\t2.9\nq\t0.01\nr\t5.0\ns\t4.8\nt\t4.4\nu\t4.2\nv\t3.5\nw\t0.01\nx\t0.01\ny\t0.01\nz\t1.6\nč\t1.100\nć\t1.000\nđ\t0.400\nš\t1.000\nž\t0.700\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-hu.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Hungarian, in per cent of all letters.\na\t9.0\nb\t2.0\nc\t0.9\nd\t1.8\ne\t9.8\nf\t0.9\ng\t3.1\nh\t1.3\ni\t4.0\nj\t1.1\nk\t4.6\nl\t6.0\nm\t3.4\nn\t5.4\no\t4.2\np\t1.0\nq\t0.01\nr\t4.6\ns\t5.9\nt\t7.3\nu\t1.0\nv\t2.0\nw\t0.01\nx\t0.01\ny\t2.1\nz\t4.2\ná\t3.600\né\t4.000\ní\t0.600\nó\t1.000\nö\t1.000\nő\t0.900\nú\t0.300\nü\t0.600\nű\t0.400\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
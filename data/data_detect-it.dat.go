@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-it.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Italian, in per cent of all letters.\na\t11.745\nb\t0.927\nc\t4.501\nd\t3.736\ne\t11.792\nf\t1.153\ng\t1.644\nh\t0.636\ni\t10.143\nj\t0.011\nk\t0.009\nl\t6.510\nm\t2.512\nn\t6.883\no\t9.832\np\t3.056\nq\t0.505\nr\t6.367\ns\t4.981\nt\t5.623\nu\t3.011\nv\t2.097\nw\t0.033\nx\t0.003\ny\t0.020\nz\t1.181\nà\t0.635\nè\t0.263\né\t0.030\nì\t0.030\nò\t0.002\nó\t0.001\nù\t0.166\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
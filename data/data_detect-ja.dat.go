@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-ja.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the characters of Japanese, in per cent of\n# all characters. The kanji frequencies are estimated from\n# their rank in newspaper text.\n*\t0.002\nの\t3.20\nい\t3.00\nに\t2.60\nて\t2.40\nう\t2.20\nし\t2.20\nた\t2.20\nか\t2.00\nる\t2.00\nな\t2.00\nと\t2.00\nは\t1.80\nで\t1.60\nが\t1.50\nす\t1.50\nま\t1.30\nを\t1.20\nっ\t1.20\nん\t1.20\nこ\t1.00\nれ\t1.00\nら\t0.90\nも\t0.90\nく\t0.80\nさ\t0.70\nき\t0.70\nり\t0.70\nだ\t0.60\nあ\t0.60\nそ\t0.50\nお\t0.50\nけ\t0.40\nよ\t0.40\nつ\t0.40\nち\t0.40\nど\t0.30\nょ\t0.30\nせ\t0.30\nろ\t0.30\nわ\t0.30\nえ\t0.30\nめ\t0.30\nみ\t0.30\nや\t0.30\nじ\t0.30\nね\t0.20\nば\t0.20\nほ\t0.20\nご\t0.20\nゃ\t0.20\nひ\t0.20\nむ\t0.20\nぶ\t0.10\nげ\t0.10\nゅ\t0.10\nび\t0.10\nぐ\t0.10\nず\t0.10\nふ\t0.10\nへ\t0.10\nぎ\t0.10\nゆ\t0.10\nぜ\t0.05\nぞ\t0.05\nぼ\t0.05\nぬ\t0.05\nべ\t0.05\nづ\t0.02\nぱ\t0.02\nぴ\t0.02\nぷ\t0.02\nぺ\t0.02\nぽ\t0.02\nぁ\t0.01\nぃ\t0.01\nぅ\t0.01\nぇ\t0.01\nぉ\t0.01\nぢ\t0.01\nゑ\t0.001\nゐ\t0.001\nー\t1.00\nン\t0.60\nス\t0.50\nト\t0.40\nル\t0.40\nイ\t0.40\nッ\t0.30\nリ\t0.30\nク\t0.30\nラ\t0.30\nシ\t0.30\nタ\t0.20\nコ\t0.20\nレ\t0.20\nテ\t0.20\nド\t0.20\nマ\t0.20\nカ\t0.20\nア\t0.20\nロ\t0.20\nフ\t0.15\nプ\t0.15\nメ\t0.10\nジ\t0.10\nニ\t0.10\nオ\t0.10\nグ\t0.10\nキ\t0.10\nバ\t0.10\nム\t0.10\nサ\t0.10\nデ\t0.10\nブ\t0.10\nナ\t0.10\nィ\t0.10\nャ\t0.05\nュ\t0.05\nョ\t0.05\nエ\t0.05\nウ\t0.05\nパ\t0.05\nセ\t0.05\nミ\t0.05\nハ\t0.05\nビ\t0.05\nガ\t0.05\nダ\t0.05\nゲ\t0.05\nベ\t0.05\nポ\t0.05\nピ\t0.05\nゴ\t0.05\nズ\t0.05\nワ\t0.05\nチ\t0.05\nケ\t0.05\nボ\t0.05\nホ\t0.05\nネ\t0.03\nヒ\t0.03\nノ\t0.03\nソ\t0.03\nツ\t0.03\nモ\t0.03\nヤ\t0.03\nユ\t0.03\nヨ\t0.03\nヘ\t0.03\nヌ\t0.01\nゼ\t0.02\nゾ\t0.02\nギ\t0.02\nヴ\t0.01\nァ\t0.02\nゥ\t0.01\nェ\t0.02\nォ\t0.02\nヶ\t0.01\n、\t3.50\n。\t2.50\n「\t0.50\n」\t0.50\n・\t0.20\n（\t0.20\n）\t0.20\n『\t0.05\n』\t0.05\n？\t0.05\n！\t0.05\n\u3000\t0.30\n…\t0.05\n～\t0.02\n々\t0.20\n日\t1.2000\n一\t0.8571\n国\t0.6667\n会\t0.5455\n人\t0.4615\n年\t0.4000\n大\t0.3529\n十\t0.3158\n二\t0.2857\n本\t0.2609\n中\t0.2400\n長\t0.2222\n出\t0.2069\n三\t0.1935\n同\t0.1818\n時\t0.1714\n政\t0.1622\n事\t0.1538\n自\t0.1463\n行\t0.1395\n社\t0.1333\n見\t0.1277\n月\t0.1224\n分\t0.1176\n議\t0.1132\n後\t0.1091\n前\t0.1053\n民\t0.1017\n生\t0.0984\n連\t0.0952\n五\t0.0923\n発\t0.0896\n間\t0.0870\n対\t0.0845\n上\t0.0822\n部\t0.0800\n東\t0.0779\n者\t0.0759\n党\t0.0741\n地\t0.0723\n合\t0.0706\n市\t0.0690\n業\t0.0674\n内\t0.0659\n相\t0.0645\n方\t0.0632\n四\t0.0619\n定\t0.0606\n今\t0.0594\n回\t0.0583\n新\t0.0571\n場\t0.0561\n金\t0.0550\n員\t0.0541\n九\t0.0531\n入\t0.0522\n選\t0.0513\n立\t0.0504\n開\t0.0496\n手\t0.0488\n米\t0.0480\n力\t0.0472\n学\t0.0465\n問\t0.0458\n高\t0.0451\n代\t0.0444\n明\t0.0438\n実\t0.0432\n円\t0.0426\n関\t0.0420\n決\t0.0414\n子\t0.0408\n動\t0.0403\n京\t0.0397\n全\t0.0392\n目\t0.0387\n表\t0.0382\n戦\t0.0377\n経\t0.0373\n通\t0.0368\n外\t0.0364\n最\t0.0359\n言\t0.0355\n氏\t0.0351\n現\t0.0347\n理\t0.0343\n調\t0.0339\n体\t0.0335\n化\t0.0331\n田\t0.0328\n当\t0.0324\n八\t0.0321\n六\t0.0317\n約\t0.0314\n主\t0.0311\n題\t0.0308\n下\t0.0305\n首\t0.0302\n意\t0.0299\n法\t0.0296\n不\t0.0293\n来\t0.0290\n作\t0.0287\n性\t0.0284\n的\t0.0282\n要\t0.0279\n用\t0.0276\n制\t0.0274\n治\t0.0271\n度\t0.0269\n務\t0.0267\n強\t0.0264\n気\t0.0262\n小\t0.0260\n七\t0.0258\n成\t0.0255\n期\t0.0253\n公\t0.0251\n持\t0.0249\n野\t0.0247\n協\t0.0245\n取\t0.0243\n都\t0.0241\n和\t0.0239\n統\t0.0237\n以\t0.0235\n機\t0.0233\n平\t0.0232\n総\t0.0230\n加\t0.0228\n山\t0.0226\n思\t0.0225\n家\t0.0223\n話\t0.0221\n世\t0.0220\n受\t0.0218\n区\t0.0217\n領\t0.0215\n多\t0.0214\n県\t0.0212\n続\t0.0211\n進\t0.0209\n正\t0.0208\n安\t0.0206\n設\t0.0205\n保\t0.0203\n改\t0.0202\n数\t0.0201\n記\t0.0199\n院\t0.0198\n女\t0.0197\n初\t0.0195\n北\t0.0194\n午\t0.0193\n指\t0.0192\n権\t0.0190\n心\t0.0189\n界\t0.0188\n支\t0.0187\n第\t0.0186\n産\t0.0185\n結\t0.0183\n百\t0.0182\n派\t0.0181\n点\t0.0180\n教\t0.0179\n報\t0.0178\n済\t0.0177\n書\t0.0176\n府\t0.0175\n活\t0.0174\n原\t0.0173\n先\t0.0172\n共\t0.0171\n得\t0.0170\n解\t0.0169\n名\t0.0168\n交\t0.0167\n資\t0.0166\n予\t0.0165\n川\t0.0164\n向\t0.0163\n際\t0.0163\n査\t0.0162\n勝\t0.0161\n面\t0.0160\n委\t0.0159\n告\t0.0158\n軍\t0.0157\n文\t0.0157\n反\t0.0156\n元\t0.0155\n重\t0.0154\n近\t0.0153\n千\t0.0153\n考\t0.0152\n判\t0.0151\n認\t0.0150\n画\t0.0150\n海\t0.0149\n参\t0.0148\n売\t0.0147\n利\t0.0147\n組\t0.0146\n知\t0.0145\n案\t0.0145\n道\t0.0144\n信\t0.0143\n策\t0.0143\n集\t0.0142\n在\t0.0141\n件\t0.0141\n団\t0.0140\n別\t0.0139\n物\t0.0139\n側\t0.0138\n任\t0.0137\n引\t0.0137\n使\t0.0136\n求\t0.0135\n所\t0.0135\n次\t0.0134\n水\t0.0134\n半\t0.0133\n品\t0.0132\n昨\t0.0132\n論\t0.0131\n計\t0.0131\n死\t0.0130\n官\t0.0130\n増\t0.0129\n係\t0.0128\n感\t0.0128\n特\t0.0127\n情\t0.0127\n投\t0.0126\n示\t0.0126\n変\t0.0125\n打\t0.0125\n男\t0.0124\n基\t0.0124\n私\t0.0123\n各\t0.0123\n始\t0.0122\n島\t0.0122\n直\t0.0121\n両\t0.0121\n朝\t0.0120\n革\t0.0120\n価\t0.0119\n式\t0.0119\n確\t0.0118\n村\t0.0118\n提\t0.0117\n運\t0.0117\n終\t0.0117\n挙\t0.0116\n果\t0.0116\n西\t0.0115\n勢\t0.0115\n減\t0.0114\n台\t0.0114\n広\t0.0113\n容\t0.0113\n必\t0.0113\n応\t0.0112\n演\t0.0112\n電\t0.0111\n歳\t0.0111\n住\t0.0110\n争\t0.0110\n談\t0.0110\n能\t0.0109\n無\t0.0109\n再\t0.0108\n位\t0.0108\n置\t0.0108\n企\t0.0107\n真\t0.0107\n流\t0.0107\n格\t0.0106\n有\t0.0106\n疑\t0.0105\n口\t0.0105\n過\t0.0105\n局\t0.0104\n少\t0.0104\n放\t0.0104\n税\t0.0103\n検\t0.0103\n藤\t0.0103\n町\t0.0102\n常\t0.0102\n校\t0.0102\n料\t0.0101\n沢\t0.0101\n裁\t0.0101\n状\t0.0100\n工\t0.0100\n建\t0.0100\n語\t0.0099\n球\t0.0099\n営\t0.0099\n空\t0.0098\n職\t0.0098\n証\t0.0098\n土\t0.0097\n与\t0.0097\n急\t0.0097\n止\t0.0096\n送\t0.0096\n援\t0.0096\n供\t0.0095\n可\t0.0095\n役\t0.0095\n構\t0.0094\n木\t0.0094\n割\t0.0094\n聞\t0.0094\n身\t0.0093\n費\t0.0093\n付\t0.0093\n施\t0.0092\n切\t0.0092\n由\t0.0092\n説\t0.0092\n転\t0.0091\n食\t0.0091\n比\t0.0091\n難\t0.0090\n防\t0.0090\n補\t0.0090\n車\t0.0090\n優\t0.0089\n夫\t0.0089\n研\t0.0089\n収\t0.0089\n断\t0.0088\n井\t0.0088\n何\t0.0088\n南\t0.0088\n石\t0.0087\n足\t0.0087\n違\t0.0087\n消\t0.0087\n境\t0.0086\n神\t0.0086\n番\t0.0086\n規\t0.0086\n術\t0.0085\n護\t0.0085\n展\t0.0085\n態\t0.0085\n導\t0.0084\n鮮\t0.0084\n備\t0.0084\n宅\t0.0084\n害\t0.0083\n配\t0.0083\n副\t0.0083\n算\t0.0083\n視\t0.0083\n条\t0.0082\n幹\t0.0082\n独\t0.0082\n警\t0.0082\n宮\t0.0081\n究\t0.0081\n育\t0.0081\n席\t0.0081\n輸\t0.0081\n訪\t0.0080\n楽\t0.0080\n起\t0.0080\n万\t0.0080\n着\t0.0079\n乗\t0.0079\n店\t0.0079\n述\t0.0079\n残\t0.0079\n想\t0.0078\n線\t0.0078\n率\t0.0078\n病\t0.0078\n農\t0.0078\n州\t0.0077\n武\t0.0077\n声\t0.0077\n質\t0.0077\n念\t0.0077\n待\t0.0076\n試\t0.0076\n族\t0.0076\n象\t0.0076\n銀\t0.0076\n域\t0.0075\n助\t0.0075\n労\t0.0075\n例\t0.0075\n衛\t0.0075\n然\t0.0075\n早\t0.0074\n張\t0.0074\n映\t0.0074\n限\t0.0074\n親\t0.0074\n額\t0.0073\n監\t0.0073\n環\t0.0073\n験\t0.0073\n追\t0.0073\n審\t0.0073\n商\t0.0072\n葉\t0.0072\n義\t0.0072\n伝\t0.0072\n働\t0.0072\n形\t0.0072\n景\t0.0071\n落\t0.0071\n欧\t0.0071\n担\t0.0071\n好\t0.0071\n退\t0.0071\n準\t0.0070\n賞\t0.0070\n訴\t0.0070\n辺\t0.0070\n造\t0.0070\n英\t0.0070\n被\t0.0069\n株\t0.0069\n頭\t0.0069\n技\t0.0069\n低\t0.0069\n毎\t0.0069\n医\t0.0068\n復\t0.0068\n仕\t0.0068\n去\t0.0068\n姿\t0.0068\n味\t0.0068\n負\t0.0067\n閣\t0.0067\n韓\t0.0067\n渡\t0.0067\n失\t0.0067\n移\t0.0067\n差\t0.0067\n衆\t0.0066\n個\t0.0066\n門\t0.0066\n写\t0.0066\n評\t0.0066\n課\t0.0066\n末\t0.0066\n守\t0.0065\n若\t0.0065\n脳\t0.0065\n極\t0.0065\n種\t0.0065\n美\t0.0065\n岡\t0.0065\n影\t0.0064\n命\t0.0064\n含\t0.0064\n福\t0.0064\n蔵\t0.0064\n量\t0.0064\n望\t0.0064\n松\t0.0063\n非\t0.0063\n撃\t0.0063\n佐\t0.0063\n核\t0.0063\n観\t0.0063\n察\t0.0063\n整\t0.0063\n段\t0.0062\n横\t0.0062\n融\t0.0062\n型\t0.0062\n白\t0.0062\n深\t0.0062\n字\t0.0062\n答\t0.0062\n夜\t0.0061\n製\t0.0061\n票\t0.0061\n況\t0.0061\n音\t0.0061\n申\t0.0061\n様\t0.0061\n財\t0.0061\n港\t0.0060\n識\t0.0060\n注\t0.0060\n呼\t0.0060\n渉\t0.0060\n達\t0.0060\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-ko.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the characters of Korean, in per cent of\n# all characters. The frequencies of the Hangul syllables\n# are estimated from their rank.\n*\t0.01\n이\t3.5000\n다\t2.3333\n는\t1.7500\n의\t1.4000\n에\t1.1667\n하\t1.0000\n고\t0.8750\n을\t0.7778\n가\t0.7000\n를\t0.6364\n한\t0.5833\n지\t0.5385\n기\t0.5000\n로\t0.4667\n서\t0.4375\n사\t0.4118\n으\t0.3889\n리\t0.3684\n자\t0.3500\n도\t0.3333\n나\t0.3182\n대\t0.3043\n어\t0.2917\n수\t0.2800\n인\t0.2692\n해\t0.2593\n아\t0.2500\n시\t0.2414\n일\t0.2333\n정\t0.2258\n그\t0.2188\n전\t0.2121\n게\t0.2059\n적\t0.2000\n되\t0.1944\n있\t0.1892\n구\t0.1842\n부\t0.1795\n제\t0.1750\n것\t0.1707\n상\t0.1667\n만\t0.1628\n보\t0.1591\n주\t0.1556\n국\t0.1522\n과\t0.1489\n면\t0.1458\n들\t0.1429\n우\t0.1400\n성\t0.1373\n여\t0.1346\n원\t0.1321\n라\t0.1296\n오\t0.1273\n스\t0.1250\n소\t0.1228\n장\t0.1207\n문\t0.1186\n동\t0.1167\n세\t0.1148\n없\t0.1129\n때\t0.1111\n내\t0.1094\n경\t0.1077\n신\t0.1061\n관\t0.1045\n화\t0.1029\n니\t0.1014\n요\t0.1000\n개\t0.0986\n와\t0.0972\n방\t0.0959\n말\t0.0946\n위\t0.0933\n치\t0.0921\n중\t0.0909\n공\t0.0897\n학\t0.0886\n생\t0.0875\n했\t0.0864\n거\t0.0854\n연\t0.0843\n비\t0.0833\n까\t0.0824\n물\t0.0814\n분\t0.0805\n무\t0.0795\n야\t0.0787\n미\t0.0778\n음\t0.0769\n안\t0.0761\n습\t0.0753\n력\t0.0745\n된\t0.0737\n모\t0.0729\n초\t0.0722\n실\t0.0714\n터\t0.0707\n조\t0.0700\n선\t0.0693\n유\t0.0686\n당\t0.0680\n간\t0.0673\n진\t0.0667\n더\t0.0660\n할\t0.0654\n러\t0.0648\n본\t0.0642\n직\t0.0636\n알\t0.0631\n날\t0.0625\n저\t0.0619\n르\t0.0614\n발\t0.0609\n및\t0.0603\n두\t0.0598\n득\t0.0593\n반\t0.0588\n금\t0.0583\n명\t0.0579\n불\t0.0574\n통\t0.0569\n행\t0.0565\n데\t0.0560\n산\t0.0556\n회\t0.0551\n계\t0.0547\n후\t0.0543\n심\t0.0538\n식\t0.0534\n업\t0.0530\n결\t0.0526\n현\t0.0522\n표\t0.0519\n등\t0.0515\n점\t0.0511\n건\t0.0507\n외\t0.0504\n재\t0.0500\n입\t0.0496\n작\t0.0493\n체\t0.0490\n운\t0.0486\n용\t0.0483\n각\t0.0479\n법\t0.0476\n단\t0.0473\n영\t0.0470\n감\t0.0467\n강\t0.0464\n달\t0.0461\n민\t0.0458\n월\t0.0455\n년\t0.0452\n른\t0.0449\n람\t0.0446\n양\t0.0443\n약\t0.0440\n던\t0.0437\n듯\t0.0435\n울\t0.0432\n올\t0.0429\n린\t0.0427\n않\t0.0424\n은\t0.0422\n련\t0.0419\n님\t0.0417\n받\t0.0414\n될\t0.0412\n교\t0.0409\n새\t0.0407\n처\t0.0405\n번\t0.0402\n며\t0.0400\n호\t0.0398\n차\t0.0395\n살\t0.0393\n품\t0.0391\n태\t0.0389\n근\t0.0387\n육\t0.0385\n복\t0.0383\n임\t0.0380\n급\t0.0378\n출\t0.0376\n활\t0.0374\n역\t0.0372\n변\t0.0370\n씨\t0.0368\n예\t0.0366\n택\t0.0365\n려\t0.0363\n드\t0.0361\n트\t0.0359\n크\t0.0357\n프\t0.0355\n므\t0.0354\n겠\t0.0352\n였\t0.0350\n많\t0.0348\n히\t0.0347\n네\t0.0345\n군\t0.0343\n속\t0.0341\n키\t0.0340\n왜\t0.0338\n쪽\t0.0337\n함\t0.0335\n길\t0.0333\n매\t0.0332\n토\t0.0330\n답\t0.0329\n편\t0.0327\n망\t0.0326\n파\t0.0324\n배\t0.0323\n목\t0.0321\n특\t0.0320\n설\t0.0318\n순\t0.0317\n형\t0.0315\n노\t0.0314\n확\t0.0312\n청\t0.0311\n준\t0.0310\n색\t0.0308\n잘\t0.0307\n희\t0.0306\n최\t0.0304\n손\t0.0303\n집\t0.0302\n써\t0.0300\n쓰\t0.0299\n봐\t0.0298\n못\t0.0297\n왔\t0.0295\n갔\t0.0294\n줄\t0.0293\n곳\t0.0292\n권\t0.0290\n밝\t0.0289\n높\t0.0288\n먹\t0.0287\n읽\t0.0286\n씩\t0.0285\n절\t0.0283\n험\t0.0282\n질\t0.0281\n종\t0.0280\n투\t0.0279\n판\t0.0278\n허\t0.0277\n혁\t0.0276\n협\t0.0275\n승\t0.0273\n술\t0.0272\n추\t0.0271\n충\t0.0270\n층\t0.0269\n항\t0.0268\n향\t0.0267\n혼\t0.0266\n홍\t0.0265\n환\t0.0264\n황\t0.0263\n효\t0.0262\n훈\t0.0261\n휴\t0.0260\n흥\t0.0259\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
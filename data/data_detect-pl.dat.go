@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-pl.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Polish, in per cent of all letters.\na\t10.503\nb\t1.740\nc\t3.895\nd\t3.725\ne\t7.352\nf\t0.143\ng\t1.731\nh\t1.015\ni\t8.328\nj\t1.836\nk\t2.753\nl\t2.564\nm\t2.515\nn\t6.237\no\t6.667\np\t2.445\nq\t0.001\nr\t5.243\ns\t5.224\nt\t2.475\nu\t2.062\nv\t0.012\nw\t5.813\nx\t0.004\ny\t3.206\nz\t4.852\ną\t0.699\nć\t0.743\nę\t1.035\nł\t2.109\nń\t0.362\nó\t1.141\nś\t0.814\nź\t0.078\nż\t0.706\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
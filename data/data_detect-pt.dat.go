@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-pt.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Portuguese, in per cent of all letters.\na\t14.634\nb\t1.043\nc\t3.882\nd\t4.992\ne\t12.570\nf\t1.023\ng\t1.303\nh\t0.781\ni\t6.186\nj\t0.397\nk\t0.015\nl\t2.779\nm\t4.738\nn\t4.446\no\t9.735\np\t2.523\nq\t1.204\nr\t6.530\ns\t6.805\nt\t4.336\nu\t3.639\nv\t1.575\nw\t0.037\nx\t0.253\ny\t0.006\nz\t0.470\ná\t0.118\nà\t0.072\nâ\t0.562\nã\t0.733\nç\t0.530\né\t0.337\nê\t0.450\ní\t0.132\nó\t0.296\nô\t0.635\nõ\t0.040\nú\t0.207\nü\t0.001\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-ro.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Romanian, in per cent of all letters.\na\t10.0\nb\t1.0\nc\t4.7\nd\t3.4\ne\t11.0\nf\t1.4\ng\t1.0\nh\t0.5\ni\t9.5\nj\t0.2\nk\t0.1\nl\t4.5\nm\t3.0\nn\t6.5\no\t5.0\np\t3.0\nq\t0.01\nr\t6.8\ns\t4.5\nt\t6.0\nu\t6.3\nv\t1.0\nw\t0.02\nx\t0.1\ny\t0.05\nz\t0.8\nă\t2.000\nâ\t1.000\nî\t1.200\nș\t1.400\nş\t1.400\nț\t1.300\nţ\t1.300\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-ru.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Russian,\n# in per cent of all letters.\nо\t10.97\nе\t8.45\nа\t8.01\nи\t7.35\nн\t6.70\nт\t6.26\nс\t5.47\nр\t4.73\nв\t4.54\nл\t4.40\nк\t3.49\nм\t3.21\nд\t2.98\nп\t2.81\nу\t2.62\nя\t2.01\nы\t1.90\nь\t1.74\nг\t1.70\nз\t1.65\nб\t1.59\nч\t1.44\nй\t1.21\nх\t0.97\nж\t0.94\nш\t0.73\nю\t0.64\nц\t0.48\nщ\t0.36\nэ\t0.32\nф\t0.26\nъ\t0.04\nё\t0.04\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-sk.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Slovak, in per cent of all letters.\na\t9.0\nb\t1.7\nc\t2.8\nd\t3.3\ne\t8.6\nf\t0.3\ng\t0.3\nh\t2.0\ni\t5.8\nj\t2.0\nk\t3.5\nl\t3.6\nm\t3.0\nn\t5.6\no\t9.0\np\t2.7\nq\t0.01\nr\t4.3\ns\t4.6\nt\t4.3\nu\t2.5\nv\t4.5\nw\t0.01\nx\t0.02\ny\t1.6\nz\t2.0\ná\t2.100\nä\t0.100\nč\t0.900\nď\t0.100\né\t0.300\ní\t1.600\nĺ\t0.010\nľ\t0.300\nň\t0.100\nó\t0.200\nô\t0.200\nŕ\t0.010\nš\t0.700\nť\t0.400\nú\t0.700\ný\t1.300\nž\t0.700\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-sv.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Swedish, in per cent of all letters.\na\t9.383\nb\t1.535\nc\t1.486\nd\t4.702\ne\t10.149\nf\t2.027\ng\t2.862\nh\t2.090\ni\t5.817\nj\t0.614\nk\t3.140\nl\t5.275\nm\t3.471\nn\t8.542\no\t4.482\np\t1.839\nq\t0.020\nr\t8.431\ns\t6.590\nt\t7.691\nu\t1.919\nv\t2.415\nw\t0.142\nx\t0.159\ny\t0.708\nz\t0.070\nå\t1.338\nä\t1.797\nö\t1.305\né\t0.050\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-tr.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Turkish, in per cent of all letters.\na\t12.920\nb\t2.844\nc\t1.463\nd\t5.206\ne\t9.912\nf\t0.461\ng\t1.253\nh\t1.212\ni\t9.600\nj\t0.034\nk\t5.683\nl\t5.922\nm\t3.752\nn\t7.987\no\t2.976\np\t0.886\nq\t0.001\nr\t7.722\ns\t3.014\nt\t3.314\nu\t3.235\nv\t0.959\nw\t0.001\nx\t0.001\ny\t3.336\nz\t1.500\nç\t1.463\nğ\t1.125\nı\t5.114\nİ\t0.200\nö\t0.777\nş\t1.780\nü\t1.854\nâ\t0.050\nî\t0.020\nû\t0.010\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-uk.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the letters of Ukrainian,\n# in per cent of all letters.\nо\t9.40\nа\t8.00\nн\t6.60\nи\t6.00\nі\t5.60\nт\t5.00\nв\t4.70\nе\t4.70\nр\t4.40\nс\t4.00\nк\t3.60\nл\t3.60\nу\t3.40\nд\t3.10\nм\t3.10\nп\t2.80\nз\t2.20\nя\t2.00\nь\t1.60\nб\t1.60\nг\t1.50\nч\t1.30\nй\t1.20\nх\t1.10\nц\t0.90\nї\t0.80\nж\t0.80\nю\t0.70\nш\t0.70\nє\t0.40\nф\t0.30\nщ\t0.20\nґ\t0.02\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
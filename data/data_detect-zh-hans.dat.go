@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-zh-hans.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the characters of Chinese in simplified\n# characters, in per cent of all characters. The frequencies\n# of the hanzi are estimated from their rank.\n*\t0.006\n，\t3.00\n。\t2.00\n、\t0.50\n“\t0.40\n”\t0.40\n：\t0.20\n；\t0.05\n？\t0.10\n！\t0.05\n（\t0.10\n）\t0.10\n《\t0.05\n》\t0.05\n\u3000\t0.10\n的\t4.0000\n一\t2.6667\n是\t2.0000\n不\t1.6000\n了\t1.3333\n在\t1.1429\n人\t1.0000\n有\t0.8889\n我\t0.8000\n他\t0.7273\n这\t0.6667\n个\t0.6154\n们\t0.5714\n中\t0.5333\n来\t0.5000\n上\t0.4706\n大\t0.4444\n为\t0.4211\n和\t0.4000\n国\t0.3810\n地\t0.3636\n到\t0.3478\n以\t0.3333\n说\t0.3200\n时\t0.3077\n要\t0.2963\n就\t0.2857\n出\t0.2759\n会\t0.2667\n可\t0.2581\n也\t0.2500\n你\t0.2424\n对\t0.2353\n生\t0.2286\n能\t0.2222\n而\t0.2162\n子\t0.2105\n那\t0.2051\n得\t0.2000\n于\t0.1951\n着\t0.1905\n下\t0.1860\n自\t0.1818\n之\t0.1778\n年\t0.1739\n过\t0.1702\n发\t0.1667\n后\t0.1633\n作\t0.1600\n里\t0.1569\n用\t0.1538\n道\t0.1509\n行\t0.1481\n所\t0.1455\n然\t0.1429\n家\t0.1404\n种\t0.1379\n事\t0.1356\n成\t0.1333\n方\t0.1311\n多\t0.1290\n经\t0.1270\n么\t0.1250\n去\t0.1231\n法\t0.1212\n学\t0.1194\n如\t0.1176\n都\t0.1159\n同\t0.1143\n现\t0.1127\n当\t0.1111\n没\t0.1096\n动\t0.1081\n面\t0.1067\n起\t0.1053\n看\t0.1039\n定\t0.1026\n天\t0.1013\n分\t0.1000\n还\t0.0988\n进\t0.0976\n好\t0.0964\n小\t0.0952\n部\t0.0941\n其\t0.0930\n些\t0.0920\n主\t0.0909\n样\t0.0899\n理\t0.0889\n心\t0.0879\n她\t0.0870\n本\t0.0860\n前\t0.0851\n开\t0.0842\n但\t0.0833\n因\t0.0825\n只\t0.0816\n从\t0.0808\n想\t0.0800\n实\t0.0792\n日\t0.0784\n军\t0.0777\n者\t0.0769\n意\t0.0762\n无\t0.0755\n力\t0.0748\n它\t0.0741\n与\t0.0734\n长\t0.0727\n把\t0.0721\n机\t0.0714\n十\t0.0708\n民\t0.0702\n第\t0.0696\n公\t0.0690\n此\t0.0684\n已\t0.0678\n工\t0.0672\n使\t0.0667\n情\t0.0661\n明\t0.0656\n性\t0.0650\n知\t0.0645\n全\t0.0640\n三\t0.0635\n又\t0.0630\n关\t0.0625\n点\t0.0620\n正\t0.0615\n业\t0.0611\n外\t0.0606\n将\t0.0602\n两\t0.0597\n高\t0.0593\n间\t0.0588\n由\t0.0584\n问\t0.0580\n很\t0.0576\n最\t0.0571\n重\t0.0567\n并\t0.0563\n物\t0.0559\n手\t0.0556\n应\t0.0552\n战\t0.0548\n向\t0.0544\n头\t0.0541\n文\t0.0537\n体\t0.0533\n政\t0.0530\n美\t0.0526\n相\t0.0523\n见\t0.0519\n被\t0.0516\n利\t0.0513\n什\t0.0510\n二\t0.0506\n等\t0.0503\n产\t0.0500\n或\t0.0497\n新\t0.0494\n己\t0.0491\n制\t0.0488\n身\t0.0485\n果\t0.0482\n加\t0.0479\n西\t0.0476\n斯\t0.0473\n月\t0.0471\n话\t0.0468\n合\t0.0465\n回\t0.0462\n特\t0.0460\n代\t0.0457\n内\t0.0455\n信\t0.0452\n表\t0.0449\n化\t0.0447\n老\t0.0444\n给\t0.0442\n世\t0.0440\n位\t0.0437\n次\t0.0435\n度\t0.0432\n门\t0.0430\n任\t0.0428\n常\t0.0426\n先\t0.0423\n海\t0.0421\n通\t0.0419\n教\t0.0417\n儿\t0.0415\n原\t0.0412\n东\t0.0410\n声\t0.0408\n提\t0.0406\n立\t0.0404\n及\t0.0402\n比\t0.0400\n员\t0.0398\n解\t0.0396\n水\t0.0394\n名\t0.0392\n真\t0.0390\n论\t0.0388\n处\t0.0386\n走\t0.0385\n义\t0.0383\n各\t0.0381\n入\t0.0379\n几\t0.0377\n口\t0.0376\n认\t0.0374\n条\t0.0372\n平\t0.0370\n系\t0.0369\n气\t0.0367\n题\t0.0365\n活\t0.0364\n尔\t0.0362\n更\t0.0360\n别\t0.0359\n打\t0.0357\n女\t0.0356\n变\t0.0354\n四\t0.0352\n神\t0.0351\n总\t0.0349\n何\t0.0348\n电\t0.0346\n数\t0.0345\n安\t0.0343\n少\t0.0342\n报\t0.0340\n才\t0.0339\n结\t0.0338\n反\t0.0336\n受\t0.0335\n目\t0.0333\n太\t0.0332\n量\t0.0331\n再\t0.0329\n感\t0.0328\n建\t0.0327\n务\t0.0325\n做\t0.0324\n接\t0.0323\n必\t0.0321\n场\t0.0320\n件\t0.0319\n计\t0.0317\n管\t0.0316\n期\t0.0315\n市\t0.0314\n直\t0.0312\n德\t0.0311\n资\t0.0310\n命\t0.0309\n山\t0.0308\n金\t0.0307\n指\t0.0305\n克\t0.0304\n许\t0.0303\n统\t0.0302\n区\t0.0301\n保\t0.0300\n至\t0.0299\n队\t0.0297\n形\t0.0296\n社\t0.0295\n便\t0.0294\n空\t0.0293\n决\t0.0292\n治\t0.0291\n展\t0.0290\n马\t0.0289\n科\t0.0288\n司\t0.0287\n五\t0.0286\n基\t0.0285\n眼\t0.0284\n书\t0.0283\n非\t0.0282\n则\t0.0281\n听\t0.0280\n白\t0.0279\n却\t0.0278\n界\t0.0277\n达\t0.0276\n光\t0.0275\n放\t0.0274\n强\t0.0273\n即\t0.0272\n像\t0.0271\n难\t0.0270\n且\t0.0269\n权\t0.0268\n思\t0.0268\n王\t0.0267\n象\t0.0266\n完\t0.0265\n设\t0.0264\n式\t0.0263\n色\t0.0262\n路\t0.0261\n记\t0.0261\n南\t0.0260\n品\t0.0259\n住\t0.0258\n告\t0.0257\n类\t0.0256\n求\t0.0256\n据\t0.0255\n程\t0.0254\n北\t0.0253\n边\t0.0252\n死\t0.0252\n张\t0.0251\n该\t0.0250\n交\t0.0249\n规\t0.0248\n万\t0.0248\n取\t0.0247\n拉\t0.0246\n格\t0.0245\n望\t0.0245\n觉\t0.0244\n术\t0.0243\n领\t0.0242\n共\t0.0242\n确\t0.0241\n传\t0.0240\n师\t0.0240\n观\t0.0239\n清\t0.0238\n今\t0.0237\n切\t0.0237\n院\t0.0236\n让\t0.0235\n识\t0.0235\n候\t0.0234\n带\t0.0233\n导\t0.0233\n争\t0.0232\n运\t0.0231\n笑\t0.0231\n飞\t0.0230\n风\t0.0229\n步\t0.0229\n改\t0.0228\n收\t0.0227\n根\t0.0227\n干\t0.0226\n造\t0.0225\n言\t0.0225\n联\t0.0224\n持\t0.0223\n组\t0.0223\n每\t0.0222\n济\t0.0222\n车\t0.0221\n亲\t0.0220\n极\t0.0220\n林\t0.0219\n服\t0.0219\n快\t0.0218\n办\t0.0217\n议\t0.0217\n往\t0.0216\n元\t0.0216\n英\t0.0215\n士\t0.0214\n证\t0.0214\n近\t0.0213\n失\t0.0213\n转\t0.0212\n夫\t0.0212\n令\t0.0211\n准\t0.0211\n布\t0.0210\n始\t0.0209\n怎\t0.0209\n呢\t0.0208\n存\t0.0208\n未\t0.0207\n远\t0.0207\n叫\t0.0206\n台\t0.0206\n单\t0.0205\n影\t0.0205\n具\t0.0204\n罗\t0.0204\n字\t0.0203\n爱\t0.0203\n击\t0.0202\n流\t0.0202\n备\t0.0201\n兵\t0.0201\n连\t0.0200\n调\t0.0200\n深\t0.0199\n商\t0.0199\n算\t0.0198\n质\t0.0198\n团\t0.0197\n集\t0.0197\n百\t0.0196\n需\t0.0196\n价\t0.0195\n花\t0.0195\n党\t0.0194\n华\t0.0194\n城\t0.0193\n石\t0.0193\n级\t0.0192\n整\t0.0192\n府\t0.0191\n离\t0.0191\n况\t0.0190\n亚\t0.0190\n请\t0.0190\n技\t0.0189\n际\t0.0189\n约\t0.0188\n示\t0.0188\n复\t0.0187\n病\t0.0187\n息\t0.0186\n究\t0.0186\n线\t0.0186\n似\t0.0185\n官\t0.0185\n火\t0.0184\n断\t0.0184\n精\t0.0183\n满\t0.0183\n支\t0.0183\n视\t0.0182\n消\t0.0182\n越\t0.0181\n器\t0.0181\n容\t0.0181\n照\t0.0180\n须\t0.0180\n九\t0.0179\n增\t0.0179\n研\t0.0179\n写\t0.0178\n称\t0.0178\n企\t0.0177\n八\t0.0177\n功\t0.0177\n吗\t0.0176\n包\t0.0176\n片\t0.0175\n史\t0.0175\n委\t0.0175\n乎\t0.0174\n查\t0.0174\n轻\t0.0174\n易\t0.0173\n早\t0.0173\n曾\t0.0172\n除\t0.0172\n农\t0.0172\n找\t0.0171\n装\t0.0171\n广\t0.0171\n显\t0.0170\n吧\t0.0170\n阿\t0.0169\n李\t0.0169\n标\t0.0169\n谈\t0.0168\n吃\t0.0168\n图\t0.0168\n念\t0.0167\n六\t0.0167\n引\t0.0167\n历\t0.0166\n首\t0.0166\n医\t0.0166\n局\t0.0165\n突\t0.0165\n专\t0.0165\n费\t0.0164\n号\t0.0164\n尽\t0.0164\n另\t0.0163\n周\t0.0163\n较\t0.0163\n注\t0.0162\n语\t0.0162\n仅\t0.0162\n考\t0.0161\n落\t0.0161\n青\t0.0161\n随\t0.0160\n选\t0.0160\n列\t0.0160\n武\t0.0159\n红\t0.0159\n响\t0.0159\n虽\t0.0158\n推\t0.0158\n势\t0.0158\n参\t0.0157\n希\t0.0157\n古\t0.0157\n众\t0.0157\n构\t0.0156\n房\t0.0156\n半\t0.0156\n节\t0.0155\n土\t0.0155\n投\t0.0155\n某\t0.0154\n案\t0.0154\n黑\t0.0154\n维\t0.0154\n革\t0.0153\n划\t0.0153\n敌\t0.0153\n致\t0.0152\n陈\t0.0152\n律\t0.0152\n足\t0.0152\n态\t0.0151\n护\t0.0151\n七\t0.0151\n兴\t0.0150\n派\t0.0150\n孩\t0.0150\n验\t0.0150\n责\t0.0149\n营\t0.0149\n星\t0.0149\n够\t0.0148\n章\t0.0148\n音\t0.0148\n跟\t0.0148\n志\t0.0147\n底\t0.0147\n站\t0.0147\n严\t0.0147\n巴\t0.0146\n例\t0.0146\n防\t0.0146\n族\t0.0145\n供\t0.0145\n效\t0.0145\n续\t0.0145\n施\t0.0144\n留\t0.0144\n讲\t0.0144\n型\t0.0144\n料\t0.0143\n终\t0.0143\n答\t0.0143\n紧\t0.0143\n黄\t0.0142\n绝\t0.0142\n奇\t0.0142\n察\t0.0142\n母\t0.0141\n京\t0.0141\n段\t0.0141\n依\t0.0141\n批\t0.0140\n群\t0.0140\n项\t0.0140\n故\t0.0140\n按\t0.0139\n河\t0.0139\n米\t0.0139\n围\t0.0139\n江\t0.0138\n织\t0.0138\n害\t0.0138\n斗\t0.0138\n双\t0.0137\n境\t0.0137\n客\t0.0137\n纪\t0.0137\n采\t0.0137\n举\t0.0136\n杀\t0.0136\n攻\t0.0136\n父\t0.0136\n苏\t0.0135\n密\t0.0135\n低\t0.0135\n朝\t0.0135\n友\t0.0134\n诉\t0.0134\n止\t0.0134\n细\t0.0134\n愿\t0.0134\n千\t0.0133\n值\t0.0133\n仍\t0.0133\n男\t0.0133\n钱\t0.0132\n破\t0.0132\n网\t0.0132\n热\t0.0132\n助\t0.0132\n倒\t0.0131\n育\t0.0131\n属\t0.0131\n坐\t0.0131\n帝\t0.0131\n限\t0.0130\n船\t0.0130\n脸\t0.0130\n职\t0.0130\n速\t0.0129\n刻\t0.0129\n乐\t0.0129\n否\t0.0129\n刚\t0.0129\n威\t0.0128\n毛\t0.0128\n状\t0.0128\n率\t0.0128\n甚\t0.0128\n独\t0.0127\n球\t0.0127\n般\t0.0127\n普\t0.0127\n怕\t0.0127\n弹\t0.0126\n校\t0.0126\n苦\t0.0126\n创\t0.0126\n假\t0.0126\n久\t0.0125\n错\t0.0125\n承\t0.0125\n印\t0.0125\n晚\t0.0125\n兰\t0.0124\n试\t0.0124\n股\t0.0124\n拿\t0.0124\n脑\t0.0124\n预\t0.0123\n谁\t0.0123\n益\t0.0123\n阳\t0.0123\n若\t0.0123\n哪\t0.0123\n微\t0.0122\n尼\t0.0122\n继\t0.0122\n送\t0.0122\n急\t0.0122\n血\t0.0121\n惊\t0.0121\n伤\t0.0121\n素\t0.0121\n药\t0.0121\n适\t0.0120\n波\t0.0120\n夜\t0.0120\n省\t0.0120\n初\t0.0120\n喜\t0.0120\n卫\t0.0119\n源\t0.0119\n食\t0.0119\n险\t0.0119\n待\t0.0119\n述\t0.0119\n陆\t0.0118\n习\t0.0118\n置\t0.0118\n居\t0.0118\n劳\t0.0118\n财\t0.0117\n环\t0.0117\n排\t0.0117\n福\t0.0117\n纳\t0.0117\n欢\t0.0117\n雷\t0.0116\n警\t0.0116\n获\t0.0116\n模\t0.0116\n充\t0.0116\n负\t0.0116\n云\t0.0115\n停\t0.0115\n木\t0.0115\n游\t0.0115\n龙\t0.0115\n树\t0.0115\n疑\t0.0114\n层\t0.0114\n冷\t0.0114\n洲\t0.0114\n冲\t0.0114\n射\t0.0114\n略\t0.0113\n范\t0.0113\n竟\t0.0113\n句\t0.0113\n室\t0.0113\n异\t0.0113\n激\t0.0113\n汉\t0.0112\n村\t0.0112\n哈\t0.0112\n策\t0.0112\n演\t0.0112\n简\t0.0112\n卡\t0.0111\n罪\t0.0111\n判\t0.0111\n担\t0.0111\n州\t0.0111\n静\t0.0111\n退\t0.0110\n既\t0.0110\n衣\t0.0110\n您\t0.0110\n宗\t0.0110\n积\t0.0110\n余\t0.0110\n痛\t0.0109\n检\t0.0109\n差\t0.0109\n富\t0.0109\n灵\t0.0109\n协\t0.0109\n角\t0.0109\n占\t0.0108\n配\t0.0108\n征\t0.0108\n修\t0.0108\n皮\t0.0108\n挥\t0.0108\n胜\t0.0108\n降\t0.0107\n阶\t0.0107\n审\t0.0107\n沉\t0.0107\n坚\t0.0107\n善\t0.0107\n妈\t0.0107\n刘\t0.0106\n读\t0.0106\n啊\t0.0106\n超\t0.0106\n免\t0.0106\n压\t0.0106\n银\t0.0106\n买\t0.0105\n皇\t0.0105\n养\t0.0105\n伊\t0.0105\n怀\t0.0105\n执\t0.0105\n副\t0.0105\n乱\t0.0104\n抗\t0.0104\n犯\t0.0104\n追\t0.0104\n帮\t0.0104\n宣\t0.0104\n佛\t0.0104\n岁\t0.0103\n航\t0.0103\n优\t0.0103\n怪\t0.0103\n香\t0.0103\n著\t0.0103\n田\t0.0103\n铁\t0.0103\n控\t0.0102\n税\t0.0102\n左\t0.0102\n右\t0.0102\n份\t0.0102\n穿\t0.0102\n艺\t0.0102\n背\t0.0102\n阵\t0.0101\n草\t0.0101\n脚\t0.0101\n概\t0.0101\n恶\t0.0101\n块\t0.0101\n顿\t0.0101\n敢\t0.0101\n守\t0.0100\n酒\t0.0100\n岛\t0.0100\n托\t0.0100\n央\t0.0100\n户\t0.0100\n烈\t0.0100\n洋\t0.0100\n哥\t0.0099\n索\t0.0099\n胡\t0.0099\n款\t0.0099\n靠\t0.0099\n评\t0.0099\n版\t0.0099\n宝\t0.0099\n座\t0.0098\n释\t0.0098\n景\t0.0098\n顾\t0.0098\n弟\t0.0098\n登\t0.0098\n货\t0.0098\n互\t0.0098\n付\t0.0097\n伯\t0.0097\n慢\t0.0097\n欧\t0.0097\n换\t0.0097\n闻\t0.0097\n危\t0.0097\n忙\t0.0097\n核\t0.0097\n暗\t0.0096\n姐\t0.0096\n介\t0.0096\n坏\t0.0096\n讨\t0.0096\n丽\t0.0096\n良\t0.0096\n序\t0.0096\n升\t0.0095\n监\t0.0095\n临\t0.0095\n亮\t0.0095\n露\t0.0095\n永\t0.0095\n呼\t0.0095\n味\t0.0095\n野\t0.0095\n架\t0.0094\n域\t0.0094\n沙\t0.0094\n掉\t0.0094\n括\t0.0094\n舰\t0.0094\n鱼\t0.0094\n杂\t0.0094\n误\t0.0094\n湾\t0.0093\n吉\t0.0093\n减\t0.0093\n编\t0.0093\n楚\t0.0093\n肯\t0.0093\n测\t0.0093\n败\t0.0093\n屋\t0.0093\n跑\t0.0092\n梦\t0.0092\n散\t0.0092\n温\t0.0092\n困\t0.0092\n剑\t0.0092\n渐\t0.0092\n封\t0.0092\n救\t0.0092\n贵\t0.0092\n枪\t0.0091\n缺\t0.0091\n楼\t0.0091\n县\t0.0091\n尚\t0.0091\n毫\t0.0091\n移\t0.0091\n娘\t0.0091\n朋\t0.0091\n画\t0.0090\n班\t0.0090\n智\t0.0090\n亦\t0.0090\n耳\t0.0090\n恩\t0.0090\n短\t0.0090\n掌\t0.0090\n恐\t0.0090\n遗\t0.0090\n固\t0.0089\n席\t0.0089\n松\t0.0089\n秘\t0.0089\n谢\t0.0089\n鲁\t0.0089\n遇\t0.0089\n康\t0.0089\n虑\t0.0089\n幸\t0.0089\n均\t0.0088\n销\t0.0088\n钟\t0.0088\n诗\t0.0088\n藏\t0.0088\n赶\t0.0088\n剧\t0.0088\n票\t0.0088\n损\t0.0088\n忽\t0.0088\n巨\t0.0088\n炮\t0.0087\n旧\t0.0087\n端\t0.0087\n探\t0.0087\n湖\t0.0087\n录\t0.0087\n叶\t0.0087\n春\t0.0087\n乡\t0.0087\n附\t0.0087\n吸\t0.0086\n予\t0.0086\n礼\t0.0086\n港\t0.0086\n雨\t0.0086\n呀\t0.0086\n板\t0.0086\n庭\t0.0086\n妇\t0.0086\n归\t0.0086\n睛\t0.0086\n饭\t0.0085\n额\t0.0085\n含\t0.0085\n顺\t0.0085\n输\t0.0085\n摇\t0.0085\n招\t0.0085\n婚\t0.0085\n脱\t0.0085\n补\t0.0085\n谓\t0.0085\n督\t0.0084\n毒\t0.0084\n油\t0.0084\n疗\t0.0084\n旅\t0.0084\n泽\t0.0084\n材\t0.0084\n灭\t0.0084\n逐\t0.0084\n莫\t0.0084\n笔\t0.0084\n亡\t0.0084\n鲜\t0.0083\n词\t0.0083\n圣\t0.0083\n择\t0.0083\n寻\t0.0083\n厂\t0.0083\n睡\t0.0083\n博\t0.0083\n勒\t0.0083\n烟\t0.0083\n授\t0.0083\n诺\t0.0082\n伦\t0.0082\n岸\t0.0082\n奥\t0.0082\n唐\t0.0082\n卖\t0.0082\n俄\t0.0082\n炸\t0.0082\n载\t0.0082\n洛\t0.0082\n健\t0.0082\n堂\t0.0082\n旁\t0.0081\n宫\t0.0081\n喝\t0.0081\n借\t0.0081\n君\t0.0081\n禁\t0.0081\n阴\t0.0081\n园\t0.0081\n谋\t0.0081\n宋\t0.0081\n避\t0.0081\n抓\t0.0081\n荣\t0.0080\n姑\t0.0080\n孙\t0.0080\n逃\t0.0080\n牙\t0.0080\n束\t0.0080\n跳\t0.0080\n顶\t0.0080\n玉\t0.0080\n镇\t0.0080\n雪\t0.0080\n午\t0.0080\n练\t0.0080\n迫\t0.0079\n爷\t0.0079\n篇\t0.0079\n肉\t0.0079\n嘴\t0.0079\n馆\t0.0079\n遍\t0.0079\n凡\t0.0079\n础\t0.0079\n洞\t0.0079\n卷\t0.0079\n坦\t0.0079\n牛\t0.0079\n宁\t0.0078\n纸\t0.0078\n诸\t0.0078\n训\t0.0078\n私\t0.0078\n庄\t0.0078\n祖\t0.0078\n丝\t0.0078\n翻\t0.0078\n暴\t0.0078\n森\t0.0078\n塔\t0.0078\n默\t0.0078\n握\t0.0077\n戏\t0.0077\n隐\t0.0077\n熟\t0.0077\n骨\t0.0077\n访\t0.0077\n弱\t0.0077\n蒙\t0.0077\n歌\t0.0077\n店\t0.0077\n鬼\t0.0077\n软\t0.0077\n典\t0.0077\n欲\t0.0076\n萨\t0.0076\n伙\t0.0076\n遭\t0.0076\n盘\t0.0076\n爸\t0.0076\n扩\t0.0076\n盖\t0.0076\n弄\t0.0076\n雄\t0.0076\n稳\t0.0076\n忘\t0.0076\n亿\t0.0076\n刺\t0.0076\n拥\t0.0075\n徒\t0.0075\n姆\t0.0075\n杨\t0.0075\n齐\t0.0075\n赛\t0.0075\n趣\t0.0075\n曲\t0.0075\n刀\t0.0075\n床\t0.0075\n迎\t0.0075\n冰\t0.0075\n虚\t0.0075\n玩\t0.0075\n析\t0.0074\n窗\t0.0074\n醒\t0.0074\n妻\t0.0074\n透\t0.0074\n购\t0.0074\n替\t0.0074\n塞\t0.0074\n努\t0.0074\n休\t0.0074\n虎\t0.0074\n扬\t0.0074\n途\t0.0074\n侵\t0.0074\n刑\t0.0074\n绿\t0.0073\n兄\t0.0073\n迅\t0.0073\n套\t0.0073\n贸\t0.0073\n毕\t0.0073\n唯\t0.0073\n谷\t0.0073\n轮\t0.0073\n库\t0.0073\n迹\t0.0073\n尤\t0.0073\n竞\t0.0073\n街\t0.0073\n促\t0.0073\n延\t0.0072\n震\t0.0072\n弃\t0.0072\n甲\t0.0072\n伟\t0.0072\n麻\t0.0072\n川\t0.0072\n申\t0.0072\n缓\t0.0072\n潜\t0.0072\n闪\t0.0072\n售\t0.0072\n灯\t0.0072\n针\t0.0072\n哲\t0.0072\n络\t0.0071\n抵\t0.0071\n朱\t0.0071\n埃\t0.0071\n抱\t0.0071\n鼓\t0.0071\n植\t0.0071\n纯\t0.0071\n夏\t0.0071\n忍\t0.0071\n页\t0.0071\n杰\t0.0071\n筑\t0.0071\n折\t0.0071\n郑\t0.0071\n贝\t0.0071\n尊\t0.0070\n吴\t0.0070\n秀\t0.0070\n混\t0.0070\n臣\t0.0070\n雅\t0.0070\n振\t0.0070\n染\t0.0070\n盛\t0.0070\n怒\t0.0070\n舞\t0.0070\n圆\t0.0070\n搞\t0.0070\n狂\t0.0070\n措\t0.0070\n姓\t0.0070\n残\t0.0070\n秋\t0.0069\n培\t0.0069\n迷\t0.0069\n诚\t0.0069\n宽\t0.0069\n宇\t0.0069\n猛\t0.0069\n摆\t0.0069\n梅\t0.0069\n毁\t0.0069\n伸\t0.0069\n摩\t0.0069\n盟\t0.0069\n末\t0.0069\n乃\t0.0069\n悲\t0.0069\n拍\t0.0068\n丁\t0.0068\n赵\t0.0068\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
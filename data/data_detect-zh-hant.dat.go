@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("detect-zh-hant.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Frequencies of the characters of Chinese in traditional\n# characters, in per cent of all characters. The frequencies\n# of the hanzi are estimated from their rank.\n*\t0.006\n，\t3.00\n。\t2.00\n、\t0.50\n「\t0.40\n」\t0.40\n：\t0.20\n；\t0.05\n？\t0.10\n！\t0.05\n（\t0.10\n）\t0.10\n《\t0.05\n》\t0.05\n\u3000\t0.10\n的\t4.0000\n一\t2.6667\n是\t2.0000\n不\t1.6000\n了\t1.3333\n在\t1.1429\n人\t1.0000\n有\t0.8889\n我\t0.8000\n他\t0.7273\n這\t0.6667\n個\t0.6154\n們\t0.5714\n中\t0.5333\n來\t0.5000\n上\t0.4706\n大\t0.4444\n為\t0.4211\n和\t0.4000\n國\t0.3810\n地\t0.3636\n到\t0.3478\n以\t0.3333\n說\t0.3200\n時\t0.3077\n要\t0.2963\n就\t0.2857\n出\t0.2759\n會\t0.2667\n可\t0.2581\n也\t0.2500\n你\t0.2424\n對\t0.2353\n生\t0.2286\n能\t0.2222\n而\t0.2162\n子\t0.2105\n那\t0.2051\n得\t0.2000\n於\t0.1951\n著\t0.1905\n下\t0.1860\n自\t0.1818\n之\t0.1778\n年\t0.1739\n過\t0.1702\n發\t0.1667\n後\t0.1633\n作\t0.1600\n裡\t0.1569\n用\t0.1538\n道\t0.1509\n行\t0.1481\n所\t0.1455\n然\t0.1429\n家\t0.1404\n種\t0.1379\n事\t0.1356\n成\t0.1333\n方\t0.1311\n多\t0.1290\n經\t0.1270\n麼\t0.1250\n去\t0.1231\n法\t0.1212\n學\t0.1194\n如\t0.1176\n都\t0.1159\n同\t0.1143\n現\t0.1127\n當\t0.1111\n沒\t0.1096\n動\t0.1081\n面\t0.1067\n起\t0.1053\n看\t0.1039\n定\t0.1026\n天\t0.1013\n分\t0.1000\n還\t0.0988\n進\t0.0976\n好\t0.0964\n小\t0.0952\n部\t0.0941\n其\t0.0930\n些\t0.0920\n主\t0.0909\n樣\t0.0899\n理\t0.0889\n心\t0.0879\n她\t0.0870\n本\t0.0860\n前\t0.0851\n開\t0.0842\n但\t0.0833\n因\t0.0825\n只\t0.0816\n從\t0.0808\n想\t0.0800\n實\t0.0792\n日\t0.0784\n軍\t0.0777\n者\t0.0769\n意\t0.0762\n無\t0.0755\n力\t0.0748\n它\t0.0741\n與\t0.0734\n長\t0.0727\n把\t0.0721\n機\t0.0714\n十\t0.0708\n民\t0.0702\n第\t0.0696\n公\t0.0690\n此\t0.0684\n已\t0.0678\n工\t0.0672\n使\t0.0667\n情\t0.0661\n明\t0.0656\n性\t0.0650\n知\t0.0645\n全\t0.0640\n三\t0.0635\n又\t0.0630\n關\t0.0625\n點\t0.0620\n正\t0.0615\n業\t0.0611\n外\t0.0606\n將\t0.0602\n兩\t0.0597\n高\t0.0593\n間\t0.0588\n由\t0.0584\n問\t0.0580\n很\t0.0576\n最\t0.0571\n重\t0.0567\n並\t0.0563\n物\t0.0559\n手\t0.0556\n應\t0.0552\n戰\t0.0548\n向\t0.0544\n頭\t0.0541\n文\t0.0537\n體\t0.0533\n政\t0.0530\n美\t0.0526\n相\t0.0523\n見\t0.0519\n被\t0.0516\n利\t0.0513\n什\t0.0510\n二\t0.0506\n等\t0.0503\n產\t0.0500\n或\t0.0497\n新\t0.0494\n己\t0.0491\n制\t0.0488\n身\t0.0485\n果\t0.0482\n加\t0.0479\n西\t0.0476\n斯\t0.0473\n月\t0.0471\n話\t0.0468\n合\t0.0465\n回\t0.0462\n特\t0.0460\n代\t0.0457\n内\t0.0455\n信\t0.0452\n表\t0.0449\n化\t0.0447\n老\t0.0444\n給\t0.0442\n世\t0.0440\n位\t0.0437\n次\t0.0435\n度\t0.0432\n門\t0.0430\n任\t0.0428\n常\t0.0426\n先\t0.0423\n海\t0.0421\n通\t0.0419\n教\t0.0417\n兒\t0.0415\n原\t0.0412\n東\t0.0410\n聲\t0.0408\n提\t0.0406\n立\t0.0404\n及\t0.0402\n比\t0.0400\n員\t0.0398\n解\t0.0396\n水\t0.0394\n名\t0.0392\n真\t0.0390\n論\t0.0388\n處\t0.0386\n走\t0.0385\n義\t0.0383\n各\t0.0381\n入\t0.0379\n幾\t0.0377\n口\t0.0376\n認\t0.0374\n條\t0.0372\n平\t0.0370\n系\t0.0369\n氣\t0.0367\n題\t0.0365\n活\t0.0364\n爾\t0.0362\n更\t0.0360\n別\t0.0359\n打\t0.0357\n女\t0.0356\n變\t0.0354\n四\t0.0352\n神\t0.0351\n總\t0.0349\n何\t0.0348\n電\t0.0346\n數\t0.0345\n安\t0.0343\n少\t0.0342\n報\t0.0340\n才\t0.0339\n結\t0.0338\n反\t0.0336\n受\t0.0335\n目\t0.0333\n太\t0.0332\n量\t0.0331\n再\t0.0329\n感\t0.0328\n建\t0.0327\n務\t0.0325\n做\t0.0324\n接\t0.0323\n必\t0.0321\n場\t0.0320\n件\t0.0319\n計\t0.0317\n管\t0.0316\n期\t0.0315\n市\t0.0314\n直\t0.0312\n德\t0.0311\n資\t0.0310\n命\t0.0309\n山\t0.0308\n金\t0.0307\n指\t0.0305\n克\t0.0304\n许\t0.0303\n统\t0.0302\n區\t0.0301\n保\t0.0300\n至\t0.0299\n隊\t0.0297\n形\t0.0296\n社\t0.0295\n便\t0.0294\n空\t0.0293\n決\t0.0292\n治\t0.0291\n展\t0.0290\n馬\t0.0289\n科\t0.0288\n司\t0.0287\n五\t0.0286\n基\t0.0285\n眼\t0.0284\n書\t0.0283\n非\t0.0282\n則\t0.0281\n聽\t0.0280\n白\t0.0279\n卻\t0.0278\n界\t0.0277\n達\t0.0276\n光\t0.0275\n放\t0.0274\n強\t0.0273\n即\t0.0272\n像\t0.0271\n難\t0.0270\n且\t0.0269\n權\t0.0268\n思\t0.0268\n王\t0.0267\n象\t0.0266\n完\t0.0265\n設\t0.0264\n式\t0.0263\n色\t0.0262\n路\t0.0261\n記\t0.0261\n南\t0.0260\n品\t0.0259\n住\t0.0258\n告\t0.0257\n類\t0.0256\n求\t0.0256\n據\t0.0255\n程\t0.0254\n北\t0.0253\n邊\t0.0252\n死\t0.0252\n張\t0.0251\n該\t0.0250\n交\t0.0249\n規\t0.0248\n萬\t0.0248\n取\t0.0247\n拉\t0.0246\n格\t0.0245\n望\t0.0245\n覺\t0.0244\n術\t0.0243\n領\t0.0242\n共\t0.0242\n確\t0.0241\n傳\t0.0240\n師\t0.0240\n觀\t0.0239\n清\t0.0238\n今\t0.0237\n切\t0.0237\n院\t0.0236\n讓\t0.0235\n識\t0.0235\n候\t0.0234\n帶\t0.0233\n導\t0.0233\n爭\t0.0232\n運\t0.0231\n笑\t0.0231\n飛\t0.0230\n風\t0.0229\n步\t0.0229\n改\t0.0228\n收\t0.0227\n根\t0.0227\n干\t0.0226\n造\t0.0225\n言\t0.0225\n聯\t0.0224\n持\t0.0223\n組\t0.0223\n每\t0.0222\n濟\t0.0222\n車\t0.0221\n親\t0.0220\n極\t0.0220\n林\t0.0219\n服\t0.0219\n快\t0.0218\n辦\t0.0217\n議\t0.0217\n往\t0.0216\n元\t0.0216\n英\t0.0215\n士\t0.0214\n證\t0.0214\n近\t0.0213\n失\t0.0213\n轉\t0.0212\n夫\t0.0212\n令\t0.0211\n準\t0.0211\n布\t0.0210\n始\t0.0209\n怎\t0.0209\n呢\t0.0208\n存\t0.0208\n未\t0.0207\n遠\t0.0207\n叫\t0.0206\n臺\t0.0206\n單\t0.0205\n影\t0.0205\n具\t0.0204\n羅\t0.0204\n字\t0.0203\n愛\t0.0203\n擊\t0.0202\n流\t0.0202\n備\t0.0201\n兵\t0.0201\n連\t0.0200\n調\t0.0200\n深\t0.0199\n商\t0.0199\n算\t0.0198\n質\t0.0198\n團\t0.0197\n集\t0.0197\n百\t0.0196\n需\t0.0196\n價\t0.0195\n花\t0.0195\n黨\t0.0194\n華\t0.0194\n城\t0.0193\n石\t0.0193\n級\t0.0192\n整\t0.0192\n府\t0.0191\n離\t0.0191\n況\t0.0190\n亞\t0.0190\n請\t0.0190\n技\t0.0189\n際\t0.0189\n約\t0.0188\n示\t0.0188\n復\t0.0187\n病\t0.0187\n息\t0.0186\n究\t0.0186\n線\t0.0186\n似\t0.0185\n官\t0.0185\n火\t0.0184\n斷\t0.0184\n精\t0.0183\n滿\t0.0183\n支\t0.0183\n視\t0.0182\n消\t0.0182\n越\t0.0181\n器\t0.0181\n容\t0.0181\n照\t0.0180\n須\t0.0180\n九\t0.0179\n增\t0.0179\n研\t0.0179\n寫\t0.0178\n稱\t0.0178\n企\t0.0177\n八\t0.0177\n功\t0.0177\n嗎\t0.0176\n包\t0.0176\n片\t0.0175\n史\t0.0175\n委\t0.0175\n乎\t0.0174\n查\t0.0174\n輕\t0.0174\n易\t0.0173\n早\t0.0173\n曾\t0.0172\n除\t0.0172\n農\t0.0172\n找\t0.0171\n裝\t0.0171\n廣\t0.0171\n顯\t0.0170\n吧\t0.0170\n阿\t0.0169\n李\t0.0169\n標\t0.0169\n談\t0.0168\n吃\t0.0168\n圖\t0.0168\n念\t0.0167\n六\t0.0167\n引\t0.0167\n歷\t0.0166\n首\t0.0166\n醫\t0.0166\n局\t0.0165\n突\t0.0165\n專\t0.0165\n費\t0.0164\n號\t0.0164\n盡\t0.0164\n另\t0.0163\n周\t0.0163\n較\t0.0163\n注\t0.0162\n語\t0.0162\n僅\t0.0162\n考\t0.0161\n落\t0.0161\n青\t0.0161\n隨\t0.0160\n選\t0.0160\n列\t0.0160\n武\t0.0159\n紅\t0.0159\n響\t0.0159\n雖\t0.0158\n推\t0.0158\n勢\t0.0158\n參\t0.0157\n希\t0.0157\n古\t0.0157\n眾\t0.0157\n構\t0.0156\n房\t0.0156\n半\t0.0156\n節\t0.0155\n土\t0.0155\n投\t0.0155\n某\t0.0154\n案\t0.0154\n黑\t0.0154\n維\t0.0154\n革\t0.0153\n劃\t0.0153\n敵\t0.0153\n致\t0.0152\n陳\t0.0152\n律\t0.0152\n足\t0.0152\n態\t0.0151\n護\t0.0151\n七\t0.0151\n興\t0.0150\n派\t0.0150\n孩\t0.0150\n驗\t0.0150\n責\t0.0149\n營\t0.0149\n星\t0.0149\n夠\t0.0148\n章\t0.0148\n音\t0.0148\n跟\t0.0148\n志\t0.0147\n底\t0.0147\n站\t0.0147\n嚴\t0.0147\n巴\t0.0146\n例\t0.0146\n防\t0.0146\n族\t0.0145\n供\t0.0145\n效\t0.0145\n續\t0.0145\n施\t0.0144\n留\t0.0144\n講\t0.0144\n型\t0.0144\n料\t0.0143\n終\t0.0143\n答\t0.0143\n緊\t0.0143\n黃\t0.0142\n絕\t0.0142\n奇\t0.0142\n察\t0.0142\n母\t0.0141\n京\t0.0141\n段\t0.0141\n依\t0.0141\n批\t0.0140\n群\t0.0140\n項\t0.0140\n故\t0.0140\n按\t0.0139\n河\t0.0139\n米\t0.0139\n圍\t0.0139\n江\t0.0138\n織\t0.0138\n害\t0.0138\n鬥\t0.0138\n雙\t0.0137\n境\t0.0137\n客\t0.0137\n紀\t0.0137\n採\t0.0137\n舉\t0.0136\n殺\t0.0136\n攻\t0.0136\n父\t0.0136\n蘇\t0.0135\n密\t0.0135\n低\t0.0135\n朝\t0.0135\n友\t0.0134\n訴\t0.0134\n止\t0.0134\n細\t0.0134\n願\t0.0134\n千\t0.0133\n值\t0.0133\n仍\t0.0133\n男\t0.0133\n錢\t0.0132\n破\t0.0132\n網\t0.0132\n熱\t0.0132\n助\t0.0132\n倒\t0.0131\n育\t0.0131\n屬\t0.0131\n坐\t0.0131\n帝\t0.0131\n限\t0.0130\n船\t0.0130\n臉\t0.0130\n職\t0.0130\n速\t0.0129\n刻\t0.0129\n樂\t0.0129\n否\t0.0129\n剛\t0.0129\n威\t0.0128\n毛\t0.0128\n狀\t0.0128\n率\t0.0128\n甚\t0.0128\n獨\t0.0127\n球\t0.0127\n般\t0.0127\n普\t0.0127\n怕\t0.0127\n彈\t0.0126\n校\t0.0126\n苦\t0.0126\n創\t0.0126\n假\t0.0126\n久\t0.0125\n錯\t0.0125\n承\t0.0125\n印\t0.0125\n晚\t0.0125\n蘭\t0.0124\n試\t0.0124\n股\t0.0124\n拿\t0.0124\n腦\t0.0124\n預\t0.0123\n誰\t0.0123\n益\t0.0123\n陽\t0.0123\n若\t0.0123\n哪\t0.0123\n微\t0.0122\n尼\t0.0122\n繼\t0.0122\n送\t0.0122\n急\t0.0122\n血\t0.0121\n驚\t0.0121\n傷\t0.0121\n素\t0.0121\n藥\t0.0121\n適\t0.0120\n波\t0.0120\n夜\t0.0120\n省\t0.0120\n初\t0.0120\n喜\t0.0120\n衛\t0.0119\n源\t0.0119\n食\t0.0119\n險\t0.0119\n待\t0.0119\n述\t0.0119\n陸\t0.0118\n習\t0.0118\n置\t0.0118\n居\t0.0118\n勞\t0.0118\n財\t0.0117\n環\t0.0117\n排\t0.0117\n福\t0.0117\n納\t0.0117\n歡\t0.0117\n雷\t0.0116\n警\t0.0116\n獲\t0.0116\n模\t0.0116\n充\t0.0116\n負\t0.0116\n雲\t0.0115\n停\t0.0115\n木\t0.0115\n遊\t0.0115\n龍\t0.0115\n樹\t0.0115\n疑\t0.0114\n層\t0.0114\n冷\t0.0114\n洲\t0.0114\n衝\t0.0114\n射\t0.0114\n略\t0.0113\n範\t0.0113\n竟\t0.0113\n句\t0.0113\n室\t0.0113\n異\t0.0113\n激\t0.0113\n漢\t0.0112\n村\t0.0112\n哈\t0.0112\n策\t0.0112\n演\t0.0112\n簡\t0.0112\n卡\t0.0111\n罪\t0.0111\n判\t0.0111\n擔\t0.0111\n州\t0.0111\n靜\t0.0111\n退\t0.0110\n既\t0.0110\n衣\t0.0110\n您\t0.0110\n宗\t0.0110\n積\t0.0110\n余\t0.0110\n痛\t0.0109\n檢\t0.0109\n差\t0.0109\n富\t0.0109\n靈\t0.0109\n協\t0.0109\n角\t0.0109\n占\t0.0108\n配\t0.0108\n征\t0.0108\n修\t0.0108\n皮\t0.0108\n揮\t0.0108\n勝\t0.0108\n降\t0.0107\n階\t0.0107\n審\t0.0107\n沉\t0.0107\n堅\t0.0107\n善\t0.0107\n媽\t0.0107\n劉\t0.0106\n讀\t0.0106\n啊\t0.0106\n超\t0.0106\n免\t0.0106\n壓\t0.0106\n銀\t0.0106\n買\t0.0105\n皇\t0.0105\n養\t0.0105\n伊\t0.0105\n懷\t0.0105\n執\t0.0105\n副\t0.0105\n亂\t0.0104\n抗\t0.0104\n犯\t0.0104\n追\t0.0104\n幫\t0.0104\n宣\t0.0104\n佛\t0.0104\n歲\t0.0103\n航\t0.0103\n優\t0.0103\n怪\t0.0103\n香\t0.0103\n田\t0.0103\n鐵\t0.0103\n控\t0.0102\n稅\t0.0102\n左\t0.0102\n右\t0.0102\n份\t0.0102\n穿\t0.0102\n藝\t0.0102\n背\t0.0102\n陣\t0.0101\n草\t0.0101\n腳\t0.0101\n概\t0.0101\n惡\t0.0101\n塊\t0.0101\n頓\t0.0101\n敢\t0.0101\n守\t0.0100\n酒\t0.0100\n島\t0.0100\n托\t0.0100\n央\t0.0100\n戶\t0.0100\n烈\t0.0100\n洋\t0.0100\n哥\t0.0099\n索\t0.0099\n胡\t0.0099\n款\t0.0099\n靠\t0.0099\n評\t0.0099\n版\t0.0099\n寶\t0.0099\n座\t0.0098\n釋\t0.0098\n景\t0.0098\n顧\t0.0098\n弟\t0.0098\n登\t0.0098\n貨\t0.0098\n互\t0.0098\n付\t0.0097\n伯\t0.0097\n慢\t0.0097\n歐\t0.0097\n換\t0.0097\n聞\t0.0097\n危\t0.0097\n忙\t0.0097\n核\t0.0097\n暗\t0.0096\n姐\t0.0096\n介\t0.0096\n壞\t0.0096\n討\t0.0096\n麗\t0.0096\n良\t0.0096\n序\t0.0096\n升\t0.0095\n監\t0.0095\n臨\t0.0095\n亮\t0.0095\n露\t0.0095\n永\t0.0095\n呼\t0.0095\n味\t0.0095\n野\t0.0095\n架\t0.0094\n域\t0.0094\n沙\t0.0094\n掉\t0.0094\n括\t0.0094\n艦\t0.0094\n魚\t0.0094\n雜\t0.0094\n誤\t0.0094\n灣\t0.0093\n吉\t0.0093\n減\t0.0093\n編\t0.0093\n楚\t0.0093\n肯\t0.0093\n測\t0.0093\n敗\t0.0093\n屋\t0.0093\n跑\t0.0092\n夢\t0.0092\n散\t0.0092\n溫\t0.0092\n困\t0.0092\n劍\t0.0092\n漸\t0.0092\n封\t0.0092\n救\t0.0092\n貴\t0.0092\n槍\t0.0091\n缺\t0.0091\n樓\t0.0091\n縣\t0.0091\n尚\t0.0091\n毫\t0.0091\n移\t0.0091\n娘\t0.0091\n朋\t0.0091\n畫\t0.0090\n班\t0.0090\n智\t0.0090\n亦\t0.0090\n耳\t0.0090\n恩\t0.0090\n短\t0.0090\n掌\t0.0090\n恐\t0.0090\n遺\t0.0090\n固\t0.0089\n席\t0.0089\n松\t0.0089\n秘\t0.0089\n謝\t0.0089\n魯\t0.0089\n遇\t0.0089\n康\t0.0089\n慮\t0.0089\n幸\t0.0089\n均\t0.0088\n銷\t0.0088\n鐘\t0.0088\n詩\t0.0088\n藏\t0.0088\n趕\t0.0088\n劇\t0.0088\n票\t0.0088\n損\t0.0088\n忽\t0.0088\n巨\t0.0088\n炮\t0.0087\n舊\t0.0087\n端\t0.0087\n探\t0.0087\n湖\t0.0087\n錄\t0.0087\n葉\t0.0087\n春\t0.0087\n鄉\t0.0087\n附\t0.0087\n吸\t0.0086\n予\t0.0086\n禮\t0.0086\n港\t0.0086\n雨\t0.0086\n呀\t0.0086\n板\t0.0086\n庭\t0.0086\n婦\t0.0086\n歸\t0.0086\n睛\t0.0086\n飯\t0.0085\n額\t0.0085\n含\t0.0085\n順\t0.0085\n輸\t0.0085\n搖\t0.0085\n招\t0.0085\n婚\t0.0085\n脫\t0.0085\n補\t0.0085\n謂\t0.0085\n督\t0.0084\n毒\t0.0084\n油\t0.0084\n療\t0.0084\n旅\t0.0084\n澤\t0.0084\n材\t0.0084\n滅\t0.0084\n逐\t0.0084\n莫\t0.0084\n筆\t0.0084\n亡\t0.0084\n鮮\t0.0083\n詞\t0.0083\n聖\t0.0083\n擇\t0.0083\n尋\t0.0083\n廠\t0.0083\n睡\t0.0083\n博\t0.0083\n勒\t0.0083\n煙\t0.0083\n授\t0.0083\n諾\t0.0082\n倫\t0.0082\n岸\t0.0082\n奧\t0.0082\n唐\t0.0082\n賣\t0.0082\n俄\t0.0082\n炸\t0.0082\n載\t0.0082\n洛\t0.0082\n健\t0.0082\n堂\t0.0082\n旁\t0.0081\n宮\t0.0081\n喝\t0.0081\n借\t0.0081\n君\t0.0081\n禁\t0.0081\n陰\t0.0081\n園\t0.0081\n謀\t0.0081\n宋\t0.0081\n避\t0.0081\n抓\t0.0081\n榮\t0.0080\n姑\t0.0080\n孫\t0.0080\n逃\t0.0080\n牙\t0.0080\n束\t0.0080\n跳\t0.0080\n頂\t0.0080\n玉\t0.0080\n鎮\t0.0080\n雪\t0.0080\n午\t0.0080\n練\t0.0080\n迫\t0.0079\n爺\t0.0079\n篇\t0.0079\n肉\t0.0079\n嘴\t0.0079\n館\t0.0079\n遍\t0.0079\n凡\t0.0079\n礎\t0.0079\n洞\t0.0079\n卷\t0.0079\n坦\t0.0079\n牛\t0.0079\n寧\t0.0078\n紙\t0.0078\n諸\t0.0078\n訓\t0.0078\n私\t0.0078\n莊\t0.0078\n祖\t0.0078\n絲\t0.0078\n翻\t0.0078\n暴\t0.0078\n森\t0.0078\n塔\t0.0078\n默\t0.0078\n握\t0.0077\n戲\t0.0077\n隱\t0.0077\n熟\t0.0077\n骨\t0.0077\n訪\t0.0077\n弱\t0.0077\n蒙\t0.0077\n歌\t0.0077\n店\t0.0077\n鬼\t0.0077\n軟\t0.0077\n典\t0.0077\n欲\t0.0076\n薩\t0.0076\n伙\t0.0076\n遭\t0.0076\n盤\t0.0076\n爸\t0.0076\n擴\t0.0076\n蓋\t0.0076\n弄\t0.0076\n雄\t0.0076\n穩\t0.0076\n忘\t0.0076\n億\t0.0076\n刺\t0.0076\n擁\t0.0075\n徒\t0.0075\n姆\t0.0075\n楊\t0.0075\n齊\t0.0075\n賽\t0.0075\n趣\t0.0075\n曲\t0.0075\n刀\t0.0075\n床\t0.0075\n迎\t0.0075\n冰\t0.0075\n虛\t0.0075\n玩\t0.0075\n析\t0.0074\n窗\t0.0074\n醒\t0.0074\n妻\t0.0074\n透\t0.0074\n購\t0.0074\n替\t0.0074\n塞\t0.0074\n努\t0.0074\n休\t0.0074\n虎\t0.0074\n揚\t0.0074\n途\t0.0074\n侵\t0.0074\n刑\t0.0074\n綠\t0.0073\n兄\t0.0073\n迅\t0.0073\n套\t0.0073\n貿\t0.0073\n畢\t0.0073\n唯\t0.0073\n谷\t0.0073\n輪\t0.0073\n庫\t0.0073\n跡\t0.0073\n尤\t0.0073\n競\t0.0073\n街\t0.0073\n促\t0.0073\n延\t0.0072\n震\t0.0072\n棄\t0.0072\n甲\t0.0072\n偉\t0.0072\n麻\t0.0072\n川\t0.0072\n申\t0.0072\n緩\t0.0072\n潛\t0.0072\n閃\t0.0072\n售\t0.0072\n燈\t0.0072\n針\t0.0072\n哲\t0.0072\n絡\t0.0071\n抵\t0.0071\n朱\t0.0071\n埃\t0.0071\n抱\t0.0071\n鼓\t0.0071\n植\t0.0071\n純\t0.0071\n夏\t0.0071\n忍\t0.0071\n頁\t0.0071\n傑\t0.0071\n築\t0.0071\n折\t0.0071\n鄭\t0.0071\n貝\t0.0071\n尊\t0.0070\n吳\t0.0070\n秀\t0.0070\n混\t0.0070\n臣\t0.0070\n雅\t0.0070\n振\t0.0070\n染\t0.0070\n盛\t0.0070\n怒\t0.0070\n舞\t0.0070\n圓\t0.0070\n搞\t0.0070\n狂\t0.0070\n措\t0.0070\n姓\t0.0070\n殘\t0.0070\n秋\t0.0069\n培\t0.0069\n迷\t0.0069\n誠\t0.0069\n寬\t0.0069\n宇\t0.0069\n猛\t0.0069\n擺\t0.0069\n梅\t0.0069\n毀\t0.0069\n伸\t0.0069\n摩\t0.0069\n盟\t0.0069\n末\t0.0069\n乃\t0.0069\n悲\t0.0069\n拍\t0.0068\n丁\t0.0068\n趙\t0.0068\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
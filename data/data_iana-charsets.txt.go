@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("iana-charsets.txt", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# The IANA character sets registry\n# (https://www.iana.org/assignments/character-sets),\n# generated by data/generate-iana.go.\n# MIBenum<TAB>name<TAB>preferred MIME name, or \"-\"<TAB>aliases\n3\tUS-ASCII\tUS-ASCII\tiso-ir-6 ANSI_X3.4-1968 ANSI_X3.4-1986 ISO_646.irv:1991 ISO646-US us IBM367 cp367 csASCII\n4\tISO_8859-1:1987\tISO-8859-1\tiso-ir-100 ISO_8859-1 ISO-8859-1 latin1 l1 IBM819 CP819 csISOLatin1\n5\tISO_8859-2:1987\tISO-8859-2\tiso-ir-101 ISO_8859-2 ISO-8859-2 latin2 l2 csISOLatin2\n6\tISO_8859-3:1988\tISO-8859-3\tiso-ir-109 ISO_8859-3 ISO-8859-3 latin3 l3 csISOLatin3\n7\tISO_8859-4:1988\tISO-8859-4\tiso-ir-110 ISO_8859-4 ISO-8859-4 latin4 l4 csISOLatin4\n8\tISO_8859-5:1988\tISO-8859-5\tiso-ir-144 ISO_8859-5 ISO-8859-5 cyrillic csISOLatinCyrillic\n9\tISO_8859-6:1987\tISO-8859-6\tiso-ir-127 ISO_8859-6 ISO-8859-6 ECMA-114 ASMO-708 arabic csISOLatinArabic\n10\tISO_8859-7:1987\tISO-8859-7\tiso-ir-126 ISO_8859-7 ISO-8859-7 ELOT_928 ECMA-118 greek greek8 csISOLatinGreek\n11\tISO_8859-8:1988\tISO-8859-8\tiso-ir-138 ISO_8859-8 ISO-8859-8 hebrew csISOLatinHebrew\n12\tISO_8859-9:1989\tISO-8859-9\tiso-ir-148 ISO_8859-9 ISO-8859-9 latin5 l5 csISOLatin5\n13\tISO-8859-10\tISO-8859-10\tiso-ir-157 l6 ISO_8859-10:1992 csISOLatin6 latin6\n14\tISO_6937-2-add\t-\tiso-ir-142 csISOTextComm\n15\tJIS_X0201\t-\tX0201 csHalfWidthKatakana\n16\tJIS_Encoding\t-\tcsJISEncoding\n17\tShift_JIS\tShift_JIS\tMS_Kanji csShiftJIS\n18\tExtended_UNIX_Code_Packed_Format_for_Japanese\tEUC-JP\tcsEUCPkdFmtJapanese EUC-JP\n19\tExtended_UNIX_Code_Fixed_Width_for_Japanese\t-\tcsEUCFixWidJapanese\n20\tBS_4730\t-\tiso-ir-4 ISO646-GB gb uk csISO4UnitedKingdom\n21\tSEN_850200_C\t-\tiso-ir-11 ISO646-SE2 se2 csISO11SwedishForNames\n22\tIT\t-\tiso-ir-15 ISO646-IT csISO15Italian\n23\tES\t-\tiso-ir-17 ISO646-ES csISO17Spanish\n24\tDIN_66003\t-\tiso-ir-21 de ISO646-DE csISO21German\n25\tNS_4551-1\t-\tiso-ir-60 ISO646-NO no csISO60DanishNorwegian csISO60Norwegian1\n26\tNF_Z_62-010\t-\tiso-ir-69 ISO646-FR fr csISO69French\n27\tISO-10646-UTF-1\t-\tcsISO10646UTF1\n28\tISO_646.basic:1983\t-\tref csISO646basic1983\n29\tINVARIANT\t-\tcsINVARIANT\n30\tISO_646.irv:1983\t-\tiso-ir-2 irv csISO2IntlRefVersion\n31\tNATS-SEFI\t-\tiso-ir-8-1 csNATSSEFI\n32\tNATS-SEFI-ADD\t-\tiso-ir-8-2 csNATSSEFIADD\n33\tNATS-DANO\t-\tiso-ir-9-1 csNATSDANO\n34\tNATS-DANO-ADD\t-\tiso-ir-9-2 csNATSDANOADD\n35\tSEN_850200_B\t-\tiso-ir-10 FI ISO646-FI ISO646-SE se csISO10Swedish\n36\tKS_C_5601-1987\t-\tiso-ir-149 KS_C_5601-1989 KSC_5601 korean csKSC56011987\n37\tISO-2022-KR\tISO-2022-KR\tcsISO2022KR\n38\tEUC-KR\tEUC-KR\tcsEUCKR\n39\tISO-2022-JP\tISO-2022-JP\tcsISO2022JP\n40\tISO-2022-JP-2\tISO-2022-JP-2\tcsISO2022JP2\n41\tJIS_C6220-1969-jp\t-\tJIS_C6220-1969 iso-ir-13 katakana x0201-7 csISO13JISC6220jp\n42\tJIS_C6220-1969-ro\t-\tiso-ir-14 jp ISO646-JP csISO14JISC6220ro\n43\tPT\t-\tiso-ir-16 ISO646-PT csISO16Portuguese\n44\tgreek7-old\t-\tiso-ir-18 csISO18Greek7Old\n45\tlatin-greek\t-\tiso-ir-19 csISO19LatinGreek\n46\tNF_Z_62-010_(1973)\t-\tiso-ir-25 ISO646-FR1 csISO25French\n47\tLatin-greek-1\t-\tiso-ir-27 csISO27LatinGreek1\n48\tISO_5427\t-\tiso-ir-37 csISO5427Cyrillic\n49\tJIS_C6226-1978\t-\tiso-ir-42 csISO42JISC62261978\n50\tBS_viewdata\t-\tiso-ir-47 csISO47BSViewdata\n51\tINIS\t-\tiso-ir-49 csISO49INIS\n52\tINIS-8\t-\tiso-ir-50 csISO50INIS8\n53\tINIS-cyrillic\t-\tiso-ir-51 csISO51INISCyrillic\n54\tISO_5427:1981\t-\tiso-ir-54 ISO5427Cyrillic1981 csISO54271981\n55\tISO_5428:1980\t-\tiso-ir-55 csISO5428Greek\n56\tGB_1988-80\t-\tiso-ir-57 cn ISO646-CN csISO57GB1988\n57\tGB_2312-80\t-\tiso-ir-58 chinese csISO58GB231280\n58\tNS_4551-2\t-\tISO646-NO2 iso-ir-61 no2 csISO61Norwegian2\n59\tvideotex-suppl\t-\tiso-ir-70 csISO70VideotexSupp1\n60\tPT2\t-\tiso-ir-84 ISO646-PT2 csISO84Portuguese2\n61\tES2\t-\tiso-ir-85 ISO646-ES2 csISO85Spanish2\n62\tMSZ_7795.3\t-\tiso-ir-86 ISO646-HU hu csISO86Hungarian\n63\tJIS_C6226-1983\t-\tiso-ir-87 x0208 JIS_X0208-1983 csISO87JISX0208\n64\tgreek7\t-\tiso-ir-88 csISO88Greek7\n65\tASMO_449\t-\tISO_9036 arabic7 iso-ir-89 csISO89ASMO449\n66\tiso-ir-90\t-\tcsISO90\n67\tJIS_C6229-1984-a\t-\tiso-ir-91 jp-ocr-a csISO91JISC62291984a\n68\tJIS_C6229-1984-b\t-\tiso-ir-92 ISO646-JP-OCR-B jp-ocr-b csISO92JISC62991984b\n69\tJIS_C6229-1984-b-add\t-\tiso-ir-93 jp-ocr-b-add csISO93JIS62291984badd\n70\tJIS_C6229-1984-hand\t-\tiso-ir-94 jp-ocr-hand csISO94JIS62291984hand\n71\tJIS_C6229-1984-hand-add\t-\tiso-ir-95 jp-ocr-hand-add csISO95JIS62291984handadd\n72\tJIS_C6229-1984-kana\t-\tiso-ir-96 csISO96JISC62291984kana\n73\tISO_2033-1983\t-\tiso-ir-98 e13b csISO2033\n74\tANSI_X3.110-1983\t-\tiso-ir-99 CSA_T500-1983 NAPLPS csISO99NAPLPS\n75\tT.61-7bit\t-\tiso-ir-102 csISO102T617bit\n76\tT.61-8bit\t-\tT.61 iso-ir-103 csISO103T618bit\n77\tECMA-cyrillic\t-\tiso-ir-111 KOI8-E csISO111ECMACyrillic\n78\tCSA_Z243.4-1985-1\t-\tiso-ir-121 ISO646-CA csa7-1 csa71 ca csISO121Canadian1\n79\tCSA_Z243.4-1985-2\t-\tiso-ir-122 ISO646-CA2 csa7-2 csa72 csISO122Canadian2\n80\tCSA_Z243.4-1985-gr\t-\tiso-ir-123 csISO123CSAZ24341985gr\n81\tISO_8859-6-E\tISO-8859-6-E\tcsISO88596E ISO-8859-6-E\n82\tISO_8859-6-I\tISO-8859-6-I\tcsISO88596I ISO-8859-6-I\n83\tT.101-G2\t-\tiso-ir-128 csISO128T101G2\n84\tISO_8859-8-E\tISO-8859-8-E\tcsISO88598E ISO-8859-8-E\n85\tISO_8859-8-I\tISO-8859-8-I\tcsISO88598I ISO-8859-8-I\n86\tCSN_369103\t-\tiso-ir-139 csISO139CSN369103\n87\tJUS_I.B1.002\t-\tiso-ir-141 ISO646-YU js yu csISO141JUSIB1002\n88\tIEC_P27-1\t-\tiso-ir-143 csISO143IECP271\n89\tJUS_I.B1.003-serb\t-\tiso-ir-146 serbian csISO146Serbian\n90\tJUS_I.B1.003-mac\t-\tmacedonian iso-ir-147 csISO147Macedonian\n91\tgreek-ccitt\t-\tiso-ir-150 csISO150 csISO150GreekCCITT\n92\tNC_NC00-10:81\t-\tcuba iso-ir-151 ISO646-CU csISO151Cuba\n93\tISO_6937-2-25\t-\tiso-ir-152 csISO6937Add\n94\tGOST_19768-74\t-\tST_SEV_358-88 iso-ir-153 csISO153GOST1976874\n95\tISO_8859-supp\t-\tiso-ir-154 latin1-2-5 csISO8859Supp\n96\tISO_10367-box\t-\tiso-ir-155 csISO10367Box\n97\tlatin-lap\t-\tlap iso-ir-158 csISO158Lap\n98\tJIS_X0212-1990\t-\tx0212 iso-ir-159 csISO159JISX02121990\n99\tDS_2089\t-\tDS2089 ISO646-DK dk csISO646Danish\n100\tus-dk\t-\tcsUSDK\n101\tdk-us\t-\tcsDKUS\n102\tKSC5636\t-\tISO646-KR csKSC5636\n103\tUNICODE-1-1-UTF-7\t-\tcsUnicode11UTF7\n104\tISO-2022-CN\t-\tcsISO2022CN\n105\tISO-2022-CN-EXT\t-\tcsISO2022CNEXT\n106\tUTF-8\t-\tcsUTF8\n109\tISO-8859-13\t-\tcsISO885913\n110\tISO-8859-14\t-\tiso-ir-199 ISO_8859-14:1998 ISO_8859-14 latin8 iso-celtic l8 csISO885914\n111\tISO-8859-15\t-\tISO_8859-15 Latin-9 csISO885915\n112\tISO-8859-16\t-\tiso-ir-226 ISO_8859-16:2001 ISO_8859-16 latin10 l10 csISO885916\n113\tGBK\t-\tCP936 MS936 windows-936 csGBK\n114\tGB18030\t-\tcsGB18030\n115\tOSD_EBCDIC_DF04_15\t-\tcsOSDEBCDICDF0415\n116\tOSD_EBCDIC_DF03_IRV\t-\tcsOSDEBCDICDF03IRV\n117\tOSD_EBCDIC_DF04_1\t-\tcsOSDEBCDICDF041\n118\tISO-11548-1\t-\tISO_11548-1 ISO_TR_11548-1 csISO115481\n119\tKZ-1048\t-\tSTRK1048-2002 RK1048 csKZ1048\n1000\tISO-10646-UCS-2\t-\tcsUnicode\n1001\tISO-10646-UCS-4\t-\tcsUCS4\n1002\tISO-10646-UCS-Basic\t-\tcsUnicodeASCII\n1003\tISO-10646-Unicode-Latin1\t-\tcsUnicodeLatin1 ISO-10646\n1004\tISO-10646-J-1\t-\tcsUnicodeJapanese\n1005\tISO-Unicode-IBM-1261\t-\tcsUnicodeIBM1261\n1006\tISO-Unicode-IBM-1268\t-\tcsUnicodeIBM1268\n1007\tISO-Unicode-IBM-1276\t-\tcsUnicodeIBM1276\n1008\tISO-Unicode-IBM-1264\t-\tcsUnicodeIBM1264\n1009\tISO-Unicode-IBM-1265\t-\tcsUnicodeIBM1265\n1010\tUNICODE-1-1\t-\tcsUnicode11\n1011\tSCSU\t-\tcsSCSU\n1012\tUTF-7\t-\tcsUTF7\n1013\tUTF-16BE\t-\tcsUTF16BE\n1014\tUTF-16LE\t-\tcsUTF16LE\n1015\tUTF-16\t-\tcsUTF16\n1016\tCESU-8\t-\tcsCESU8 csCESU-8\n1017\tUTF-32\t-\tcsUTF32\n1018\tUTF-32BE\t-\tcsUTF32BE\n1019\tUTF-32LE\t-\tcsUTF32LE\n1020\tBOCU-1\t-\tcsBOCU1 csBOCU-1\n1021\tUTF-7-IMAP\t-\tcsUTF7IMAP\n2000\tISO-8859-1-Windows-3.0-Latin-1\t-\tcsWindows30Latin1\n2001\tISO-8859-1-Windows-3.1-Latin-1\t-\tcsWindows31Latin1\n2002\tISO-8859-2-Windows-Latin-2\t-\tcsWindows31Latin2\n2003\tISO-8859-9-Windows-Latin-5\t-\tcsWindows31Latin5\n2004\thp-roman8\t-\troman8 r8 csHPRoman8\n2005\tAdobe-Standard-Encoding\t-\tcsAdobeStandardEncoding\n2006\tVentura-US\t-\tcsVenturaUS\n2007\tVentura-International\t-\tcsVenturaInternational\n2008\tDEC-MCS\t-\tdec csDECMCS\n2009\tIBM850\t-\tcp850 850 csPC850Multilingual\n2010\tIBM852\t-\tcp852 852 csPCp852\n2011\tIBM437\t-\tcp437 437 csPC8CodePage437\n2012\tPC8-Danish-Norwegian\t-\tcsPC8DanishNorwegian\n2013\tIBM862\t-\tcp862 862 csPC862LatinHebrew\n2014\tPC8-Turkish\t-\tcsPC8Turkish\n2015\tIBM-Symbols\t-\tcsIBMSymbols\n2016\tIBM-Thai\t-\tcsIBMThai\n2017\tHP-Legal\t-\tcsHPLegal\n2018\tHP-Pi-font\t-\tcsHPPiFont\n2019\tHP-Math8\t-\tcsHPMath8\n2020\tAdobe-Symbol-Encoding\t-\tcsHPPSMath\n2021\tHP-DeskTop\t-\tcsHPDesktop\n2022\tVentura-Math\t-\tcsVenturaMath\n2023\tMicrosoft-Publishing\t-\tcsMicrosoftPublishing\n2024\tWindows-31J\t-\tcsWindows31J\n2025\tGB2312\tGB2312\tcsGB2312\n2026\tBig5\tBig5\tcsBig5\n2027\tmacintosh\t-\tmac csMacintosh\n2028\tIBM037\t-\tcp037 ebcdic-cp-us ebcdic-cp-ca ebcdic-cp-wt ebcdic-cp-nl csIBM037\n2029\tIBM038\t-\tEBCDIC-INT cp038 csIBM038\n2030\tIBM273\t-\tCP273 csIBM273\n2031\tIBM274\t-\tEBCDIC-BE CP274 csIBM274\n2032\tIBM275\t-\tEBCDIC-BR cp275 csIBM275\n2033\tIBM277\t-\tEBCDIC-CP-DK EBCDIC-CP-NO csIBM277\n2034\tIBM278\t-\tCP278 ebcdic-cp-fi ebcdic-cp-se csIBM278\n2035\tIBM280\t-\tCP280 ebcdic-cp-it csIBM280\n2036\tIBM281\t-\tEBCDIC-JP-E cp281 csIBM281\n2037\tIBM284\t-\tCP284 ebcdic-cp-es csIBM284\n2038\tIBM285\t-\tCP285 ebcdic-cp-gb csIBM285\n2039\tIBM290\t-\tcp290 EBCDIC-JP-kana csIBM290\n2040\tIBM297\t-\tcp297 ebcdic-cp-fr csIBM297\n2041\tIBM420\t-\tcp420 ebcdic-cp-ar1 csIBM420\n2042\tIBM423\t-\tcp423 ebcdic-cp-gr csIBM423\n2043\tIBM424\t-\tcp424 ebcdic-cp-he csIBM424\n2044\tIBM500\t-\tCP500 ebcdic-cp-be ebcdic-cp-ch csIBM500\n2045\tIBM851\t-\tcp851 851 csIBM851\n2046\tIBM855\t-\tcp855 855 csIBM855\n2047\tIBM857\t-\tcp857 857 csIBM857\n2048\tIBM860\t-\tcp860 860 csIBM860\n2049\tIBM861\t-\tcp861 861 cp-is csIBM861\n2050\tIBM863\t-\tcp863 863 csIBM863\n2051\tIBM864\t-\tcp864 csIBM864\n2052\tIBM865\t-\tcp865 865 csIBM865\n2053\tIBM868\t-\tCP868 cp-ar csIBM868\n2054\tIBM869\t-\tcp869 869 cp-gr csIBM869\n2055\tIBM870\t-\tCP870 ebcdic-cp-roece ebcdic-cp-yu csIBM870\n2056\tIBM871\t-\tCP871 ebcdic-cp-is csIBM871\n2057\tIBM880\t-\tcp880 EBCDIC-Cyrillic csIBM880\n2058\tIBM891\t-\tcp891 csIBM891\n2059\tIBM903\t-\tcp903 csIBM903\n2060\tIBM904\t-\tcp904 904 csIBBM904\n2061\tIBM905\t-\tCP905 ebcdic-cp-tr csIBM905\n2062\tIBM918\t-\tCP918 ebcdic-cp-ar2 csIBM918\n2063\tIBM1026\t-\tCP1026 csIBM1026\n2064\tEBCDIC-AT-DE\t-\tcsIBMEBCDICATDE\n2065\tEBCDIC-AT-DE-A\t-\tcsEBCDICATDEA\n2066\tEBCDIC-CA-FR\t-\tcsEBCDICCAFR\n2067\tEBCDIC-DK-NO\t-\tcsEBCDICDKNO\n2068\tEBCDIC-DK-NO-A\t-\tcsEBCDICDKNOA\n2069\tEBCDIC-FI-SE\t-\tcsEBCDICFISE\n2070\tEBCDIC-FI-SE-A\t-\tcsEBCDICFISEA\n2071\tEBCDIC-FR\t-\tcsEBCDICFR\n2072\tEBCDIC-IT\t-\tcsEBCDICIT\n2073\tEBCDIC-PT\t-\tcsEBCDICPT\n2074\tEBCDIC-ES\t-\tcsEBCDICES\n2075\tEBCDIC-ES-A\t-\tcsEBCDICESA\n2076\tEBCDIC-ES-S\t-\tcsEBCDICESS\n2077\tEBCDIC-UK\t-\tcsEBCDICUK\n2078\tEBCDIC-US\t-\tcsEBCDICUS\n2079\tUNKNOWN-8BIT\t-\tcsUnknown8BiT\n2080\tMNEMONIC\t-\tcsMnemonic\n2081\tMNEM\t-\tcsMnem\n2082\tVISCII\t-\tcsVISCII\n2083\tVIQR\t-\tcsVIQR\n2084\tKOI8-R\tKOI8-R\tcsKOI8R\n2085\tHZ-GB-2312\t-\t\n2086\tIBM866\t-\tcp866 866 csIBM866\n2087\tIBM775\t-\tcp775 csPC775Baltic\n2088\tKOI8-U\t-\tcsKOI8U\n2089\tIBM00858\t-\tCCSID00858 CP00858 PC-Multilingual-850+euro csIBM00858\n2090\tIBM00924\t-\tCCSID00924 CP00924 ebcdic-Latin9--euro csIBM00924\n2091\tIBM01140\t-\tCCSID01140 CP01140 ebcdic-us-37+euro csIBM01140\n2092\tIBM01141\t-\tCCSID01141 CP01141 ebcdic-de-273+euro csIBM01141\n2093\tIBM01142\t-\tCCSID01142 CP01142 ebcdic-dk-277+euro ebcdic-no-277+euro csIBM01142\n2094\tIBM01143\t-\tCCSID01143 CP01143 ebcdic-fi-278+euro ebcdic-se-278+euro csIBM01143\n2095\tIBM01144\t-\tCCSID01144 CP01144 ebcdic-it-280+euro csIBM01144\n2096\tIBM01145\t-\tCCSID01145 CP01145 ebcdic-es-284+euro csIBM01145\n2097\tIBM01146\t-\tCCSID01146 CP01146 ebcdic-gb-285+euro csIBM01146\n2098\tIBM01147\t-\tCCSID01147 CP01147 ebcdic-fr-297+euro csIBM01147\n2099\tIBM01148\t-\tCCSID01148 CP01148 ebcdic-international-500+euro csIBM01148\n2100\tIBM01149\t-\tCCSID01149 CP01149 ebcdic-is-871+euro csIBM01149\n2101\tBig5-HKSCS\t-\tcsBig5HKSCS\n2102\tIBM1047\t-\tIBM-1047 csIBM1047\n2103\tPTCP154\t-\tcsPTCP154 PT154 CP154 Cyrillic-Asian\n2104\tAmiga-1251\t-\tAmi1251 Amiga1251 Ami-1251 csAmiga1251\n2105\tKOI7-switched\t-\tcsKOI7switched\n2106\tBRF\t-\tcsBRF\n2107\tTSCII\t-\tcsTSCII\n2108\tCP51932\t-\tcsCP51932\n2109\twindows-874\t-\tcswindows874\n2250\twindows-1250\t-\tcswindows1250\n2251\twindows-1251\t-\tcswindows1251\n2252\twindows-1252\t-\tcswindows1252\n2253\twindows-1253\t-\tcswindows1253\n2254\twindows-1254\t-\tcswindows1254\n2255\twindows-1255\t-\tcswindows1255\n2256\twindows-1256\t-\tcswindows1256\n2257\twindows-1257\t-\tcswindows1257\n2258\twindows-1258\t-\tcswindows1258\n2259\tTIS-620\t-\tcsTIS620 ISO-8859-11\n2260\tCP50220\t-\tcsCP50220\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
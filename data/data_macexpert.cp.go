@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("macexpert.cp", func() (io.ReadCloser, error) {
+		r := strings.NewReader("�������������������������������� \uf721\uf6f8\uf7a2\uf724\uf6e4\uf726\uf7b4⁽⁾‥․,-.⁄\uf730\uf731\uf732\uf733\uf734\uf735\uf736\uf737\uf738\uf739:;�\uf6de�\uf73f����\uf7f0��¼½¾⅛⅜⅝⅞⅓⅔������ﬀﬁﬂﬃﬄ₍�₎\uf6f6\uf6e5\uf760\uf761\uf762\uf763\uf764\uf765\uf766\uf767\uf768\uf769\uf76a\uf76b\uf76c\uf76d\uf76e\uf76f\uf770\uf771\uf772\uf773\uf774\uf775\uf776\uf777\uf778\uf779\uf77a₡\uf6dc\uf6dd\uf6fe��\uf6e9\uf6e0����\uf7e1\uf7e0\uf7e2\uf7e4\uf7e3\uf7e5\uf7e7\uf7e9\uf7e8\uf7ea\uf7eb\uf7ed\uf7ec\uf7ee\uf7ef\uf7f1\uf7f3\uf7f2\uf7f4\uf7f6\uf7f5\uf7fa\uf7f9\uf7fb\uf7fc�⁸₄₃₆₈₇\uf6fd�\uf6df₂�\uf7a8�\uf6f5\uf6f0₅�\uf6e1\uf6e7\uf7fd�\uf6e3��\uf7fe�₉₀\uf6ff\uf7e6\uf7f8\uf7bf₁\uf6f9������\uf7b8�����\uf6fa‒\uf6e6����\uf7a1�\uf7ff�¹²³⁴⁵⁶⁷⁹⁰�\uf6ec\uf6f1\uf6f3��\uf6ed\uf6f2\uf6eb�����\uf6ee\uf6fb\uf6f4\uf7af\uf6eaⁿ\uf6ef\uf6e2\uf6e8\uf6f7\uf6fc����")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("marc8.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("ŁØĐÞÆŒʹ·♭®±ƠƯʼ�ʻłøđþæœʺı£ð�ơư��°ℓ℗©♯¿¡ß€�����������������������̧̨̣̤̥̳̲̦̜̮̉̀́̂̃̄̆̇̈̌̊︠︡̋̐︢︣̕��̓̀́̈͂̓̔ͅ��������«»“”ʹ͵�����·���;�ΑΒ�ΓΔΕϚϜΖΗΘΙΚΛΜΝΞΟΠϞΡΣ�ΤΥΦΧΨΩϠ��αβϐγδεϛϝζηθικλμνξοπϟρσςτυφχψωϡ!\"#$%&'()*+,-./0123456789:;<=>?юабцдефгхийклмнопярстужвьызшэщчъЮАБЦДЕФГХИЙКЛМНОПЯРСТУЖВЬЫЗШЭЩЧ�������������������������������ґђѓєёѕіїјљњћќўџ�ѣѳѵѫ�������[�]�_ҐЂЃЄЁЅІЇЈЉЊЋЌЎЏЪѢѲѴѪ�����������!\"#$%&'()*+,-./0123456789:;<=>?��������������������������������אבגדהוזחטיךכלםמןנסעףפץצקרשת����!\"#$%&'()*+،-./٠١٢٣٤٥٦٧٨٩:؛<=>؟�ءآأؤإئابةتثجحخدذرزسشصضطظعغ�����ـفقكلمنهوىيًٌٍَُِّْ����������������������������������������������������������������������������αβγ����������������������������������₍₎�₊�₋��₀₁₂₃₄₅₆₇₈₉����������������������������������������������������������������������������⁽⁾�⁺�⁻��⁰¹²³⁴⁵⁶⁷⁸⁹���������������������������������������������������������������������")
+		return ioutil.NopCloser(r), nil
+	})
+}
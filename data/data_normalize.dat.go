@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("normalize.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Canonical combining classes and decompositions (Unicode 14.0.0).\n# Fields: code point; combining class; canonical decomposition; \"x\" if excluded from composition;\n# compatibility decomposition, if the character has one.\n# Hangul syllables are decomposed algorithmically and are not listed.\n00A0;0;;;0020\n00A8;0;;;0020 0308\n00AA;0;;;0061\n00AF;0;;;0020 0304\n00B2;0;;;0032\n00B3;0;;;0033\n00B4;0;;;0020 0301\n00B5;0;;;03BC\n00B8;0;;;0020 0327\n00B9;0;;;0031\n00BA;0;;;006F\n00BC;0;;;0031 2044 0034\n00BD;0;;;0031 2044 0032\n00BE;0;;;0033 2044 0034\n00C0;0;0041 0300\n00C1;0;0041 0301\n00C2;0;0041 0302\n00C3;0;0041 0303\n00C4;0;0041 0308\n00C5;0;0041 030A\n00C7;0;0043 0327\n00C8;0;0045 0300\n00C9;0;0045 0301\n00CA;0;0045 0302\n00CB;0;0045 0308\n00CC;0;0049 0300\n00CD;0;0049 0301\n00CE;0;0049 0302\n00CF;0;0049 0308\n00D1;0;004E 0303\n00D2;0;004F 0300\n00D3;0;004F 0301\n00D4;0;004F 0302\n00D5;0;004F 0303\n00D6;0;004F 0308\n00D9;0;0055 0300\n00DA;0;0055 0301\n00DB;0;0055 0302\n00DC;0;0055 0308\n00DD;0;0059 0301\n00E0;0;0061 0300\n00E1;0;0061 0301\n00E2;0;0061 0302\n00E3;0;0061 0303\n00E4;0;0061 0308\n00E5;0;0061 030A\n00E7;0;0063 0327\n00E8;0;0065 0300\n00E9;0;0065 0301\n00EA;0;0065 0302\n00EB;0;0065 0308\n00EC;0;0069 0300\n00ED;0;0069 0301\n00EE;0;0069 0302\n00EF;0;0069 0308\n00F1;0;006E 0303\n00F2;0;006F 0300\n00F3;0;006F 0301\n00F4;0;006F 0302\n00F5;0;006F 0303\n00F6;0;006F 0308\n00F9;0;0075 0300\n00FA;0;0075 0301\n00FB;0;0075 0302\n00FC;0;0075 0308\n00FD;0;0079 0301\n00FF;0;0079 0308\n0100;0;0041 0304\n0101;0;0061 0304\n0102;0;0041 0306\n0103;0;0061 0306\n0104;0;0041 0328\n0105;0;0061 0328\n0106;0;0043 0301\n0107;0;0063 0301\n0108;0;0043 0302\n0109;0;0063 0302\n010A;0;0043 0307\n010B;0;0063 0307\n010C;0;0043 030C\n010D;0;0063 030C\n010E;0;0044 030C\n010F;0;0064 030C\n0112;0;0045 0304\n0113;0;0065 0304\n0114;0;0045 0306\n0115;0;0065 0306\n0116;0;0045 0307\n0117;0;0065 0307\n0118;0;0045 0328\n0119;0;0065 0328\n011A;0;0045 030C\n011B;0;0065 030C\n011C;0;0047 0302\n011D;0;0067 0302\n011E;0;0047 0306\n011F;0;0067 0306\n0120;0;0047 0307\n0121;0;0067 0307\n0122;0;0047 0327\n0123;0;0067 0327\n0124;0;0048 0302\n0125;0;0068 0302\n0128;0;0049 0303\n0129;0;0069 0303\n012A;0;0049 0304\n012B;0;0069 0304\n012C;0;0049 0306\n012D;0;0069 0306\n012E;0;0049 0328\n012F;0;0069 0328\n0130;0;0049 0307\n0132;0;;;0049 004A\n0133;0;;;0069 006A\n0134;0;004A 0302\n0135;0;006A 0302\n0136;0;004B 0327\n0137;0;006B 0327\n0139;0;004C 0301\n013A;0;006C 0301\n013B;0;004C 0327\n013C;0;006C 0327\n013D;0;004C 030C\n013E;0;006C 030C\n013F;0;;;004C 00B7\n0140;0;;;006C 00B7\n0143;0;004E 0301\n0144;0;006E 0301\n0145;0;004E 0327\n0146;0;006E 0327\n0147;0;004E 030C\n0148;0;006E 030C\n0149;0;;;02BC 006E\n014C;0;004F 0304\n014D;0;006F 0304\n014E;0;004F 0306\n014F;0;006F 0306\n0150;0;004F 030B\n0151;0;006F 030B\n0154;0;0052 0301\n0155;0;0072 0301\n0156;0;0052 0327\n0157;0;0072 0327\n0158;0;0052 030C\n0159;0;0072 030C\n015A;0;0053 0301\n015B;0;0073 0301\n015C;0;0053 0302\n015D;0;0073 0302\n015E;0;0053 0327\n015F;0;0073 0327\n0160;0;0053 030C\n0161;0;0073 030C\n0162;0;0054 0327\n0163;0;0074 0327\n0164;0;0054 030C\n0165;0;0074 030C\n0168;0;0055 0303\n0169;0;0075 0303\n016A;0;0055 0304\n016B;0;0075 0304\n016C;0;0055 0306\n016D;0;0075 0306\n016E;0;0055 030A\n016F;0;0075 030A\n0170;0;0055 030B\n0171;0;0075 030B\n0172;0;0055 0328\n0173;0;0075 0328\n0174;0;0057 0302\n0175;0;0077 0302\n0176;0;0059 0302\n0177;0;0079 0302\n0178;0;0059 0308\n0179;0;005A 0301\n017A;0;007A 0301\n017B;0;005A 0307\n017C;0;007A 0307\n017D;0;005A 030C\n017E;0;007A 030C\n017F;0;;;0073\n01A0;0;004F 031B\n01A1;0;006F 031B\n01AF;0;0055 031B\n01B0;0;0075 031B\n01C4;0;;;0044 017D\n01C5;0;;;0044 017E\n01C6;0;;;0064 017E\n01C7;0;;;004C 004A\n01C8;0;;;004C 006A\n01C9;0;;;006C 006A\n01CA;0;;;004E 004A\n01CB;0;;;004E 006A\n01CC;0;;;006E 006A\n01CD;0;0041 030C\n01CE;0;0061 030C\n01CF;0;0049 030C\n01D0;0;0069 030C\n01D1;0;004F 030C\n01D2;0;006F 030C\n01D3;0;0055 030C\n01D4;0;0075 030C\n01D5;0;00DC 0304\n01D6;0;00FC 0304\n01D7;0;00DC 0301\n01D8;0;00FC 0301\n01D9;0;00DC 030C\n01DA;0;00FC 030C\n01DB;0;00DC 0300\n01DC;0;00FC 0300\n01DE;0;00C4 0304\n01DF;0;00E4 0304\n01E0;0;0226 0304\n01E1;0;0227 0304\n01E2;0;00C6 0304\n01E3;0;00E6 0304\n01E6;0;0047 030C\n01E7;0;0067 030C\n01E8;0;004B 030C\n01E9;0;006B 030C\n01EA;0;004F 0328\n01EB;0;006F 0328\n01EC;0;01EA 0304\n01ED;0;01EB 0304\n01EE;0;01B7 030C\n01EF;0;0292 030C\n01F0;0;006A 030C\n01F1;0;;;0044 005A\n01F2;0;;;0044 007A\n01F3;0;;;0064 007A\n01F4;0;0047 0301\n01F5;0;0067 0301\n01F8;0;004E 0300\n01F9;0;006E 0300\n01FA;0;00C5 0301\n01FB;0;00E5 0301\n01FC;0;00C6 0301\n01FD;0;00E6 0301\n01FE;0;00D8 0301\n01FF;0;00F8 0301\n0200;0;0041 030F\n0201;0;0061 030F\n0202;0;0041 0311\n0203;0;0061 0311\n0204;0;0045 030F\n0205;0;0065 030F\n0206;0;0045 0311\n0207;0;0065 0311\n0208;0;0049 030F\n0209;0;0069 030F\n020A;0;0049 0311\n020B;0;0069 0311\n020C;0;004F 030F\n020D;0;006F 030F\n020E;0;004F 0311\n020F;0;006F 0311\n0210;0;0052 030F\n0211;0;0072 030F\n0212;0;0052 0311\n0213;0;0072 0311\n0214;0;0055 030F\n0215;0;0075 030F\n0216;0;0055 0311\n0217;0;0075 0311\n0218;0;0053 0326\n0219;0;0073 0326\n021A;0;0054 0326\n021B;0;0074 0326\n021E;0;0048 030C\n021F;0;0068 030C\n0226;0;0041 0307\n0227;0;0061 0307\n0228;0;0045 0327\n0229;0;0065 0327\n022A;0;00D6 0304\n022B;0;00F6 0304\n022C;0;00D5 0304\n022D;0;00F5 0304\n022E;0;004F 0307\n022F;0;006F 0307\n0230;0;022E 0304\n0231;0;022F 0304\n0232;0;0059 0304\n0233;0;0079 0304\n02B0;0;;;0068\n02B1;0;;;0266\n02B2;0;;;006A\n02B3;0;;;0072\n02B4;0;;;0279\n02B5;0;;;027B\n02B6;0;;;0281\n02B7;0;;;0077\n02B8;0;;;0079\n02D8;0;;;0020 0306\n02D9;0;;;0020 0307\n02DA;0;;;0020 030A\n02DB;0;;;0020 0328\n02DC;0;;;0020 0303\n02DD;0;;;0020 030B\n02E0;0;;;0263\n02E1;0;;;006C\n02E2;0;;;0073\n02E3;0;;;0078\n02E4;0;;;0295\n0300;230;\n0301;230;\n0302;230;\n0303;230;\n0304;230;\n0305;230;\n0306;230;\n0307;230;\n0308;230;\n0309;230;\n030A;230;\n030B;230;\n030C;230;\n030D;230;\n030E;230;\n030F;230;\n0310;230;\n0311;230;\n0312;230;\n0313;230;\n0314;230;\n0315;232;\n0316;220;\n0317;220;\n0318;220;\n0319;220;\n031A;232;\n031B;216;\n031C;220;\n031D;220;\n031E;220;\n031F;220;\n0320;220;\n0321;202;\n0322;202;\n0323;220;\n0324;220;\n0325;220;\n0326;220;\n0327;202;\n0328;202;\n0329;220;\n032A;220;\n032B;220;\n032C;220;\n032D;220;\n032E;220;\n032F;220;\n0330;220;\n0331;220;\n0332;220;\n0333;220;\n0334;1;\n0335;1;\n0336;1;\n0337;1;\n0338;1;\n0339;220;\n033A;220;\n033B;220;\n033C;220;\n033D;230;\n033E;230;\n033F;230;\n0340;230;0300;x\n0341;230;0301;x\n0342;230;\n0343;230;0313;x\n0344;230;0308 0301;x\n0345;240;\n0346;230;\n0347;220;\n0348;220;\n0349;220;\n034A;230;\n034B;230;\n034C;230;\n034D;220;\n034E;220;\n0350;230;\n0351;230;\n0352;230;\n0353;220;\n0354;220;\n0355;220;\n0356;220;\n0357;230;\n0358;232;\n0359;220;\n035A;220;\n035B;230;\n035C;233;\n035D;234;\n035E;234;\n035F;233;\n0360;234;\n0361;234;\n0362;233;\n0363;230;\n0364;230;\n0365;230;\n0366;230;\n0367;230;\n0368;230;\n0369;230;\n036A;230;\n036B;230;\n036C;230;\n036D;230;\n036E;230;\n036F;230;\n0374;0;02B9;x\n037A;0;;;0020 0345\n037E;0;003B;x\n0384;0;;;0020 0301\n0385;0;00A8 0301\n0386;0;0391 0301\n0387;0;00B7;x\n0388;0;0395 0301\n0389;0;0397 0301\n038A;0;0399 0301\n038C;0;039F 0301\n038E;0;03A5 0301\n038F;0;03A9 0301\n0390;0;03CA 0301\n03AA;0;0399 0308\n03AB;0;03A5 0308\n03AC;0;03B1 0301\n03AD;0;03B5 0301\n03AE;0;03B7 0301\n03AF;0;03B9 0301\n03B0;0;03CB 0301\n03CA;0;03B9 0308\n03CB;0;03C5 0308\n03CC;0;03BF 0301\n03CD;0;03C5 0301\n03CE;0;03C9 0301\n03D0;0;;;03B2\n03D1;0;;;03B8\n03D2;0;;;03A5\n03D3;0;03D2 0301\n03D4;0;03D2 0308\n03D5;0;;;03C6\n03D6;0;;;03C0\n03F0;0;;;03BA\n03F1;0;;;03C1\n03F2;0;;;03C2\n03F4;0;;;0398\n03F5;0;;;03B5\n03F9;0;;;03A3\n0400;0;0415 0300\n0401;0;0415 0308\n0403;0;0413 0301\n0407;0;0406 0308\n040C;0;041A 0301\n040D;0;0418 0300\n040E;0;0423 0306\n0419;0;0418 0306\n0439;0;0438 0306\n0450;0;0435 0300\n0451;0;0435 0308\n0453;0;0433 0301\n0457;0;0456 0308\n045C;0;043A 0301\n045D;0;0438 0300\n045E;0;0443 0306\n0476;0;0474 030F\n0477;0;0475 030F\n0483;230;\n0484;230;\n0485;230;\n0486;230;\n0487;230;\n04C1;0;0416 0306\n04C2;0;0436 0306\n04D0;0;0410 0306\n04D1;0;0430 0306\n04D2;0;0410 0308\n04D3;0;0430 0308\n04D6;0;0415 0306\n04D7;0;0435 0306\n04DA;0;04D8 0308\n04DB;0;04D9 0308\n04DC;0;0416 0308\n04DD;0;0436 0308\n04DE;0;0417 0308\n04DF;0;0437 0308\n04E2;0;0418 0304\n04E3;0;0438 0304\n04E4;0;0418 0308\n04E5;0;0438 0308\n04E6;0;041E 0308\n04E7;0;043E 0308\n04EA;0;04E8 0308\n04EB;0;04E9 0308\n04EC;0;042D 0308\n04ED;0;044D 0308\n04EE;0;0423 0304\n04EF;0;0443 0304\n04F0;0;0423 0308\n04F1;0;0443 0308\n04F2;0;0423 030B\n04F3;0;0443 030B\n04F4;0;0427 0308\n04F5;0;0447 0308\n04F8;0;042B 0308\n04F9;0;044B 0308\n0587;0;;;0565 0582\n0591;220;\n0592;230;\n0593;230;\n0594;230;\n0595;230;\n0596;220;\n0597;230;\n0598;230;\n0599;230;\n059A;222;\n059B;220;\n059C;230;\n059D;230;\n059E;230;\n059F;230;\n05A0;230;\n05A1;230;\n05A2;220;\n05A3;220;\n05A4;220;\n05A5;220;\n05A6;220;\n05A7;220;\n05A8;230;\n05A9;230;\n05AA;220;\n05AB;230;\n05AC;230;\n05AD;222;\n05AE;228;\n05AF;230;\n05B0;10;\n05B1;11;\n05B2;12;\n05B3;13;\n05B4;14;\n05B5;15;\n05B6;16;\n05B7;17;\n05B8;18;\n05B9;19;\n05BA;19;\n05BB;20;\n05BC;21;\n05BD;22;\n05BF;23;\n05C1;24;\n05C2;25;\n05C4;230;\n05C5;220;\n05C7;18;\n0610;230;\n0611;230;\n0612;230;\n0613;230;\n0614;230;\n0615;230;\n0616;230;\n0617;230;\n0618;30;\n0619;31;\n061A;32;\n0622;0;0627 0653\n0623;0;0627 0654\n0624;0;0648 0654\n0625;0;0627 0655\n0626;0;064A 0654\n064B;27;\n064C;28;\n064D;29;\n064E;30;\n064F;31;\n0650;32;\n0651;33;\n0652;34;\n0653;230;\n0654;230;\n0655;220;\n0656;220;\n0657;230;\n0658;230;\n0659;230;\n065A;230;\n065B;230;\n065C;220;\n065D;230;\n065E;230;\n065F;220;\n0670;35;\n0675;0;;;0627 0674\n0676;0;;;0648 0674\n0677;0;;;06C7 0674\n0678;0;;;064A 0674\n06C0;0;06D5 0654\n06C2;0;06C1 0654\n06D3;0;06D2 0654\n06D6;230;\n06D7;230;\n06D8;230;\n06D9;230;\n06DA;230;\n06DB;230;\n06DC;230;\n06DF;230;\n06E0;230;\n06E1;230;\n06E2;230;\n06E3;220;\n06E4;230;\n06E7;230;\n06E8;230;\n06EA;220;\n06EB;230;\n06EC;230;\n06ED;220;\n0711;36;\n0730;230;\n0731;220;\n0732;230;\n0733;230;\n0734;220;\n0735;230;\n0736;230;\n0737;220;\n0738;220;\n0739;220;\n073A;230;\n073B;220;\n073C;220;\n073D;230;\n073E;220;\n073F;230;\n0740;230;\n0741;230;\n0742;220;\n0743;230;\n0744;220;\n0745;230;\n0746;220;\n0747;230;\n0748;220;\n0749;230;\n074A;230;\n07EB;230;\n07EC;230;\n07ED;230;\n07EE;230;\n07EF;230;\n07F0;230;\n07F1;230;\n07F2;220;\n07F3;230;\n07FD;220;\n0816;230;\n0817;230;\n0818;230;\n0819;230;\n081B;230;\n081C;230;\n081D;230;\n081E;230;\n081F;230;\n0820;230;\n0821;230;\n0822;230;\n0823;230;\n0825;230;\n0826;230;\n0827;230;\n0829;230;\n082A;230;\n082B;230;\n082C;230;\n082D;230;\n0859;220;\n085A;220;\n085B;220;\n0898;230;\n0899;220;\n089A;220;\n089B;220;\n089C;230;\n089D;230;\n089E;230;\n089F;230;\n08CA;230;\n08CB;230;\n08CC;230;\n08CD;230;\n08CE;230;\n08CF;220;\n08D0;220;\n08D1;220;\n08D2;220;\n08D3;220;\n08D4;230;\n08D5;230;\n08D6;230;\n08D7;230;\n08D8;230;\n08D9;230;\n08DA;230;\n08DB;230;\n08DC;230;\n08DD;230;\n08DE;230;\n08DF;230;\n08E0;230;\n08E1;230;\n08E3;220;\n08E4;230;\n08E5;230;\n08E6;220;\n08E7;230;\n08E8;230;\n08E9;220;\n08EA;230;\n08EB;230;\n08EC;230;\n08ED;220;\n08EE;220;\n08EF;220;\n08F0;27;\n08F1;28;\n08F2;29;\n08F3;230;\n08F4;230;\n08F5;230;\n08F6;220;\n08F7;230;\n08F8;230;\n08F9;220;\n08FA;220;\n08FB;230;\n08FC;230;\n08FD;230;\n08FE;230;\n08FF;230;\n0929;0;0928 093C\n0931;0;0930 093C\n0934;0;0933 093C\n093C;7;\n094D;9;\n0951;230;\n0952;220;\n0953;230;\n0954;230;\n0958;0;0915 093C;x\n0959;0;0916 093C;x\n095A;0;0917 093C;x\n095B;0;091C 093C;x\n095C;0;0921 093C;x\n095D;0;0922 093C;x\n095E;0;092B 093C;x\n095F;0;092F 093C;x\n09BC;7;\n09CB;0;09C7 09BE\n09CC;0;09C7 09D7\n09CD;9;\n09DC;0;09A1 09BC;x\n09DD;0;09A2 09BC;x\n09DF;0;09AF 09BC;x\n09FE;230;\n0A33;0;0A32 0A3C;x\n0A36;0;0A38 0A3C;x\n0A3C;7;\n0A4D;9;\n0A59;0;0A16 0A3C;x\n0A5A;0;0A17 0A3C;x\n0A5B;0;0A1C 0A3C;x\n0A5E;0;0A2B 0A3C;x\n0ABC;7;\n0ACD;9;\n0B3C;7;\n0B48;0;0B47 0B56\n0B4B;0;0B47 0B3E\n0B4C;0;0B47 0B57\n0B4D;9;\n0B5C;0;0B21 0B3C;x\n0B5D;0;0B22 0B3C;x\n0B94;0;0B92 0BD7\n0BCA;0;0BC6 0BBE\n0BCB;0;0BC7 0BBE\n0BCC;0;0BC6 0BD7\n0BCD;9;\n0C3C;7;\n0C48;0;0C46 0C56\n0C4D;9;\n0C55;84;\n0C56;91;\n0CBC;7;\n0CC0;0;0CBF 0CD5\n0CC7;0;0CC6 0CD5\n0CC8;0;0CC6 0CD6\n0CCA;0;0CC6 0CC2\n0CCB;0;0CCA 0CD5\n0CCD;9;\n0D3B;9;\n0D3C;9;\n0D4A;0;0D46 0D3E\n0D4B;0;0D47 0D3E\n0D4C;0;0D46 0D57\n0D4D;9;\n0DCA;9;\n0DDA;0;0DD9 0DCA\n0DDC;0;0DD9 0DCF\n0DDD;0;0DDC 0DCA\n0DDE;0;0DD9 0DDF\n0E33;0;;;0E4D 0E32\n0E38;103;\n0E39;103;\n0E3A;9;\n0E48;107;\n0E49;107;\n0E4A;107;\n0E4B;107;\n0EB3;0;;;0ECD 0EB2\n0EB8;118;\n0EB9;118;\n0EBA;9;\n0EC8;122;\n0EC9;122;\n0ECA;122;\n0ECB;122;\n0EDC;0;;;0EAB 0E99\n0EDD;0;;;0EAB 0EA1\n0F0C;0;;;0F0B\n0F18;220;\n0F19;220;\n0F35;220;\n0F37;220;\n0F39;216;\n0F43;0;0F42 0FB7;x\n0F4D;0;0F4C 0FB7;x\n0F52;0;0F51 0FB7;x\n0F57;0;0F56 0FB7;x\n0F5C;0;0F5B 0FB7;x\n0F69;0;0F40 0FB5;x\n0F71;129;\n0F72;130;\n0F73;0;0F71 0F72;x\n0F74;132;\n0F75;0;0F71 0F74;x\n0F76;0;0FB2 0F80;x\n0F77;0;;;0FB2 0F81\n0F78;0;0FB3 0F80;x\n0F79;0;;;0FB3 0F81\n0F7A;130;\n0F7B;130;\n0F7C;130;\n0F7D;130;\n0F80;130;\n0F81;0;0F71 0F80;x\n0F82;230;\n0F83;230;\n0F84;9;\n0F86;230;\n0F87;230;\n0F93;0;0F92 0FB7;x\n0F9D;0;0F9C 0FB7;x\n0FA2;0;0FA1 0FB7;x\n0FA7;0;0FA6 0FB7;x\n0FAC;0;0FAB 0FB7;x\n0FB9;0;0F90 0FB5;x\n0FC6;220;\n1026;0;1025 102E\n1037;7;\n1039;9;\n103A;9;\n108D;220;\n10FC;0;;;10DC\n135D;230;\n135E;230;\n135F;230;\n1714;9;\n1715;9;\n1734;9;\n17D2;9;\n17DD;230;\n18A9;228;\n1939;222;\n193A;230;\n193B;220;\n1A17;230;\n1A18;220;\n1A60;9;\n1A75;230;\n1A76;230;\n1A77;230;\n1A78;230;\n1A79;230;\n1A7A;230;\n1A7B;230;\n1A7C;230;\n1A7F;220;\n1AB0;230;\n1AB1;230;\n1AB2;230;\n1AB3;230;\n1AB4;230;\n1AB5;220;\n1AB6;220;\n1AB7;220;\n1AB8;220;\n1AB9;220;\n1ABA;220;\n1ABB;230;\n1ABC;230;\n1ABD;220;\n1ABF;220;\n1AC0;220;\n1AC1;230;\n1AC2;230;\n1AC3;220;\n1AC4;220;\n1AC5;230;\n1AC6;230;\n1AC7;230;\n1AC8;230;\n1AC9;230;\n1ACA;220;\n1ACB;230;\n1ACC;230;\n1ACD;230;\n1ACE;230;\n1B06;0;1B05 1B35\n1B08;0;1B07 1B35\n1B0A;0;1B09 1B35\n1B0C;0;1B0B 1B35\n1B0E;0;1B0D 1B35\n1B12;0;1B11 1B35\n1B34;7;\n1B3B;0;1B3A 1B35\n1B3D;0;1B3C 1B35\n1B40;0;1B3E 1B35\n1B41;0;1B3F 1B35\n1B43;0;1B42 1B35\n1B44;9;\n1B6B;230;\n1B6C;220;\n1B6D;230;\n1B6E;230;\n1B6F;230;\n1B70;230;\n1B71;230;\n1B72;230;\n1B73;230;\n1BAA;9;\n1BAB;9;\n1BE6;7;\n1BF2;9;\n1BF3;9;\n1C37;7;\n1CD0;230;\n1CD1;230;\n1CD2;230;\n1CD4;1;\n1CD5;220;\n1CD6;220;\n1CD7;220;\n1CD8;220;\n1CD9;220;\n1CDA;230;\n1CDB;230;\n1CDC;220;\n1CDD;220;\n1CDE;220;\n1CDF;220;\n1CE0;230;\n1CE2;1;\n1CE3;1;\n1CE4;1;\n1CE5;1;\n1CE6;1;\n1CE7;1;\n1CE8;1;\n1CED;220;\n1CF4;230;\n1CF8;230;\n1CF9;230;\n1D2C;0;;;0041\n1D2D;0;;;00C6\n1D2E;0;;;0042\n1D30;0;;;0044\n1D31;0;;;0045\n1D32;0;;;018E\n1D33;0;;;0047\n1D34;0;;;0048\n1D35;0;;;0049\n1D36;0;;;004A\n1D37;0;;;004B\n1D38;0;;;004C\n1D39;0;;;004D\n1D3A;0;;;004E\n1D3C;0;;;004F\n1D3D;0;;;0222\n1D3E;0;;;0050\n1D3F;0;;;0052\n1D40;0;;;0054\n1D41;0;;;0055\n1D42;0;;;0057\n1D43;0;;;0061\n1D44;0;;;0250\n1D45;0;;;0251\n1D46;0;;;1D02\n1D47;0;;;0062\n1D48;0;;;0064\n1D49;0;;;0065\n1D4A;0;;;0259\n1D4B;0;;;025B\n1D4C;0;;;025C\n1D4D;0;;;0067\n1D4F;0;;;006B\n1D50;0;;;006D\n1D51;0;;;014B\n1D52;0;;;006F\n1D53;0;;;0254\n1D54;0;;;1D16\n1D55;0;;;1D17\n1D56;0;;;0070\n1D57;0;;;0074\n1D58;0;;;0075\n1D59;0;;;1D1D\n1D5A;0;;;026F\n1D5B;0;;;0076\n1D5C;0;;;1D25\n1D5D;0;;;03B2\n1D5E;0;;;03B3\n1D5F;0;;;03B4\n1D60;0;;;03C6\n1D61;0;;;03C7\n1D62;0;;;0069\n1D63;0;;;0072\n1D64;0;;;0075\n1D65;0;;;0076\n1D66;0;;;03B2\n1D67;0;;;03B3\n1D68;0;;;03C1\n1D69;0;;;03C6\n1D6A;0;;;03C7\n1D78;0;;;043D\n1D9B;0;;;0252\n1D9C;0;;;0063\n1D9D;0;;;0255\n1D9E;0;;;00F0\n1D9F;0;;;025C\n1DA0;0;;;0066\n1DA1;0;;;025F\n1DA2;0;;;0261\n1DA3;0;;;0265\n1DA4;0;;;0268\n1DA5;0;;;0269\n1DA6;0;;;026A\n1DA7;0;;;1D7B\n1DA8;0;;;029D\n1DA9;0;;;026D\n1DAA;0;;;1D85\n1DAB;0;;;029F\n1DAC;0;;;0271\n1DAD;0;;;0270\n1DAE;0;;;0272\n1DAF;0;;;0273\n1DB0;0;;;0274\n1DB1;0;;;0275\n1DB2;0;;;0278\n1DB3;0;;;0282\n1DB4;0;;;0283\n1DB5;0;;;01AB\n1DB6;0;;;0289\n1DB7;0;;;028A\n1DB8;0;;;1D1C\n1DB9;0;;;028B\n1DBA;0;;;028C\n1DBB;0;;;007A\n1DBC;0;;;0290\n1DBD;0;;;0291\n1DBE;0;;;0292\n1DBF;0;;;03B8\n1DC0;230;\n1DC1;230;\n1DC2;220;\n1DC3;230;\n1DC4;230;\n1DC5;230;\n1DC6;230;\n1DC7;230;\n1DC8;230;\n1DC9;230;\n1DCA;220;\n1DCB;230;\n1DCC;230;\n1DCD;234;\n1DCE;214;\n1DCF;220;\n1DD0;202;\n1DD1;230;\n1DD2;230;\n1DD3;230;\n1DD4;230;\n1DD5;230;\n1DD6;230;\n1DD7;230;\n1DD8;230;\n1DD9;230;\n1DDA;230;\n1DDB;230;\n1DDC;230;\n1DDD;230;\n1DDE;230;\n1DDF;230;\n1DE0;230;\n1DE1;230;\n1DE2;230;\n1DE3;230;\n1DE4;230;\n1DE5;230;\n1DE6;230;\n1DE7;230;\n1DE8;230;\n1DE9;230;\n1DEA;230;\n1DEB;230;\n1DEC;230;\n1DED;230;\n1DEE;230;\n1DEF;230;\n1DF0;230;\n1DF1;230;\n1DF2;230;\n1DF3;230;\n1DF4;230;\n1DF5;230;\n1DF6;232;\n1DF7;228;\n1DF8;228;\n1DF9;220;\n1DFA;218;\n1DFB;230;\n1DFC;233;\n1DFD;220;\n1DFE;230;\n1DFF;220;\n1E00;0;0041 0325\n1E01;0;0061 0325\n1E02;0;0042 0307\n1E03;0;0062 0307\n1E04;0;0042 0323\n1E05;0;0062 0323\n1E06;0;0042 0331\n1E07;0;0062 0331\n1E08;0;00C7 0301\n1E09;0;00E7 0301\n1E0A;0;0044 0307\n1E0B;0;0064 0307\n1E0C;0;0044 0323\n1E0D;0;0064 0323\n1E0E;0;0044 0331\n1E0F;0;0064 0331\n1E10;0;0044 0327\n1E11;0;0064 0327\n1E12;0;0044 032D\n1E13;0;0064 032D\n1E14;0;0112 0300\n1E15;0;0113 0300\n1E16;0;0112 0301\n1E17;0;0113 0301\n1E18;0;0045 032D\n1E19;0;0065 032D\n1E1A;0;0045 0330\n1E1B;0;0065 0330\n1E1C;0;0228 0306\n1E1D;0;0229 0306\n1E1E;0;0046 0307\n1E1F;0;0066 0307\n1E20;0;0047 0304\n1E21;0;0067 0304\n1E22;0;0048 0307\n1E23;0;0068 0307\n1E24;0;0048 0323\n1E25;0;0068 0323\n1E26;0;0048 0308\n1E27;0;0068 0308\n1E28;0;0048 0327\n1E29;0;0068 0327\n1E2A;0;0048 032E\n1E2B;0;0068 032E\n1E2C;0;0049 0330\n1E2D;0;0069 0330\n1E2E;0;00CF 0301\n1E2F;0;00EF 0301\n1E30;0;004B 0301\n1E31;0;006B 0301\n1E32;0;004B 0323\n1E33;0;006B 0323\n1E34;0;004B 0331\n1E35;0;006B 0331\n1E36;0;004C 0323\n1E37;0;006C 0323\n1E38;0;1E36 0304\n1E39;0;1E37 0304\n1E3A;0;004C 0331\n1E3B;0;006C 0331\n1E3C;0;004C 032D\n1E3D;0;006C 032D\n1E3E;0;004D 0301\n1E3F;0;006D 0301\n1E40;0;004D 0307\n1E41;0;006D 0307\n1E42;0;004D 0323\n1E43;0;006D 0323\n1E44;0;004E 0307\n1E45;0;006E 0307\n1E46;0;004E 0323\n1E47;0;006E 0323\n1E48;0;004E 0331\n1E49;0;006E 0331\n1E4A;0;004E 032D\n1E4B;0;006E 032D\n1E4C;0;00D5 0301\n1E4D;0;00F5 0301\n1E4E;0;00D5 0308\n1E4F;0;00F5 0308\n1E50;0;014C 0300\n1E51;0;014D 0300\n1E52;0;014C 0301\n1E53;0;014D 0301\n1E54;0;0050 0301\n1E55;0;0070 0301\n1E56;0;0050 0307\n1E57;0;0070 0307\n1E58;0;0052 0307\n1E59;0;0072 0307\n1E5A;0;0052 0323\n1E5B;0;0072 0323\n1E5C;0;1E5A 0304\n1E5D;0;1E5B 0304\n1E5E;0;0052 0331\n1E5F;0;0072 0331\n1E60;0;0053 0307\n1E61;0;0073 0307\n1E62;0;0053 0323\n1E63;0;0073 0323\n1E64;0;015A 0307\n1E65;0;015B 0307\n1E66;0;0160 0307\n1E67;0;0161 0307\n1E68;0;1E62 0307\n1E69;0;1E63 0307\n1E6A;0;0054 0307\n1E6B;0;0074 0307\n1E6C;0;0054 0323\n1E6D;0;0074 0323\n1E6E;0;0054 0331\n1E6F;0;0074 0331\n1E70;0;0054 032D\n1E71;0;0074 032D\n1E72;0;0055 0324\n1E73;0;0075 0324\n1E74;0;0055 0330\n1E75;0;0075 0330\n1E76;0;0055 032D\n1E77;0;0075 032D\n1E78;0;0168 0301\n1E79;0;0169 0301\n1E7A;0;016A 0308\n1E7B;0;016B 0308\n1E7C;0;0056 0303\n1E7D;0;0076 0303\n1E7E;0;0056 0323\n1E7F;0;0076 0323\n1E80;0;0057 0300\n1E81;0;0077 0300\n1E82;0;0057 0301\n1E83;0;0077 0301\n1E84;0;0057 0308\n1E85;0;0077 0308\n1E86;0;0057 0307\n1E87;0;0077 0307\n1E88;0;0057 0323\n1E89;0;0077 0323\n1E8A;0;0058 0307\n1E8B;0;0078 0307\n1E8C;0;0058 0308\n1E8D;0;0078 0308\n1E8E;0;0059 0307\n1E8F;0;0079 0307\n1E90;0;005A 0302\n1E91;0;007A 0302\n1E92;0;005A 0323\n1E93;0;007A 0323\n1E94;0;005A 0331\n1E95;0;007A 0331\n1E96;0;0068 0331\n1E97;0;0074 0308\n1E98;0;0077 030A\n1E99;0;0079 030A\n1E9A;0;;;0061 02BE\n1E9B;0;017F 0307\n1EA0;0;0041 0323\n1EA1;0;0061 0323\n1EA2;0;0041 0309\n1EA3;0;0061 0309\n1EA4;0;00C2 0301\n1EA5;0;00E2 0301\n1EA6;0;00C2 0300\n1EA7;0;00E2 0300\n1EA8;0;00C2 0309\n1EA9;0;00E2 0309\n1EAA;0;00C2 0303\n1EAB;0;00E2 0303\n1EAC;0;1EA0 0302\n1EAD;0;1EA1 0302\n1EAE;0;0102 0301\n1EAF;0;0103 0301\n1EB0;0;0102 0300\n1EB1;0;0103 0300\n1EB2;0;0102 0309\n1EB3;0;0103 0309\n1EB4;0;0102 0303\n1EB5;0;0103 0303\n1EB6;0;1EA0 0306\n1EB7;0;1EA1 0306\n1EB8;0;0045 0323\n1EB9;0;0065 0323\n1EBA;0;0045 0309\n1EBB;0;0065 0309\n1EBC;0;0045 0303\n1EBD;0;0065 0303\n1EBE;0;00CA 0301\n1EBF;0;00EA 0301\n1EC0;0;00CA 0300\n1EC1;0;00EA 0300\n1EC2;0;00CA 0309\n1EC3;0;00EA 0309\n1EC4;0;00CA 0303\n1EC5;0;00EA 0303\n1EC6;0;1EB8 0302\n1EC7;0;1EB9 0302\n1EC8;0;0049 0309\n1EC9;0;0069 0309\n1ECA;0;0049 0323\n1ECB;0;0069 0323\n1ECC;0;004F 0323\n1ECD;0;006F 0323\n1ECE;0;004F 0309\n1ECF;0;006F 0309\n1ED0;0;00D4 0301\n1ED1;0;00F4 0301\n1ED2;0;00D4 0300\n1ED3;0;00F4 0300\n1ED4;0;00D4 0309\n1ED5;0;00F4 0309\n1ED6;0;00D4 0303\n1ED7;0;00F4 0303\n1ED8;0;1ECC 0302\n1ED9;0;1ECD 0302\n1EDA;0;01A0 0301\n1EDB;0;01A1 0301\n1EDC;0;01A0 0300\n1EDD;0;01A1 0300\n1EDE;0;01A0 0309\n1EDF;0;01A1 0309\n1EE0;0;01A0 0303\n1EE1;0;01A1 0303\n1EE2;0;01A0 0323\n1EE3;0;01A1 0323\n1EE4;0;0055 0323\n1EE5;0;0075 0323\n1EE6;0;0055 0309\n1EE7;0;0075 0309\n1EE8;0;01AF 0301\n1EE9;0;01B0 0301\n1EEA;0;01AF 0300\n1EEB;0;01B0 0300\n1EEC;0;01AF 0309\n1EED;0;01B0 0309\n1EEE;0;01AF 0303\n1EEF;0;01B0 0303\n1EF0;0;01AF 0323\n1EF1;0;01B0 0323\n1EF2;0;0059 0300\n1EF3;0;0079 0300\n1EF4;0;0059 0323\n1EF5;0;0079 0323\n1EF6;0;0059 0309\n1EF7;0;0079 0309\n1EF8;0;0059 0303\n1EF9;0;0079 0303\n1F00;0;03B1 0313\n1F01;0;03B1 0314\n1F02;0;1F00 0300\n1F03;0;1F01 0300\n1F04;0;1F00 0301\n1F05;0;1F01 0301\n1F06;0;1F00 0342\n1F07;0;1F01 0342\n1F08;0;0391 0313\n1F09;0;0391 0314\n1F0A;0;1F08 0300\n1F0B;0;1F09 0300\n1F0C;0;1F08 0301\n1F0D;0;1F09 0301\n1F0E;0;1F08 0342\n1F0F;0;1F09 0342\n1F10;0;03B5 0313\n1F11;0;03B5 0314\n1F12;0;1F10 0300\n1F13;0;1F11 0300\n1F14;0;1F10 0301\n1F15;0;1F11 0301\n1F18;0;0395 0313\n1F19;0;0395 0314\n1F1A;0;1F18 0300\n1F1B;0;1F19 0300\n1F1C;0;1F18 0301\n1F1D;0;1F19 0301\n1F20;0;03B7 0313\n1F21;0;03B7 0314\n1F22;0;1F20 0300\n1F23;0;1F21 0300\n1F24;0;1F20 0301\n1F25;0;1F21 0301\n1F26;0;1F20 0342\n1F27;0;1F21 0342\n1F28;0;0397 0313\n1F29;0;0397 0314\n1F2A;0;1F28 0300\n1F2B;0;1F29 0300\n1F2C;0;1F28 0301\n1F2D;0;1F29 0301\n1F2E;0;1F28 0342\n1F2F;0;1F29 0342\n1F30;0;03B9 0313\n1F31;0;03B9 0314\n1F32;0;1F30 0300\n1F33;0;1F31 0300\n1F34;0;1F30 0301\n1F35;0;1F31 0301\n1F36;0;1F30 0342\n1F37;0;1F31 0342\n1F38;0;0399 0313\n1F39;0;0399 0314\n1F3A;0;1F38 0300\n1F3B;0;1F39 0300\n1F3C;0;1F38 0301\n1F3D;0;1F39 0301\n1F3E;0;1F38 0342\n1F3F;0;1F39 0342\n1F40;0;03BF 0313\n1F41;0;03BF 0314\n1F42;0;1F40 0300\n1F43;0;1F41 0300\n1F44;0;1F40 0301\n1F45;0;1F41 0301\n1F48;0;039F 0313\n1F49;0;039F 0314\n1F4A;0;1F48 0300\n1F4B;0;1F49 0300\n1F4C;0;1F48 0301\n1F4D;0;1F49 0301\n1F50;0;03C5 0313\n1F51;0;03C5 0314\n1F52;0;1F50 0300\n1F53;0;1F51 0300\n1F54;0;1F50 0301\n1F55;0;1F51 0301\n1F56;0;1F50 0342\n1F57;0;1F51 0342\n1F59;0;03A5 0314\n1F5B;0;1F59 0300\n1F5D;0;1F59 0301\n1F5F;0;1F59 0342\n1F60;0;03C9 0313\n1F61;0;03C9 0314\n1F62;0;1F60 0300\n1F63;0;1F61 0300\n1F64;0;1F60 0301\n1F65;0;1F61 0301\n1F66;0;1F60 0342\n1F67;0;1F61 0342\n1F68;0;03A9 0313\n1F69;0;03A9 0314\n1F6A;0;1F68 0300\n1F6B;0;1F69 0300\n1F6C;0;1F68 0301\n1F6D;0;1F69 0301\n1F6E;0;1F68 0342\n1F6F;0;1F69 0342\n1F70;0;03B1 0300\n1F71;0;03AC;x\n1F72;0;03B5 0300\n1F73;0;03AD;x\n1F74;0;03B7 0300\n1F75;0;03AE;x\n1F76;0;03B9 0300\n1F77;0;03AF;x\n1F78;0;03BF 0300\n1F79;0;03CC;x\n1F7A;0;03C5 0300\n1F7B;0;03CD;x\n1F7C;0;03C9 0300\n1F7D;0;03CE;x\n1F80;0;1F00 0345\n1F81;0;1F01 0345\n1F82;0;1F02 0345\n1F83;0;1F03 0345\n1F84;0;1F04 0345\n1F85;0;1F05 0345\n1F86;0;1F06 0345\n1F87;0;1F07 0345\n1F88;0;1F08 0345\n1F89;0;1F09 0345\n1F8A;0;1F0A 0345\n1F8B;0;1F0B 0345\n1F8C;0;1F0C 0345\n1F8D;0;1F0D 0345\n1F8E;0;1F0E 0345\n1F8F;0;1F0F 0345\n1F90;0;1F20 0345\n1F91;0;1F21 0345\n1F92;0;1F22 0345\n1F93;0;1F23 0345\n1F94;0;1F24 0345\n1F95;0;1F25 0345\n1F96;0;1F26 0345\n1F97;0;1F27 0345\n1F98;0;1F28 0345\n1F99;0;1F29 0345\n1F9A;0;1F2A 0345\n1F9B;0;1F2B 0345\n1F9C;0;1F2C 0345\n1F9D;0;1F2D 0345\n1F9E;0;1F2E 0345\n1F9F;0;1F2F 0345\n1FA0;0;1F60 0345\n1FA1;0;1F61 0345\n1FA2;0;1F62 0345\n1FA3;0;1F63 0345\n1FA4;0;1F64 0345\n1FA5;0;1F65 0345\n1FA6;0;1F66 0345\n1FA7;0;1F67 0345\n1FA8;0;1F68 0345\n1FA9;0;1F69 0345\n1FAA;0;1F6A 0345\n1FAB;0;1F6B 0345\n1FAC;0;1F6C 0345\n1FAD;0;1F6D 0345\n1FAE;0;1F6E 0345\n1FAF;0;1F6F 0345\n1FB0;0;03B1 0306\n1FB1;0;03B1 0304\n1FB2;0;1F70 0345\n1FB3;0;03B1 0345\n1FB4;0;03AC 0345\n1FB6;0;03B1 0342\n1FB7;0;1FB6 0345\n1FB8;0;0391 0306\n1FB9;0;0391 0304\n1FBA;0;0391 0300\n1FBB;0;0386;x\n1FBC;0;0391 0345\n1FBD;0;;;0020 0313\n1FBE;0;03B9;x\n1FBF;0;;;0020 0313\n1FC0;0;;;0020 0342\n1FC1;0;00A8 0342\n1FC2;0;1F74 0345\n1FC3;0;03B7 0345\n1FC4;0;03AE 0345\n1FC6;0;03B7 0342\n1FC7;0;1FC6 0345\n1FC8;0;0395 0300\n1FC9;0;0388;x\n1FCA;0;0397 0300\n1FCB;0;0389;x\n1FCC;0;0397 0345\n1FCD;0;1FBF 0300\n1FCE;0;1FBF 0301\n1FCF;0;1FBF 0342\n1FD0;0;03B9 0306\n1FD1;0;03B9 0304\n1FD2;0;03CA 0300\n1FD3;0;0390;x\n1FD6;0;03B9 0342\n1FD7;0;03CA 0342\n1FD8;0;0399 0306\n1FD9;0;0399 0304\n1FDA;0;0399 0300\n1FDB;0;038A;x\n1FDD;0;1FFE 0300\n1FDE;0;1FFE 0301\n1FDF;0;1FFE 0342\n1FE0;0;03C5 0306\n1FE1;0;03C5 0304\n1FE2;0;03CB 0300\n1FE3;0;03B0;x\n1FE4;0;03C1 0313\n1FE5;0;03C1 0314\n1FE6;0;03C5 0342\n1FE7;0;03CB 0342\n1FE8;0;03A5 0306\n1FE9;0;03A5 0304\n1FEA;0;03A5 0300\n1FEB;0;038E;x\n1FEC;0;03A1 0314\n1FED;0;00A8 0300\n1FEE;0;0385;x\n1FEF;0;0060;x\n1FF2;0;1F7C 0345\n1FF3;0;03C9 0345\n1FF4;0;03CE 0345\n1FF6;0;03C9 0342\n1FF7;0;1FF6 0345\n1FF8;0;039F 0300\n1FF9;0;038C;x\n1FFA;0;03A9 0300\n1FFB;0;038F;x\n1FFC;0;03A9 0345\n1FFD;0;00B4;x\n1FFE;0;;;0020 0314\n2000;0;2002;x\n2001;0;2003;x\n2002;0;;;0020\n2003;0;;;0020\n2004;0;;;0020\n2005;0;;;0020\n2006;0;;;0020\n2007;0;;;0020\n2008;0;;;0020\n2009;0;;;0020\n200A;0;;;0020\n2011;0;;;2010\n2017;0;;;0020 0333\n2024;0;;;002E\n2025;0;;;002E 002E\n2026;0;;;002E 002E 002E\n202F;0;;;0020\n2033;0;;;2032 2032\n2034;0;;;2032 2032 2032\n2036;0;;;2035 2035\n2037;0;;;2035 2035 2035\n203C;0;;;0021 0021\n203E;0;;;0020 0305\n2047;0;;;003F 003F\n2048;0;;;003F 0021\n2049;0;;;0021 003F\n2057;0;;;2032 2032 2032 2032\n205F;0;;;0020\n2070;0;;;0030\n2071;0;;;0069\n2074;0;;;0034\n2075;0;;;0035\n2076;0;;;0036\n2077;0;;;0037\n2078;0;;;0038\n2079;0;;;0039\n207A;0;;;002B\n207B;0;;;2212\n207C;0;;;003D\n207D;0;;;0028\n207E;0;;;0029\n207F;0;;;006E\n2080;0;;;0030\n2081;0;;;0031\n2082;0;;;0032\n2083;0;;;0033\n2084;0;;;0034\n2085;0;;;0035\n2086;0;;;0036\n2087;0;;;0037\n2088;0;;;0038\n2089;0;;;0039\n208A;0;;;002B\n208B;0;;;2212\n208C;0;;;003D\n208D;0;;;0028\n208E;0;;;0029\n2090;0;;;0061\n2091;0;;;0065\n2092;0;;;006F\n2093;0;;;0078\n2094;0;;;0259\n2095;0;;;0068\n2096;0;;;006B\n2097;0;;;006C\n2098;0;;;006D\n2099;0;;;006E\n209A;0;;;0070\n209B;0;;;0073\n209C;0;;;0074\n20A8;0;;;0052 0073\n20D0;230;\n20D1;230;\n20D2;1;\n20D3;1;\n20D4;230;\n20D5;230;\n20D6;230;\n20D7;230;\n20D8;1;\n20D9;1;\n20DA;1;\n20DB;230;\n20DC;230;\n20E1;230;\n20E5;1;\n20E6;1;\n20E7;230;\n20E8;220;\n20E9;230;\n20EA;1;\n20EB;1;\n20EC;220;\n20ED;220;\n20EE;220;\n20EF;220;\n20F0;230;\n2100;0;;;0061 002F 0063\n2101;0;;;0061 002F 0073\n2102;0;;;0043\n2103;0;;;00B0 0043\n2105;0;;;0063 002F 006F\n2106;0;;;0063 002F 0075\n2107;0;;;0190\n2109;0;;;00B0 0046\n210A;0;;;0067\n210B;0;;;0048\n210C;0;;;0048\n210D;0;;;0048\n210E;0;;;0068\n210F;0;;;0127\n2110;0;;;0049\n2111;0;;;0049\n2112;0;;;004C\n2113;0;;;006C\n2115;0;;;004E\n2116;0;;;004E 006F\n2119;0;;;0050\n211A;0;;;0051\n211B;0;;;0052\n211C;0;;;0052\n211D;0;;;0052\n2120;0;;;0053 004D\n2121;0;;;0054 0045 004C\n2122;0;;;0054 004D\n2124;0;;;005A\n2126;0;03A9;x\n2128;0;;;005A\n212A;0;004B;x\n212B;0;00C5;x\n212C;0;;;0042\n212D;0;;;0043\n212F;0;;;0065\n2130;0;;;0045\n2131;0;;;0046\n2133;0;;;004D\n2134;0;;;006F\n2135;0;;;05D0\n2136;0;;;05D1\n2137;0;;;05D2\n2138;0;;;05D3\n2139;0;;;0069\n213B;0;;;0046 0041 0058\n213C;0;;;03C0\n213D;0;;;03B3\n213E;0;;;0393\n213F;0;;;03A0\n2140;0;;;2211\n2145;0;;;0044\n2146;0;;;0064\n2147;0;;;0065\n2148;0;;;0069\n2149;0;;;006A\n2150;0;;;0031 2044 0037\n2151;0;;;0031 2044 0039\n2152;0;;;0031 2044 0031 0030\n2153;0;;;0031 2044 0033\n2154;0;;;0032 2044 0033\n2155;0;;;0031 2044 0035\n2156;0;;;0032 2044 0035\n2157;0;;;0033 2044 0035\n2158;0;;;0034 2044 0035\n2159;0;;;0031 2044 0036\n215A;0;;;0035 2044 0036\n215B;0;;;0031 2044 0038\n215C;0;;;0033 2044 0038\n215D;0;;;0035 2044 0038\n215E;0;;;0037 2044 0038\n215F;0;;;0031 2044\n2160;0;;;0049\n2161;0;;;0049 0049\n2162;0;;;0049 0049 0049\n2163;0;;;0049 0056\n2164;0;;;0056\n2165;0;;;0056 0049\n2166;0;;;0056 0049 0049\n2167;0;;;0056 0049 0049 0049\n2168;0;;;0049 0058\n2169;0;;;0058\n216A;0;;;0058 0049\n216B;0;;;0058 0049 0049\n216C;0;;;004C\n216D;0;;;0043\n216E;0;;;0044\n216F;0;;;004D\n2170;0;;;0069\n2171;0;;;0069 0069\n2172;0;;;0069 0069 0069\n2173;0;;;0069 0076\n2174;0;;;0076\n2175;0;;;0076 0069\n2176;0;;;0076 0069 0069\n2177;0;;;0076 0069 0069 0069\n2178;0;;;0069 0078\n2179;0;;;0078\n217A;0;;;0078 0069\n217B;0;;;0078 0069 0069\n217C;0;;;006C\n217D;0;;;0063\n217E;0;;;0064\n217F;0;;;006D\n2189;0;;;0030 2044 0033\n219A;0;2190 0338\n219B;0;2192 0338\n21AE;0;2194 0338\n21CD;0;21D0 0338\n21CE;0;21D4 0338\n21CF;0;21D2 0338\n2204;0;2203 0338\n2209;0;2208 0338\n220C;0;220B 0338\n2224;0;2223 0338\n2226;0;2225 0338\n222C;0;;;222B 222B\n222D;0;;;222B 222B 222B\n222F;0;;;222E 222E\n2230;0;;;222E 222E 222E\n2241;0;223C 0338\n2244;0;2243 0338\n2247;0;2245 0338\n2249;0;2248 0338\n2260;0;003D 0338\n2262;0;2261 0338\n226D;0;224D 0338\n226E;0;003C 0338\n226F;0;003E 0338\n2270;0;2264 0338\n2271;0;2265 0338\n2274;0;2272 0338\n2275;0;2273 0338\n2278;0;2276 0338\n2279;0;2277 0338\n2280;0;227A 0338\n2281;0;227B 0338\n2284;0;2282 0338\n2285;0;2283 0338\n2288;0;2286 0338\n2289;0;2287 0338\n22AC;0;22A2 0338\n22AD;0;22A8 0338\n22AE;0;22A9 0338\n22AF;0;22AB 0338\n22E0;0;227C 0338\n22E1;0;227D 0338\n22E2;0;2291 0338\n22E3;0;2292 0338\n22EA;0;22B2 0338\n22EB;0;22B3 0338\n22EC;0;22B4 0338\n22ED;0;22B5 0338\n2329;0;3008;x\n232A;0;3009;x\n2460;0;;;0031\n2461;0;;;0032\n2462;0;;;0033\n2463;0;;;0034\n2464;0;;;0035\n2465;0;;;0036\n2466;0;;;0037\n2467;0;;;0038\n2468;0;;;0039\n2469;0;;;0031 0030\n246A;0;;;0031 0031\n246B;0;;;0031 0032\n246C;0;;;0031 0033\n246D;0;;;0031 0034\n246E;0;;;0031 0035\n246F;0;;;0031 0036\n2470;0;;;0031 0037\n2471;0;;;0031 0038\n2472;0;;;0031 0039\n2473;0;;;0032 0030\n2474;0;;;0028 0031 0029\n2475;0;;;0028 0032 0029\n2476;0;;;0028 0033 0029\n2477;0;;;0028 0034 0029\n2478;0;;;0028 0035 0029\n2479;0;;;0028 0036 0029\n247A;0;;;0028 0037 0029\n247B;0;;;0028 0038 0029\n247C;0;;;0028 0039 0029\n247D;0;;;0028 0031 0030 0029\n247E;0;;;0028 0031 0031 0029\n247F;0;;;0028 0031 0032 0029\n2480;0;;;0028 0031 0033 0029\n2481;0;;;0028 0031 0034 0029\n2482;0;;;0028 0031 0035 0029\n2483;0;;;0028 0031 0036 0029\n2484;0;;;0028 0031 0037 0029\n2485;0;;;0028 0031 0038 0029\n2486;0;;;0028 0031 0039 0029\n2487;0;;;0028 0032 0030 0029\n2488;0;;;0031 002E\n2489;0;;;0032 002E\n248A;0;;;0033 002E\n248B;0;;;0034 002E\n248C;0;;;0035 002E\n248D;0;;;0036 002E\n248E;0;;;0037 002E\n248F;0;;;0038 002E\n2490;0;;;0039 002E\n2491;0;;;0031 0030 002E\n2492;0;;;0031 0031 002E\n2493;0;;;0031 0032 002E\n2494;0;;;0031 0033 002E\n2495;0;;;0031 0034 002E\n2496;0;;;0031 0035 002E\n2497;0;;;0031 0036 002E\n2498;0;;;0031 0037 002E\n2499;0;;;0031 0038 002E\n249A;0;;;0031 0039 002E\n249B;0;;;0032 0030 002E\n249C;0;;;0028 0061 0029\n249D;0;;;0028 0062 0029\n249E;0;;;0028 0063 0029\n249F;0;;;0028 0064 0029\n24A0;0;;;0028 0065 0029\n24A1;0;;;0028 0066 0029\n24A2;0;;;0028 0067 0029\n24A3;0;;;0028 0068 0029\n24A4;0;;;0028 0069 0029\n24A5;0;;;0028 006A 0029\n24A6;0;;;0028 006B 0029\n24A7;0;;;0028 006C 0029\n24A8;0;;;0028 006D 0029\n24A9;0;;;0028 006E 0029\n24AA;0;;;0028 006F 0029\n24AB;0;;;0028 0070 0029\n24AC;0;;;0028 0071 0029\n24AD;0;;;0028 0072 0029\n24AE;0;;;0028 0073 0029\n24AF;0;;;0028 0074 0029\n24B0;0;;;0028 0075 0029\n24B1;0;;;0028 0076 0029\n24B2;0;;;0028 0077 0029\n24B3;0;;;0028 0078 0029\n24B4;0;;;0028 0079 0029\n24B5;0;;;0028 007A 0029\n24B6;0;;;0041\n24B7;0;;;0042\n24B8;0;;;0043\n24B9;0;;;0044\n24BA;0;;;0045\n24BB;0;;;0046\n24BC;0;;;0047\n24BD;0;;;0048\n24BE;0;;;0049\n24BF;0;;;004A\n24C0;0;;;004B\n24C1;0;;;004C\n24C2;0;;;004D\n24C3;0;;;004E\n24C4;0;;;004F\n24C5;0;;;0050\n24C6;0;;;0051\n24C7;0;;;0052\n24C8;0;;;0053\n24C9;0;;;0054\n24CA;0;;;0055\n24CB;0;;;0056\n24CC;0;;;0057\n24CD;0;;;0058\n24CE;0;;;0059\n24CF;0;;;005A\n24D0;0;;;0061\n24D1;0;;;0062\n24D2;0;;;0063\n24D3;0;;;0064\n24D4;0;;;0065\n24D5;0;;;0066\n24D6;0;;;0067\n24D7;0;;;0068\n24D8;0;;;0069\n24D9;0;;;006A\n24DA;0;;;006B\n24DB;0;;;006C\n24DC;0;;;006D\n24DD;0;;;006E\n24DE;0;;;006F\n24DF;0;;;0070\n24E0;0;;;0071\n24E1;0;;;0072\n24E2;0;;;0073\n24E3;0;;;0074\n24E4;0;;;0075\n24E5;0;;;0076\n24E6;0;;;0077\n24E7;0;;;0078\n24E8;0;;;0079\n24E9;0;;;007A\n24EA;0;;;0030\n2A0C;0;;;222B 222B 222B 222B\n2A74;0;;;003A 003A 003D\n2A75;0;;;003D 003D\n2A76;0;;;003D 003D 003D\n2ADC;0;2ADD 0338;x\n2C7C;0;;;006A\n2C7D;0;;;0056\n2CEF;230;\n2CF0;230;\n2CF1;230;\n2D6F;0;;;2D61\n2D7F;9;\n2DE0;230;\n2DE1;230;\n2DE2;230;\n2DE3;230;\n2DE4;230;\n2DE5;230;\n2DE6;230;\n2DE7;230;\n2DE8;230;\n2DE9;230;\n2DEA;230;\n2DEB;230;\n2DEC;230;\n2DED;230;\n2DEE;230;\n2DEF;230;\n2DF0;230;\n2DF1;230;\n2DF2;230;\n2DF3;230;\n2DF4;230;\n2DF5;230;\n2DF6;230;\n2DF7;230;\n2DF8;230;\n2DF9;230;\n2DFA;230;\n2DFB;230;\n2DFC;230;\n2DFD;230;\n2DFE;230;\n2DFF;230;\n2E9F;0;;;6BCD\n2EF3;0;;;9F9F\n2F00;0;;;4E00\n2F01;0;;;4E28\n2F02;0;;;4E36\n2F03;0;;;4E3F\n2F04;0;;;4E59\n2F05;0;;;4E85\n2F06;0;;;4E8C\n2F07;0;;;4EA0\n2F08;0;;;4EBA\n2F09;0;;;513F\n2F0A;0;;;5165\n2F0B;0;;;516B\n2F0C;0;;;5182\n2F0D;0;;;5196\n2F0E;0;;;51AB\n2F0F;0;;;51E0\n2F10;0;;;51F5\n2F11;0;;;5200\n2F12;0;;;529B\n2F13;0;;;52F9\n2F14;0;;;5315\n2F15;0;;;531A\n2F16;0;;;5338\n2F17;0;;;5341\n2F18;0;;;535C\n2F19;0;;;5369\n2F1A;0;;;5382\n2F1B;0;;;53B6\n2F1C;0;;;53C8\n2F1D;0;;;53E3\n2F1E;0;;;56D7\n2F1F;0;;;571F\n2F20;0;;;58EB\n2F21;0;;;5902\n2F22;0;;;590A\n2F23;0;;;5915\n2F24;0;;;5927\n2F25;0;;;5973\n2F26;0;;;5B50\n2F27;0;;;5B80\n2F28;0;;;5BF8\n2F29;0;;;5C0F\n2F2A;0;;;5C22\n2F2B;0;;;5C38\n2F2C;0;;;5C6E\n2F2D;0;;;5C71\n2F2E;0;;;5DDB\n2F2F;0;;;5DE5\n2F30;0;;;5DF1\n2F31;0;;;5DFE\n2F32;0;;;5E72\n2F33;0;;;5E7A\n2F34;0;;;5E7F\n2F35;0;;;5EF4\n2F36;0;;;5EFE\n2F37;0;;;5F0B\n2F38;0;;;5F13\n2F39;0;;;5F50\n2F3A;0;;;5F61\n2F3B;0;;;5F73\n2F3C;0;;;5FC3\n2F3D;0;;;6208\n2F3E;0;;;6236\n2F3F;0;;;624B\n2F40;0;;;652F\n2F41;0;;;6534\n2F42;0;;;6587\n2F43;0;;;6597\n2F44;0;;;65A4\n2F45;0;;;65B9\n2F46;0;;;65E0\n2F47;0;;;65E5\n2F48;0;;;66F0\n2F49;0;;;6708\n2F4A;0;;;6728\n2F4B;0;;;6B20\n2F4C;0;;;6B62\n2F4D;0;;;6B79\n2F4E;0;;;6BB3\n2F4F;0;;;6BCB\n2F50;0;;;6BD4\n2F51;0;;;6BDB\n2F52;0;;;6C0F\n2F53;0;;;6C14\n2F54;0;;;6C34\n2F55;0;;;706B\n2F56;0;;;722A\n2F57;0;;;7236\n2F58;0;;;723B\n2F59;0;;;723F\n2F5A;0;;;7247\n2F5B;0;;;7259\n2F5C;0;;;725B\n2F5D;0;;;72AC\n2F5E;0;;;7384\n2F5F;0;;;7389\n2F60;0;;;74DC\n2F61;0;;;74E6\n2F62;0;;;7518\n2F63;0;;;751F\n2F64;0;;;7528\n2F65;0;;;7530\n2F66;0;;;758B\n2F67;0;;;7592\n2F68;0;;;7676\n2F69;0;;;767D\n2F6A;0;;;76AE\n2F6B;0;;;76BF\n2F6C;0;;;76EE\n2F6D;0;;;77DB\n2F6E;0;;;77E2\n2F6F;0;;;77F3\n2F70;0;;;793A\n2F71;0;;;79B8\n2F72;0;;;79BE\n2F73;0;;;7A74\n2F74;0;;;7ACB\n2F75;0;;;7AF9\n2F76;0;;;7C73\n2F77;0;;;7CF8\n2F78;0;;;7F36\n2F79;0;;;7F51\n2F7A;0;;;7F8A\n2F7B;0;;;7FBD\n2F7C;0;;;8001\n2F7D;0;;;800C\n2F7E;0;;;8012\n2F7F;0;;;8033\n2F80;0;;;807F\n2F81;0;;;8089\n2F82;0;;;81E3\n2F83;0;;;81EA\n2F84;0;;;81F3\n2F85;0;;;81FC\n2F86;0;;;820C\n2F87;0;;;821B\n2F88;0;;;821F\n2F89;0;;;826E\n2F8A;0;;;8272\n2F8B;0;;;8278\n2F8C;0;;;864D\n2F8D;0;;;866B\n2F8E;0;;;8840\n2F8F;0;;;884C\n2F90;0;;;8863\n2F91;0;;;897E\n2F92;0;;;898B\n2F93;0;;;89D2\n2F94;0;;;8A00\n2F95;0;;;8C37\n2F96;0;;;8C46\n2F97;0;;;8C55\n2F98;0;;;8C78\n2F99;0;;;8C9D\n2F9A;0;;;8D64\n2F9B;0;;;8D70\n2F9C;0;;;8DB3\n2F9D;0;;;8EAB\n2F9E;0;;;8ECA\n2F9F;0;;;8F9B\n2FA0;0;;;8FB0\n2FA1;0;;;8FB5\n2FA2;0;;;9091\n2FA3;0;;;9149\n2FA4;0;;;91C6\n2FA5;0;;;91CC\n2FA6;0;;;91D1\n2FA7;0;;;9577\n2FA8;0;;;9580\n2FA9;0;;;961C\n2FAA;0;;;96B6\n2FAB;0;;;96B9\n2FAC;0;;;96E8\n2FAD;0;;;9751\n2FAE;0;;;975E\n2FAF;0;;;9762\n2FB0;0;;;9769\n2FB1;0;;;97CB\n2FB2;0;;;97ED\n2FB3;0;;;97F3\n2FB4;0;;;9801\n2FB5;0;;;98A8\n2FB6;0;;;98DB\n2FB7;0;;;98DF\n2FB8;0;;;9996\n2FB9;0;;;9999\n2FBA;0;;;99AC\n2FBB;0;;;9AA8\n2FBC;0;;;9AD8\n2FBD;0;;;9ADF\n2FBE;0;;;9B25\n2FBF;0;;;9B2F\n2FC0;0;;;9B32\n2FC1;0;;;9B3C\n2FC2;0;;;9B5A\n2FC3;0;;;9CE5\n2FC4;0;;;9E75\n2FC5;0;;;9E7F\n2FC6;0;;;9EA5\n2FC7;0;;;9EBB\n2FC8;0;;;9EC3\n2FC9;0;;;9ECD\n2FCA;0;;;9ED1\n2FCB;0;;;9EF9\n2FCC;0;;;9EFD\n2FCD;0;;;9F0E\n2FCE;0;;;9F13\n2FCF;0;;;9F20\n2FD0;0;;;9F3B\n2FD1;0;;;9F4A\n2FD2;0;;;9F52\n2FD3;0;;;9F8D\n2FD4;0;;;9F9C\n2FD5;0;;;9FA0\n3000;0;;;0020\n302A;218;\n302B;228;\n302C;232;\n302D;222;\n302E;224;\n302F;224;\n3036;0;;;3012\n3038;0;;;5341\n3039;0;;;5344\n303A;0;;;5345\n304C;0;304B 3099\n304E;0;304D 3099\n3050;0;304F 3099\n3052;0;3051 3099\n3054;0;3053 3099\n3056;0;3055 3099\n3058;0;3057 3099\n305A;0;3059 3099\n305C;0;305B 3099\n305E;0;305D 3099\n3060;0;305F 3099\n3062;0;3061 3099\n3065;0;3064 3099\n3067;0;3066 3099\n3069;0;3068 3099\n3070;0;306F 3099\n3071;0;306F 309A\n3073;0;3072 3099\n3074;0;3072 309A\n3076;0;3075 3099\n3077;0;3075 309A\n3079;0;3078 3099\n307A;0;3078 309A\n307C;0;307B 3099\n307D;0;307B 309A\n3094;0;3046 3099\n3099;8;\n309A;8;\n309B;0;;;0020 3099\n309C;0;;;0020 309A\n309E;0;309D 3099\n309F;0;;;3088 308A\n30AC;0;30AB 3099\n30AE;0;30AD 3099\n30B0;0;30AF 3099\n30B2;0;30B1 3099\n30B4;0;30B3 3099\n30B6;0;30B5 3099\n30B8;0;30B7 3099\n30BA;0;30B9 3099\n30BC;0;30BB 3099\n30BE;0;30BD 3099\n30C0;0;30BF 3099\n30C2;0;30C1 3099\n30C5;0;30C4 3099\n30C7;0;30C6 3099\n30C9;0;30C8 3099\n30D0;0;30CF 3099\n30D1;0;30CF 309A\n30D3;0;30D2 3099\n30D4;0;30D2 309A\n30D6;0;30D5 3099\n30D7;0;30D5 309A\n30D9;0;30D8 3099\n30DA;0;30D8 309A\n30DC;0;30DB 3099\n30DD;0;30DB 309A\n30F4;0;30A6 3099\n30F7;0;30EF 3099\n30F8;0;30F0 3099\n30F9;0;30F1 3099\n30FA;0;30F2 3099\n30FE;0;30FD 3099\n30FF;0;;;30B3 30C8\n3131;0;;;1100\n3132;0;;;1101\n3133;0;;;11AA\n3134;0;;;1102\n3135;0;;;11AC\n3136;0;;;11AD\n3137;0;;;1103\n3138;0;;;1104\n3139;0;;;1105\n313A;0;;;11B0\n313B;0;;;11B1\n313C;0;;;11B2\n313D;0;;;11B3\n313E;0;;;11B4\n313F;0;;;11B5\n3140;0;;;111A\n3141;0;;;1106\n3142;0;;;1107\n3143;0;;;1108\n3144;0;;;1121\n3145;0;;;1109\n3146;0;;;110A\n3147;0;;;110B\n3148;0;;;110C\n3149;0;;;110D\n314A;0;;;110E\n314B;0;;;110F\n314C;0;;;1110\n314D;0;;;1111\n314E;0;;;1112\n314F;0;;;1161\n3150;0;;;1162\n3151;0;;;1163\n3152;0;;;1164\n3153;0;;;1165\n3154;0;;;1166\n3155;0;;;1167\n3156;0;;;1168\n3157;0;;;1169\n3158;0;;;116A\n3159;0;;;116B\n315A;0;;;116C\n315B;0;;;116D\n315C;0;;;116E\n315D;0;;;116F\n315E;0;;;1170\n315F;0;;;1171\n3160;0;;;1172\n3161;0;;;1173\n3162;0;;;1174\n3163;0;;;1175\n3164;0;;;1160\n3165;0;;;1114\n3166;0;;;1115\n3167;0;;;11C7\n3168;0;;;11C8\n3169;0;;;11CC\n316A;0;;;11CE\n316B;0;;;11D3\n316C;0;;;11D7\n316D;0;;;11D9\n316E;0;;;111C\n316F;0;;;11DD\n3170;0;;;11DF\n3171;0;;;111D\n3172;0;;;111E\n3173;0;;;1120\n3174;0;;;1122\n3175;0;;;1123\n3176;0;;;1127\n3177;0;;;1129\n3178;0;;;112B\n3179;0;;;112C\n317A;0;;;112D\n317B;0;;;112E\n317C;0;;;112F\n317D;0;;;1132\n317E;0;;;1136\n317F;0;;;1140\n3180;0;;;1147\n3181;0;;;114C\n3182;0;;;11F1\n3183;0;;;11F2\n3184;0;;;1157\n3185;0;;;1158\n3186;0;;;1159\n3187;0;;;1184\n3188;0;;;1185\n3189;0;;;1188\n318A;0;;;1191\n318B;0;;;1192\n318C;0;;;1194\n318D;0;;;119E\n318E;0;;;11A1\n3192;0;;;4E00\n3193;0;;;4E8C\n3194;0;;;4E09\n3195;0;;;56DB\n3196;0;;;4E0A\n3197;0;;;4E2D\n3198;0;;;4E0B\n3199;0;;;7532\n319A;0;;;4E59\n319B;0;;;4E19\n319C;0;;;4E01\n319D;0;;;5929\n319E;0;;;5730\n319F;0;;;4EBA\n3200;0;;;0028 1100 0029\n3201;0;;;0028 1102 0029\n3202;0;;;0028 1103 0029\n3203;0;;;0028 1105 0029\n3204;0;;;0028 1106 0029\n3205;0;;;0028 1107 0029\n3206;0;;;0028 1109 0029\n3207;0;;;0028 110B 0029\n3208;0;;;0028 110C 0029\n3209;0;;;0028 110E 0029\n320A;0;;;0028 110F 0029\n320B;0;;;0028 1110 0029\n320C;0;;;0028 1111 0029\n320D;0;;;0028 1112 0029\n320E;0;;;0028 1100 1161 0029\n320F;0;;;0028 1102 1161 0029\n3210;0;;;0028 1103 1161 0029\n3211;0;;;0028 1105 1161 0029\n3212;0;;;0028 1106 1161 0029\n3213;0;;;0028 1107 1161 0029\n3214;0;;;0028 1109 1161 0029\n3215;0;;;0028 110B 1161 0029\n3216;0;;;0028 110C 1161 0029\n3217;0;;;0028 110E 1161 0029\n3218;0;;;0028 110F 1161 0029\n3219;0;;;0028 1110 1161 0029\n321A;0;;;0028 1111 1161 0029\n321B;0;;;0028 1112 1161 0029\n321C;0;;;0028 110C 116E 0029\n321D;0;;;0028 110B 1169 110C 1165 11AB 0029\n321E;0;;;0028 110B 1169 1112 116E 0029\n3220;0;;;0028 4E00 0029\n3221;0;;;0028 4E8C 0029\n3222;0;;;0028 4E09 0029\n3223;0;;;0028 56DB 0029\n3224;0;;;0028 4E94 0029\n3225;0;;;0028 516D 0029\n3226;0;;;0028 4E03 0029\n3227;0;;;0028 516B 0029\n3228;0;;;0028 4E5D 0029\n3229;0;;;0028 5341 0029\n322A;0;;;0028 6708 0029\n322B;0;;;0028 706B 0029\n322C;0;;;0028 6C34 0029\n322D;0;;;0028 6728 0029\n322E;0;;;0028 91D1 0029\n322F;0;;;0028 571F 0029\n3230;0;;;0028 65E5 0029\n3231;0;;;0028 682A 0029\n3232;0;;;0028 6709 0029\n3233;0;;;0028 793E 0029\n3234;0;;;0028 540D 0029\n3235;0;;;0028 7279 0029\n3236;0;;;0028 8CA1 0029\n3237;0;;;0028 795D 0029\n3238;0;;;0028 52B4 0029\n3239;0;;;0028 4EE3 0029\n323A;0;;;0028 547C 0029\n323B;0;;;0028 5B66 0029\n323C;0;;;0028 76E3 0029\n323D;0;;;0028 4F01 0029\n323E;0;;;0028 8CC7 0029\n323F;0;;;0028 5354 0029\n3240;0;;;0028 796D 0029\n3241;0;;;0028 4F11 0029\n3242;0;;;0028 81EA 0029\n3243;0;;;0028 81F3 0029\n3244;0;;;554F\n3245;0;;;5E7C\n3246;0;;;6587\n3247;0;;;7B8F\n3250;0;;;0050 0054 0045\n3251;0;;;0032 0031\n3252;0;;;0032 0032\n3253;0;;;0032 0033\n3254;0;;;0032 0034\n3255;0;;;0032 0035\n3256;0;;;0032 0036\n3257;0;;;0032 0037\n3258;0;;;0032 0038\n3259;0;;;0032 0039\n325A;0;;;0033 0030\n325B;0;;;0033 0031\n325C;0;;;0033 0032\n325D;0;;;0033 0033\n325E;0;;;0033 0034\n325F;0;;;0033 0035\n3260;0;;;1100\n3261;0;;;1102\n3262;0;;;1103\n3263;0;;;1105\n3264;0;;;1106\n3265;0;;;1107\n3266;0;;;1109\n3267;0;;;110B\n3268;0;;;110C\n3269;0;;;110E\n326A;0;;;110F\n326B;0;;;1110\n326C;0;;;1111\n326D;0;;;1112\n326E;0;;;1100 1161\n326F;0;;;1102 1161\n3270;0;;;1103 1161\n3271;0;;;1105 1161\n3272;0;;;1106 1161\n3273;0;;;1107 1161\n3274;0;;;1109 1161\n3275;0;;;110B 1161\n3276;0;;;110C 1161\n3277;0;;;110E 1161\n3278;0;;;110F 1161\n3279;0;;;1110 1161\n327A;0;;;1111 1161\n327B;0;;;1112 1161\n327C;0;;;110E 1161 11B7 1100 1169\n327D;0;;;110C 116E 110B 1174\n327E;0;;;110B 116E\n3280;0;;;4E00\n3281;0;;;4E8C\n3282;0;;;4E09\n3283;0;;;56DB\n3284;0;;;4E94\n3285;0;;;516D\n3286;0;;;4E03\n3287;0;;;516B\n3288;0;;;4E5D\n3289;0;;;5341\n328A;0;;;6708\n328B;0;;;706B\n328C;0;;;6C34\n328D;0;;;6728\n328E;0;;;91D1\n328F;0;;;571F\n3290;0;;;65E5\n3291;0;;;682A\n3292;0;;;6709\n3293;0;;;793E\n3294;0;;;540D\n3295;0;;;7279\n3296;0;;;8CA1\n3297;0;;;795D\n3298;0;;;52B4\n3299;0;;;79D8\n329A;0;;;7537\n329B;0;;;5973\n329C;0;;;9069\n329D;0;;;512A\n329E;0;;;5370\n329F;0;;;6CE8\n32A0;0;;;9805\n32A1;0;;;4F11\n32A2;0;;;5199\n32A3;0;;;6B63\n32A4;0;;;4E0A\n32A5;0;;;4E2D\n32A6;0;;;4E0B\n32A7;0;;;5DE6\n32A8;0;;;53F3\n32A9;0;;;533B\n32AA;0;;;5B97\n32AB;0;;;5B66\n32AC;0;;;76E3\n32AD;0;;;4F01\n32AE;0;;;8CC7\n32AF;0;;;5354\n32B0;0;;;591C\n32B1;0;;;0033 0036\n32B2;0;;;0033 0037\n32B3;0;;;0033 0038\n32B4;0;;;0033 0039\n32B5;0;;;0034 0030\n32B6;0;;;0034 0031\n32B7;0;;;0034 0032\n32B8;0;;;0034 0033\n32B9;0;;;0034 0034\n32BA;0;;;0034 0035\n32BB;0;;;0034 0036\n32BC;0;;;0034 0037\n32BD;0;;;0034 0038\n32BE;0;;;0034 0039\n32BF;0;;;0035 0030\n32C0;0;;;0031 6708\n32C1;0;;;0032 6708\n32C2;0;;;0033 6708\n32C3;0;;;0034 6708\n32C4;0;;;0035 6708\n32C5;0;;;0036 6708\n32C6;0;;;0037 6708\n32C7;0;;;0038 6708\n32C8;0;;;0039 6708\n32C9;0;;;0031 0030 6708\n32CA;0;;;0031 0031 6708\n32CB;0;;;0031 0032 6708\n32CC;0;;;0048 0067\n32CD;0;;;0065 0072 0067\n32CE;0;;;0065 0056\n32CF;0;;;004C 0054 0044\n32D0;0;;;30A2\n32D1;0;;;30A4\n32D2;0;;;30A6\n32D3;0;;;30A8\n32D4;0;;;30AA\n32D5;0;;;30AB\n32D6;0;;;30AD\n32D7;0;;;30AF\n32D8;0;;;30B1\n32D9;0;;;30B3\n32DA;0;;;30B5\n32DB;0;;;30B7\n32DC;0;;;30B9\n32DD;0;;;30BB\n32DE;0;;;30BD\n32DF;0;;;30BF\n32E0;0;;;30C1\n32E1;0;;;30C4\n32E2;0;;;30C6\n32E3;0;;;30C8\n32E4;0;;;30CA\n32E5;0;;;30CB\n32E6;0;;;30CC\n32E7;0;;;30CD\n32E8;0;;;30CE\n32E9;0;;;30CF\n32EA;0;;;30D2\n32EB;0;;;30D5\n32EC;0;;;30D8\n32ED;0;;;30DB\n32EE;0;;;30DE\n32EF;0;;;30DF\n32F0;0;;;30E0\n32F1;0;;;30E1\n32F2;0;;;30E2\n32F3;0;;;30E4\n32F4;0;;;30E6\n32F5;0;;;30E8\n32F6;0;;;30E9\n32F7;0;;;30EA\n32F8;0;;;30EB\n32F9;0;;;30EC\n32FA;0;;;30ED\n32FB;0;;;30EF\n32FC;0;;;30F0\n32FD;0;;;30F1\n32FE;0;;;30F2\n32FF;0;;;4EE4 548C\n3300;0;;;30A2 30D1 30FC 30C8\n3301;0;;;30A2 30EB 30D5 30A1\n3302;0;;;30A2 30F3 30DA 30A2\n3303;0;;;30A2 30FC 30EB\n3304;0;;;30A4 30CB 30F3 30B0\n3305;0;;;30A4 30F3 30C1\n3306;0;;;30A6 30A9 30F3\n3307;0;;;30A8 30B9 30AF 30FC 30C9\n3308;0;;;30A8 30FC 30AB 30FC\n3309;0;;;30AA 30F3 30B9\n330A;0;;;30AA 30FC 30E0\n330B;0;;;30AB 30A4 30EA\n330C;0;;;30AB 30E9 30C3 30C8\n330D;0;;;30AB 30ED 30EA 30FC\n330E;0;;;30AC 30ED 30F3\n330F;0;;;30AC 30F3 30DE\n3310;0;;;30AE 30AC\n3311;0;;;30AE 30CB 30FC\n3312;0;;;30AD 30E5 30EA 30FC\n3313;0;;;30AE 30EB 30C0 30FC\n3314;0;;;30AD 30ED\n3315;0;;;30AD 30ED 30B0 30E9 30E0\n3316;0;;;30AD 30ED 30E1 30FC 30C8 30EB\n3317;0;;;30AD 30ED 30EF 30C3 30C8\n3318;0;;;30B0 30E9 30E0\n3319;0;;;30B0 30E9 30E0 30C8 30F3\n331A;0;;;30AF 30EB 30BC 30A4 30ED\n331B;0;;;30AF 30ED 30FC 30CD\n331C;0;;;30B1 30FC 30B9\n331D;0;;;30B3 30EB 30CA\n331E;0;;;30B3 30FC 30DD\n331F;0;;;30B5 30A4 30AF 30EB\n3320;0;;;30B5 30F3 30C1 30FC 30E0\n3321;0;;;30B7 30EA 30F3 30B0\n3322;0;;;30BB 30F3 30C1\n3323;0;;;30BB 30F3 30C8\n3324;0;;;30C0 30FC 30B9\n3325;0;;;30C7 30B7\n3326;0;;;30C9 30EB\n3327;0;;;30C8 30F3\n3328;0;;;30CA 30CE\n3329;0;;;30CE 30C3 30C8\n332A;0;;;30CF 30A4 30C4\n332B;0;;;30D1 30FC 30BB 30F3 30C8\n332C;0;;;30D1 30FC 30C4\n332D;0;;;30D0 30FC 30EC 30EB\n332E;0;;;30D4 30A2 30B9 30C8 30EB\n332F;0;;;30D4 30AF 30EB\n3330;0;;;30D4 30B3\n3331;0;;;30D3 30EB\n3332;0;;;30D5 30A1 30E9 30C3 30C9\n3333;0;;;30D5 30A3 30FC 30C8\n3334;0;;;30D6 30C3 30B7 30A7 30EB\n3335;0;;;30D5 30E9 30F3\n3336;0;;;30D8 30AF 30BF 30FC 30EB\n3337;0;;;30DA 30BD\n3338;0;;;30DA 30CB 30D2\n3339;0;;;30D8 30EB 30C4\n333A;0;;;30DA 30F3 30B9\n333B;0;;;30DA 30FC 30B8\n333C;0;;;30D9 30FC 30BF\n333D;0;;;30DD 30A4 30F3 30C8\n333E;0;;;30DC 30EB 30C8\n333F;0;;;30DB 30F3\n3340;0;;;30DD 30F3 30C9\n3341;0;;;30DB 30FC 30EB\n3342;0;;;30DB 30FC 30F3\n3343;0;;;30DE 30A4 30AF 30ED\n3344;0;;;30DE 30A4 30EB\n3345;0;;;30DE 30C3 30CF\n3346;0;;;30DE 30EB 30AF\n3347;0;;;30DE 30F3 30B7 30E7 30F3\n3348;0;;;30DF 30AF 30ED 30F3\n3349;0;;;30DF 30EA\n334A;0;;;30DF 30EA 30D0 30FC 30EB\n334B;0;;;30E1 30AC\n334C;0;;;30E1 30AC 30C8 30F3\n334D;0;;;30E1 30FC 30C8 30EB\n334E;0;;;30E4 30FC 30C9\n334F;0;;;30E4 30FC 30EB\n3350;0;;;30E6 30A2 30F3\n3351;0;;;30EA 30C3 30C8 30EB\n3352;0;;;30EA 30E9\n3353;0;;;30EB 30D4 30FC\n3354;0;;;30EB 30FC 30D6 30EB\n3355;0;;;30EC 30E0\n3356;0;;;30EC 30F3 30C8 30B2 30F3\n3357;0;;;30EF 30C3 30C8\n3358;0;;;0030 70B9\n3359;0;;;0031 70B9\n335A;0;;;0032 70B9\n335B;0;;;0033 70B9\n335C;0;;;0034 70B9\n335D;0;;;0035 70B9\n335E;0;;;0036 70B9\n335F;0;;;0037 70B9\n3360;0;;;0038 70B9\n3361;0;;;0039 70B9\n3362;0;;;0031 0030 70B9\n3363;0;;;0031 0031 70B9\n3364;0;;;0031 0032 70B9\n3365;0;;;0031 0033 70B9\n3366;0;;;0031 0034 70B9\n3367;0;;;0031 0035 70B9\n3368;0;;;0031 0036 70B9\n3369;0;;;0031 0037 70B9\n336A;0;;;0031 0038 70B9\n336B;0;;;0031 0039 70B9\n336C;0;;;0032 0030 70B9\n336D;0;;;0032 0031 70B9\n336E;0;;;0032 0032 70B9\n336F;0;;;0032 0033 70B9\n3370;0;;;0032 0034 70B9\n3371;0;;;0068 0050 0061\n3372;0;;;0064 0061\n3373;0;;;0041 0055\n3374;0;;;0062 0061 0072\n3375;0;;;006F 0056\n3376;0;;;0070 0063\n3377;0;;;0064 006D\n3378;0;;;0064 006D 00B2\n3379;0;;;0064 006D 00B3\n337A;0;;;0049 0055\n337B;0;;;5E73 6210\n337C;0;;;662D 548C\n337D;0;;;5927 6B63\n337E;0;;;660E 6CBB\n337F;0;;;682A 5F0F 4F1A 793E\n3380;0;;;0070 0041\n3381;0;;;006E 0041\n3382;0;;;03BC 0041\n3383;0;;;006D 0041\n3384;0;;;006B 0041\n3385;0;;;004B 0042\n3386;0;;;004D 0042\n3387;0;;;0047 0042\n3388;0;;;0063 0061 006C\n3389;0;;;006B 0063 0061 006C\n338A;0;;;0070 0046\n338B;0;;;006E 0046\n338C;0;;;03BC 0046\n338D;0;;;03BC 0067\n338E;0;;;006D 0067\n338F;0;;;006B 0067\n3390;0;;;0048 007A\n3391;0;;;006B 0048 007A\n3392;0;;;004D 0048 007A\n3393;0;;;0047 0048 007A\n3394;0;;;0054 0048 007A\n3395;0;;;03BC 2113\n3396;0;;;006D 2113\n3397;0;;;0064 2113\n3398;0;;;006B 2113\n3399;0;;;0066 006D\n339A;0;;;006E 006D\n339B;0;;;03BC 006D\n339C;0;;;006D 006D\n339D;0;;;0063 006D\n339E;0;;;006B 006D\n339F;0;;;006D 006D 00B2\n33A0;0;;;0063 006D 00B2\n33A1;0;;;006D 00B2\n33A2;0;;;006B 006D 00B2\n33A3;0;;;006D 006D 00B3\n33A4;0;;;0063 006D 00B3\n33A5;0;;;006D 00B3\n33A6;0;;;006B 006D 00B3\n33A7;0;;;006D 2215 0073\n33A8;0;;;006D 2215 0073 00B2\n33A9;0;;;0050 0061\n33AA;0;;;006B 0050 0061\n33AB;0;;;004D 0050 0061\n33AC;0;;;0047 0050 0061\n33AD;0;;;0072 0061 0064\n33AE;0;;;0072 0061 0064 2215 0073\n33AF;0;;;0072 0061 0064 2215 0073 00B2\n33B0;0;;;0070 0073\n33B1;0;;;006E 0073\n33B2;0;;;03BC 0073\n33B3;0;;;006D 0073\n33B4;0;;;0070 0056\n33B5;0;;;006E 0056\n33B6;0;;;03BC 0056\n33B7;0;;;006D 0056\n33B8;0;;;006B 0056\n33B9;0;;;004D 0056\n33BA;0;;;0070 0057\n33BB;0;;;006E 0057\n33BC;0;;;03BC 0057\n33BD;0;;;006D 0057\n33BE;0;;;006B 0057\n33BF;0;;;004D 0057\n33C0;0;;;006B 03A9\n33C1;0;;;004D 03A9\n33C2;0;;;0061 002E 006D 002E\n33C3;0;;;0042 0071\n33C4;0;;;0063 0063\n33C5;0;;;0063 0064\n33C6;0;;;0043 2215 006B 0067\n33C7;0;;;0043 006F 002E\n33C8;0;;;0064 0042\n33C9;0;;;0047 0079\n33CA;0;;;0068 0061\n33CB;0;;;0048 0050\n33CC;0;;;0069 006E\n33CD;0;;;004B 004B\n33CE;0;;;004B 004D\n33CF;0;;;006B 0074\n33D0;0;;;006C 006D\n33D1;0;;;006C 006E\n33D2;0;;;006C 006F 0067\n33D3;0;;;006C 0078\n33D4;0;;;006D 0062\n33D5;0;;;006D 0069 006C\n33D6;0;;;006D 006F 006C\n33D7;0;;;0050 0048\n33D8;0;;;0070 002E 006D 002E\n33D9;0;;;0050 0050 004D\n33DA;0;;;0050 0052\n33DB;0;;;0073 0072\n33DC;0;;;0053 0076\n33DD;0;;;0057 0062\n33DE;0;;;0056 2215 006D\n33DF;0;;;0041 2215 006D\n33E0;0;;;0031 65E5\n33E1;0;;;0032 65E5\n33E2;0;;;0033 65E5\n33E3;0;;;0034 65E5\n33E4;0;;;0035 65E5\n33E5;0;;;0036 65E5\n33E6;0;;;0037 65E5\n33E7;0;;;0038 65E5\n33E8;0;;;0039 65E5\n33E9;0;;;0031 0030 65E5\n33EA;0;;;0031 0031 65E5\n33EB;0;;;0031 0032 65E5\n33EC;0;;;0031 0033 65E5\n33ED;0;;;0031 0034 65E5\n33EE;0;;;0031 0035 65E5\n33EF;0;;;0031 0036 65E5\n33F0;0;;;0031 0037 65E5\n33F1;0;;;0031 0038 65E5\n33F2;0;;;0031 0039 65E5\n33F3;0;;;0032 0030 65E5\n33F4;0;;;0032 0031 65E5\n33F5;0;;;0032 0032 65E5\n33F6;0;;;0032 0033 65E5\n33F7;0;;;0032 0034 65E5\n33F8;0;;;0032 0035 65E5\n33F9;0;;;0032 0036 65E5\n33FA;0;;;0032 0037 65E5\n33FB;0;;;0032 0038 65E5\n33FC;0;;;0032 0039 65E5\n33FD;0;;;0033 0030 65E5\n33FE;0;;;0033 0031 65E5\n33FF;0;;;0067 0061 006C\nA66F;230;\nA674;230;\nA675;230;\nA676;230;\nA677;230;\nA678;230;\nA679;230;\nA67A;230;\nA67B;230;\nA67C;230;\nA67D;230;\nA69C;0;;;044A\nA69D;0;;;044C\nA69E;230;\nA69F;230;\nA6F0;230;\nA6F1;230;\nA770;0;;;A76F\nA7F2;0;;;0043\nA7F3;0;;;0046\nA7F4;0;;;0051\nA7F8;0;;;0126\nA7F9;0;;;0153\nA806;9;\nA82C;9;\nA8C4;9;\nA8E0;230;\nA8E1;230;\nA8E2;230;\nA8E3;230;\nA8E4;230;\nA8E5;230;\nA8E6;230;\nA8E7;230;\nA8E8;230;\nA8E9;230;\nA8EA;230;\nA8EB;230;\nA8EC;230;\nA8ED;230;\nA8EE;230;\nA8EF;230;\nA8F0;230;\nA8F1;230;\nA92B;220;\nA92C;220;\nA92D;220;\nA953;9;\nA9B3;7;\nA9C0;9;\nAAB0;230;\nAAB2;230;\nAAB3;230;\nAAB4;220;\nAAB7;230;\nAAB8;230;\nAABE;230;\nAABF;230;\nAAC1;230;\nAAF6;9;\nAB5C;0;;;A727\nAB5D;0;;;AB37\nAB5E;0;;;026B\nAB5F;0;;;AB52\nAB69;0;;;028D\nABED;9;\nF900;0;8C48;x\nF901;0;66F4;x\nF902;0;8ECA;x\nF903;0;8CC8;x\nF904;0;6ED1;x\nF905;0;4E32;x\nF906;0;53E5;x\nF907;0;9F9C;x\nF908;0;9F9C;x\nF909;0;5951;x\nF90A;0;91D1;x\nF90B;0;5587;x\nF90C;0;5948;x\nF90D;0;61F6;x\nF90E;0;7669;x\nF90F;0;7F85;x\nF910;0;863F;x\nF911;0;87BA;x\nF912;0;88F8;x\nF913;0;908F;x\nF914;0;6A02;x\nF915;0;6D1B;x\nF916;0;70D9;x\nF917;0;73DE;x\nF918;0;843D;x\nF919;0;916A;x\nF91A;0;99F1;x\nF91B;0;4E82;x\nF91C;0;5375;x\nF91D;0;6B04;x\nF91E;0;721B;x\nF91F;0;862D;x\nF920;0;9E1E;x\nF921;0;5D50;x\nF922;0;6FEB;x\nF923;0;85CD;x\nF924;0;8964;x\nF925;0;62C9;x\nF926;0;81D8;x\nF927;0;881F;x\nF928;0;5ECA;x\nF929;0;6717;x\nF92A;0;6D6A;x\nF92B;0;72FC;x\nF92C;0;90CE;x\nF92D;0;4F86;x\nF92E;0;51B7;x\nF92F;0;52DE;x\nF930;0;64C4;x\nF931;0;6AD3;x\nF932;0;7210;x\nF933;0;76E7;x\nF934;0;8001;x\nF935;0;8606;x\nF936;0;865C;x\nF937;0;8DEF;x\nF938;0;9732;x\nF939;0;9B6F;x\nF93A;0;9DFA;x\nF93B;0;788C;x\nF93C;0;797F;x\nF93D;0;7DA0;x\nF93E;0;83C9;x\nF93F;0;9304;x\nF940;0;9E7F;x\nF941;0;8AD6;x\nF942;0;58DF;x\nF943;0;5F04;x\nF944;0;7C60;x\nF945;0;807E;x\nF946;0;7262;x\nF947;0;78CA;x\nF948;0;8CC2;x\nF949;0;96F7;x\nF94A;0;58D8;x\nF94B;0;5C62;x\nF94C;0;6A13;x\nF94D;0;6DDA;x\nF94E;0;6F0F;x\nF94F;0;7D2F;x\nF950;0;7E37;x\nF951;0;964B;x\nF952;0;52D2;x\nF953;0;808B;x\nF954;0;51DC;x\nF955;0;51CC;x\nF956;0;7A1C;x\nF957;0;7DBE;x\nF958;0;83F1;x\nF959;0;9675;x\nF95A;0;8B80;x\nF95B;0;62CF;x\nF95C;0;6A02;x\nF95D;0;8AFE;x\nF95E;0;4E39;x\nF95F;0;5BE7;x\nF960;0;6012;x\nF961;0;7387;x\nF962;0;7570;x\nF963;0;5317;x\nF964;0;78FB;x\nF965;0;4FBF;x\nF966;0;5FA9;x\nF967;0;4E0D;x\nF968;0;6CCC;x\nF969;0;6578;x\nF96A;0;7D22;x\nF96B;0;53C3;x\nF96C;0;585E;x\nF96D;0;7701;x\nF96E;0;8449;x\nF96F;0;8AAA;x\nF970;0;6BBA;x\nF971;0;8FB0;x\nF972;0;6C88;x\nF973;0;62FE;x\nF974;0;82E5;x\nF975;0;63A0;x\nF976;0;7565;x\nF977;0;4EAE;x\nF978;0;5169;x\nF979;0;51C9;x\nF97A;0;6881;x\nF97B;0;7CE7;x\nF97C;0;826F;x\nF97D;0;8AD2;x\nF97E;0;91CF;x\nF97F;0;52F5;x\nF980;0;5442;x\nF981;0;5973;x\nF982;0;5EEC;x\nF983;0;65C5;x\nF984;0;6FFE;x\nF985;0;792A;x\nF986;0;95AD;x\nF987;0;9A6A;x\nF988;0;9E97;x\nF989;0;9ECE;x\nF98A;0;529B;x\nF98B;0;66C6;x\nF98C;0;6B77;x\nF98D;0;8F62;x\nF98E;0;5E74;x\nF98F;0;6190;x\nF990;0;6200;x\nF991;0;649A;x\nF992;0;6F23;x\nF993;0;7149;x\nF994;0;7489;x\nF995;0;79CA;x\nF996;0;7DF4;x\nF997;0;806F;x\nF998;0;8F26;x\nF999;0;84EE;x\nF99A;0;9023;x\nF99B;0;934A;x\nF99C;0;5217;x\nF99D;0;52A3;x\nF99E;0;54BD;x\nF99F;0;70C8;x\nF9A0;0;88C2;x\nF9A1;0;8AAA;x\nF9A2;0;5EC9;x\nF9A3;0;5FF5;x\nF9A4;0;637B;x\nF9A5;0;6BAE;x\nF9A6;0;7C3E;x\nF9A7;0;7375;x\nF9A8;0;4EE4;x\nF9A9;0;56F9;x\nF9AA;0;5BE7;x\nF9AB;0;5DBA;x\nF9AC;0;601C;x\nF9AD;0;73B2;x\nF9AE;0;7469;x\nF9AF;0;7F9A;x\nF9B0;0;8046;x\nF9B1;0;9234;x\nF9B2;0;96F6;x\nF9B3;0;9748;x\nF9B4;0;9818;x\nF9B5;0;4F8B;x\nF9B6;0;79AE;x\nF9B7;0;91B4;x\nF9B8;0;96B8;x\nF9B9;0;60E1;x\nF9BA;0;4E86;x\nF9BB;0;50DA;x\nF9BC;0;5BEE;x\nF9BD;0;5C3F;x\nF9BE;0;6599;x\nF9BF;0;6A02;x\nF9C0;0;71CE;x\nF9C1;0;7642;x\nF9C2;0;84FC;x\nF9C3;0;907C;x\nF9C4;0;9F8D;x\nF9C5;0;6688;x\nF9C6;0;962E;x\nF9C7;0;5289;x\nF9C8;0;677B;x\nF9C9;0;67F3;x\nF9CA;0;6D41;x\nF9CB;0;6E9C;x\nF9CC;0;7409;x\nF9CD;0;7559;x\nF9CE;0;786B;x\nF9CF;0;7D10;x\nF9D0;0;985E;x\nF9D1;0;516D;x\nF9D2;0;622E;x\nF9D3;0;9678;x\nF9D4;0;502B;x\nF9D5;0;5D19;x\nF9D6;0;6DEA;x\nF9D7;0;8F2A;x\nF9D8;0;5F8B;x\nF9D9;0;6144;x\nF9DA;0;6817;x\nF9DB;0;7387;x\nF9DC;0;9686;x\nF9DD;0;5229;x\nF9DE;0;540F;x\nF9DF;0;5C65;x\nF9E0;0;6613;x\nF9E1;0;674E;x\nF9E2;0;68A8;x\nF9E3;0;6CE5;x\nF9E4;0;7406;x\nF9E5;0;75E2;x\nF9E6;0;7F79;x\nF9E7;0;88CF;x\nF9E8;0;88E1;x\nF9E9;0;91CC;x\nF9EA;0;96E2;x\nF9EB;0;533F;x\nF9EC;0;6EBA;x\nF9ED;0;541D;x\nF9EE;0;71D0;x\nF9EF;0;7498;x\nF9F0;0;85FA;x\nF9F1;0;96A3;x\nF9F2;0;9C57;x\nF9F3;0;9E9F;x\nF9F4;0;6797;x\nF9F5;0;6DCB;x\nF9F6;0;81E8;x\nF9F7;0;7ACB;x\nF9F8;0;7B20;x\nF9F9;0;7C92;x\nF9FA;0;72C0;x\nF9FB;0;7099;x\nF9FC;0;8B58;x\nF9FD;0;4EC0;x\nF9FE;0;8336;x\nF9FF;0;523A;x\nFA00;0;5207;x\nFA01;0;5EA6;x\nFA02;0;62D3;x\nFA03;0;7CD6;x\nFA04;0;5B85;x\nFA05;0;6D1E;x\nFA06;0;66B4;x\nFA07;0;8F3B;x\nFA08;0;884C;x\nFA09;0;964D;x\nFA0A;0;898B;x\nFA0B;0;5ED3;x\nFA0C;0;5140;x\nFA0D;0;55C0;x\nFA10;0;585A;x\nFA12;0;6674;x\nFA15;0;51DE;x\nFA16;0;732A;x\nFA17;0;76CA;x\nFA18;0;793C;x\nFA19;0;795E;x\nFA1A;0;7965;x\nFA1B;0;798F;x\nFA1C;0;9756;x\nFA1D;0;7CBE;x\nFA1E;0;7FBD;x\nFA20;0;8612;x\nFA22;0;8AF8;x\nFA25;0;9038;x\nFA26;0;90FD;x\nFA2A;0;98EF;x\nFA2B;0;98FC;x\nFA2C;0;9928;x\nFA2D;0;9DB4;x\nFA2E;0;90DE;x\nFA2F;0;96B7;x\nFA30;0;4FAE;x\nFA31;0;50E7;x\nFA32;0;514D;x\nFA33;0;52C9;x\nFA34;0;52E4;x\nFA35;0;5351;x\nFA36;0;559D;x\nFA37;0;5606;x\nFA38;0;5668;x\nFA39;0;5840;x\nFA3A;0;58A8;x\nFA3B;0;5C64;x\nFA3C;0;5C6E;x\nFA3D;0;6094;x\nFA3E;0;6168;x\nFA3F;0;618E;x\nFA40;0;61F2;x\nFA41;0;654F;x\nFA42;0;65E2;x\nFA43;0;6691;x\nFA44;0;6885;x\nFA45;0;6D77;x\nFA46;0;6E1A;x\nFA47;0;6F22;x\nFA48;0;716E;x\nFA49;0;722B;x\nFA4A;0;7422;x\nFA4B;0;7891;x\nFA4C;0;793E;x\nFA4D;0;7949;x\nFA4E;0;7948;x\nFA4F;0;7950;x\nFA50;0;7956;x\nFA51;0;795D;x\nFA52;0;798D;x\nFA53;0;798E;x\nFA54;0;7A40;x\nFA55;0;7A81;x\nFA56;0;7BC0;x\nFA57;0;7DF4;x\nFA58;0;7E09;x\nFA59;0;7E41;x\nFA5A;0;7F72;x\nFA5B;0;8005;x\nFA5C;0;81ED;x\nFA5D;0;8279;x\nFA5E;0;8279;x\nFA5F;0;8457;x\nFA60;0;8910;x\nFA61;0;8996;x\nFA62;0;8B01;x\nFA63;0;8B39;x\nFA64;0;8CD3;x\nFA65;0;8D08;x\nFA66;0;8FB6;x\nFA67;0;9038;x\nFA68;0;96E3;x\nFA69;0;97FF;x\nFA6A;0;983B;x\nFA6B;0;6075;x\nFA6C;0;242EE;x\nFA6D;0;8218;x\nFA70;0;4E26;x\nFA71;0;51B5;x\nFA72;0;5168;x\nFA73;0;4F80;x\nFA74;0;5145;x\nFA75;0;5180;x\nFA76;0;52C7;x\nFA77;0;52FA;x\nFA78;0;559D;x\nFA79;0;5555;x\nFA7A;0;5599;x\nFA7B;0;55E2;x\nFA7C;0;585A;x\nFA7D;0;58B3;x\nFA7E;0;5944;x\nFA7F;0;5954;x\nFA80;0;5A62;x\nFA81;0;5B28;x\nFA82;0;5ED2;x\nFA83;0;5ED9;x\nFA84;0;5F69;x\nFA85;0;5FAD;x\nFA86;0;60D8;x\nFA87;0;614E;x\nFA88;0;6108;x\nFA89;0;618E;x\nFA8A;0;6160;x\nFA8B;0;61F2;x\nFA8C;0;6234;x\nFA8D;0;63C4;x\nFA8E;0;641C;x\nFA8F;0;6452;x\nFA90;0;6556;x\nFA91;0;6674;x\nFA92;0;6717;x\nFA93;0;671B;x\nFA94;0;6756;x\nFA95;0;6B79;x\nFA96;0;6BBA;x\nFA97;0;6D41;x\nFA98;0;6EDB;x\nFA99;0;6ECB;x\nFA9A;0;6F22;x\nFA9B;0;701E;x\nFA9C;0;716E;x\nFA9D;0;77A7;x\nFA9E;0;7235;x\nFA9F;0;72AF;x\nFAA0;0;732A;x\nFAA1;0;7471;x\nFAA2;0;7506;x\nFAA3;0;753B;x\nFAA4;0;761D;x\nFAA5;0;761F;x\nFAA6;0;76CA;x\nFAA7;0;76DB;x\nFAA8;0;76F4;x\nFAA9;0;774A;x\nFAAA;0;7740;x\nFAAB;0;78CC;x\nFAAC;0;7AB1;x\nFAAD;0;7BC0;x\nFAAE;0;7C7B;x\nFAAF;0;7D5B;x\nFAB0;0;7DF4;x\nFAB1;0;7F3E;x\nFAB2;0;8005;x\nFAB3;0;8352;x\nFAB4;0;83EF;x\nFAB5;0;8779;x\nFAB6;0;8941;x\nFAB7;0;8986;x\nFAB8;0;8996;x\nFAB9;0;8ABF;x\nFABA;0;8AF8;x\nFABB;0;8ACB;x\nFABC;0;8B01;x\nFABD;0;8AFE;x\nFABE;0;8AED;x\nFABF;0;8B39;x\nFAC0;0;8B8A;x\nFAC1;0;8D08;x\nFAC2;0;8F38;x\nFAC3;0;9072;x\nFAC4;0;9199;x\nFAC5;0;9276;x\nFAC6;0;967C;x\nFAC7;0;96E3;x\nFAC8;0;9756;x\nFAC9;0;97DB;x\nFACA;0;97FF;x\nFACB;0;980B;x\nFACC;0;983B;x\nFACD;0;9B12;x\nFACE;0;9F9C;x\nFACF;0;2284A;x\nFAD0;0;22844;x\nFAD1;0;233D5;x\nFAD2;0;3B9D;x\nFAD3;0;4018;x\nFAD4;0;4039;x\nFAD5;0;25249;x\nFAD6;0;25CD0;x\nFAD7;0;27ED3;x\nFAD8;0;9F43;x\nFAD9;0;9F8E;x\nFB00;0;;;0066 0066\nFB01;0;;;0066 0069\nFB02;0;;;0066 006C\nFB03;0;;;0066 0066 0069\nFB04;0;;;0066 0066 006C\nFB05;0;;;017F 0074\nFB06;0;;;0073 0074\nFB13;0;;;0574 0576\nFB14;0;;;0574 0565\nFB15;0;;;0574 056B\nFB16;0;;;057E 0576\nFB17;0;;;0574 056D\nFB1D;0;05D9 05B4;x\nFB1E;26;\nFB1F;0;05F2 05B7;x\nFB20;0;;;05E2\nFB21;0;;;05D0\nFB22;0;;;05D3\nFB23;0;;;05D4\nFB24;0;;;05DB\nFB25;0;;;05DC\nFB26;0;;;05DD\nFB27;0;;;05E8\nFB28;0;;;05EA\nFB29;0;;;002B\nFB2A;0;05E9 05C1;x\nFB2B;0;05E9 05C2;x\nFB2C;0;FB49 05C1;x\nFB2D;0;FB49 05C2;x\nFB2E;0;05D0 05B7;x\nFB2F;0;05D0 05B8;x\nFB30;0;05D0 05BC;x\nFB31;0;05D1 05BC;x\nFB32;0;05D2 05BC;x\nFB33;0;05D3 05BC;x\nFB34;0;05D4 05BC;x\nFB35;0;05D5 05BC;x\nFB36;0;05D6 05BC;x\nFB38;0;05D8 05BC;x\nFB39;0;05D9 05BC;x\nFB3A;0;05DA 05BC;x\nFB3B;0;05DB 05BC;x\nFB3C;0;05DC 05BC;x\nFB3E;0;05DE 05BC;x\nFB40;0;05E0 05BC;x\nFB41;0;05E1 05BC;x\nFB43;0;05E3 05BC;x\nFB44;0;05E4 05BC;x\nFB46;0;05E6 05BC;x\nFB47;0;05E7 05BC;x\nFB48;0;05E8 05BC;x\nFB49;0;05E9 05BC;x\nFB4A;0;05EA 05BC;x\nFB4B;0;05D5 05B9;x\nFB4C;0;05D1 05BF;x\nFB4D;0;05DB 05BF;x\nFB4E;0;05E4 05BF;x\nFB4F;0;;;05D0 05DC\nFB50;0;;;0671\nFB51;0;;;0671\nFB52;0;;;067B\nFB53;0;;;067B\nFB54;0;;;067B\nFB55;0;;;067B\nFB56;0;;;067E\nFB57;0;;;067E\nFB58;0;;;067E\nFB59;0;;;067E\nFB5A;0;;;0680\nFB5B;0;;;0680\nFB5C;0;;;0680\nFB5D;0;;;0680\nFB5E;0;;;067A\nFB5F;0;;;067A\nFB60;0;;;067A\nFB61;0;;;067A\nFB62;0;;;067F\nFB63;0;;;067F\nFB64;0;;;067F\nFB65;0;;;067F\nFB66;0;;;0679\nFB67;0;;;0679\nFB68;0;;;0679\nFB69;0;;;0679\nFB6A;0;;;06A4\nFB6B;0;;;06A4\nFB6C;0;;;06A4\nFB6D;0;;;06A4\nFB6E;0;;;06A6\nFB6F;0;;;06A6\nFB70;0;;;06A6\nFB71;0;;;06A6\nFB72;0;;;0684\nFB73;0;;;0684\nFB74;0;;;0684\nFB75;0;;;0684\nFB76;0;;;0683\nFB77;0;;;0683\nFB78;0;;;0683\nFB79;0;;;0683\nFB7A;0;;;0686\nFB7B;0;;;0686\nFB7C;0;;;0686\nFB7D;0;;;0686\nFB7E;0;;;0687\nFB7F;0;;;0687\nFB80;0;;;0687\nFB81;0;;;0687\nFB82;0;;;068D\nFB83;0;;;068D\nFB84;0;;;068C\nFB85;0;;;068C\nFB86;0;;;068E\nFB87;0;;;068E\nFB88;0;;;0688\nFB89;0;;;0688\nFB8A;0;;;0698\nFB8B;0;;;0698\nFB8C;0;;;0691\nFB8D;0;;;0691\nFB8E;0;;;06A9\nFB8F;0;;;06A9\nFB90;0;;;06A9\nFB91;0;;;06A9\nFB92;0;;;06AF\nFB93;0;;;06AF\nFB94;0;;;06AF\nFB95;0;;;06AF\nFB96;0;;;06B3\nFB97;0;;;06B3\nFB98;0;;;06B3\nFB99;0;;;06B3\nFB9A;0;;;06B1\nFB9B;0;;;06B1\nFB9C;0;;;06B1\nFB9D;0;;;06B1\nFB9E;0;;;06BA\nFB9F;0;;;06BA\nFBA0;0;;;06BB\nFBA1;0;;;06BB\nFBA2;0;;;06BB\nFBA3;0;;;06BB\nFBA4;0;;;06C0\nFBA5;0;;;06C0\nFBA6;0;;;06C1\nFBA7;0;;;06C1\nFBA8;0;;;06C1\nFBA9;0;;;06C1\nFBAA;0;;;06BE\nFBAB;0;;;06BE\nFBAC;0;;;06BE\nFBAD;0;;;06BE\nFBAE;0;;;06D2\nFBAF;0;;;06D2\nFBB0;0;;;06D3\nFBB1;0;;;06D3\nFBD3;0;;;06AD\nFBD4;0;;;06AD\nFBD5;0;;;06AD\nFBD6;0;;;06AD\nFBD7;0;;;06C7\nFBD8;0;;;06C7\nFBD9;0;;;06C6\nFBDA;0;;;06C6\nFBDB;0;;;06C8\nFBDC;0;;;06C8\nFBDD;0;;;0677\nFBDE;0;;;06CB\nFBDF;0;;;06CB\nFBE0;0;;;06C5\nFBE1;0;;;06C5\nFBE2;0;;;06C9\nFBE3;0;;;06C9\nFBE4;0;;;06D0\nFBE5;0;;;06D0\nFBE6;0;;;06D0\nFBE7;0;;;06D0\nFBE8;0;;;0649\nFBE9;0;;;0649\nFBEA;0;;;0626 0627\nFBEB;0;;;0626 0627\nFBEC;0;;;0626 06D5\nFBED;0;;;0626 06D5\nFBEE;0;;;0626 0648\nFBEF;0;;;0626 0648\nFBF0;0;;;0626 06C7\nFBF1;0;;;0626 06C7\nFBF2;0;;;0626 06C6\nFBF3;0;;;0626 06C6\nFBF4;0;;;0626 06C8\nFBF5;0;;;0626 06C8\nFBF6;0;;;0626 06D0\nFBF7;0;;;0626 06D0\nFBF8;0;;;0626 06D0\nFBF9;0;;;0626 0649\nFBFA;0;;;0626 0649\nFBFB;0;;;0626 0649\nFBFC;0;;;06CC\nFBFD;0;;;06CC\nFBFE;0;;;06CC\nFBFF;0;;;06CC\nFC00;0;;;0626 062C\nFC01;0;;;0626 062D\nFC02;0;;;0626 0645\nFC03;0;;;0626 0649\nFC04;0;;;0626 064A\nFC05;0;;;0628 062C\nFC06;0;;;0628 062D\nFC07;0;;;0628 062E\nFC08;0;;;0628 0645\nFC09;0;;;0628 0649\nFC0A;0;;;0628 064A\nFC0B;0;;;062A 062C\nFC0C;0;;;062A 062D\nFC0D;0;;;062A 062E\nFC0E;0;;;062A 0645\nFC0F;0;;;062A 0649\nFC10;0;;;062A 064A\nFC11;0;;;062B 062C\nFC12;0;;;062B 0645\nFC13;0;;;062B 0649\nFC14;0;;;062B 064A\nFC15;0;;;062C 062D\nFC16;0;;;062C 0645\nFC17;0;;;062D 062C\nFC18;0;;;062D 0645\nFC19;0;;;062E 062C\nFC1A;0;;;062E 062D\nFC1B;0;;;062E 0645\nFC1C;0;;;0633 062C\nFC1D;0;;;0633 062D\nFC1E;0;;;0633 062E\nFC1F;0;;;0633 0645\nFC20;0;;;0635 062D\nFC21;0;;;0635 0645\nFC22;0;;;0636 062C\nFC23;0;;;0636 062D\nFC24;0;;;0636 062E\nFC25;0;;;0636 0645\nFC26;0;;;0637 062D\nFC27;0;;;0637 0645\nFC28;0;;;0638 0645\nFC29;0;;;0639 062C\nFC2A;0;;;0639 0645\nFC2B;0;;;063A 062C\nFC2C;0;;;063A 0645\nFC2D;0;;;0641 062C\nFC2E;0;;;0641 062D\nFC2F;0;;;0641 062E\nFC30;0;;;0641 0645\nFC31;0;;;0641 0649\nFC32;0;;;0641 064A\nFC33;0;;;0642 062D\nFC34;0;;;0642 0645\nFC35;0;;;0642 0649\nFC36;0;;;0642 064A\nFC37;0;;;0643 0627\nFC38;0;;;0643 062C\nFC39;0;;;0643 062D\nFC3A;0;;;0643 062E\nFC3B;0;;;0643 0644\nFC3C;0;;;0643 0645\nFC3D;0;;;0643 0649\nFC3E;0;;;0643 064A\nFC3F;0;;;0644 062C\nFC40;0;;;0644 062D\nFC41;0;;;0644 062E\nFC42;0;;;0644 0645\nFC43;0;;;0644 0649\nFC44;0;;;0644 064A\nFC45;0;;;0645 062C\nFC46;0;;;0645 062D\nFC47;0;;;0645 062E\nFC48;0;;;0645 0645\nFC49;0;;;0645 0649\nFC4A;0;;;0645 064A\nFC4B;0;;;0646 062C\nFC4C;0;;;0646 062D\nFC4D;0;;;0646 062E\nFC4E;0;;;0646 0645\nFC4F;0;;;0646 0649\nFC50;0;;;0646 064A\nFC51;0;;;0647 062C\nFC52;0;;;0647 0645\nFC53;0;;;0647 0649\nFC54;0;;;0647 064A\nFC55;0;;;064A 062C\nFC56;0;;;064A 062D\nFC57;0;;;064A 062E\nFC58;0;;;064A 0645\nFC59;0;;;064A 0649\nFC5A;0;;;064A 064A\nFC5B;0;;;0630 0670\nFC5C;0;;;0631 0670\nFC5D;0;;;0649 0670\nFC5E;0;;;0020 064C 0651\nFC5F;0;;;0020 064D 0651\nFC60;0;;;0020 064E 0651\nFC61;0;;;0020 064F 0651\nFC62;0;;;0020 0650 0651\nFC63;0;;;0020 0651 0670\nFC64;0;;;0626 0631\nFC65;0;;;0626 0632\nFC66;0;;;0626 0645\nFC67;0;;;0626 0646\nFC68;0;;;0626 0649\nFC69;0;;;0626 064A\nFC6A;0;;;0628 0631\nFC6B;0;;;0628 0632\nFC6C;0;;;0628 0645\nFC6D;0;;;0628 0646\nFC6E;0;;;0628 0649\nFC6F;0;;;0628 064A\nFC70;0;;;062A 0631\nFC71;0;;;062A 0632\nFC72;0;;;062A 0645\nFC73;0;;;062A 0646\nFC74;0;;;062A 0649\nFC75;0;;;062A 064A\nFC76;0;;;062B 0631\nFC77;0;;;062B 0632\nFC78;0;;;062B 0645\nFC79;0;;;062B 0646\nFC7A;0;;;062B 0649\nFC7B;0;;;062B 064A\nFC7C;0;;;0641 0649\nFC7D;0;;;0641 064A\nFC7E;0;;;0642 0649\nFC7F;0;;;0642 064A\nFC80;0;;;0643 0627\nFC81;0;;;0643 0644\nFC82;0;;;0643 0645\nFC83;0;;;0643 0649\nFC84;0;;;0643 064A\nFC85;0;;;0644 0645\nFC86;0;;;0644 0649\nFC87;0;;;0644 064A\nFC88;0;;;0645 0627\nFC89;0;;;0645 0645\nFC8A;0;;;0646 0631\nFC8B;0;;;0646 0632\nFC8C;0;;;0646 0645\nFC8D;0;;;0646 0646\nFC8E;0;;;0646 0649\nFC8F;0;;;0646 064A\nFC90;0;;;0649 0670\nFC91;0;;;064A 0631\nFC92;0;;;064A 0632\nFC93;0;;;064A 0645\nFC94;0;;;064A 0646\nFC95;0;;;064A 0649\nFC96;0;;;064A 064A\nFC97;0;;;0626 062C\nFC98;0;;;0626 062D\nFC99;0;;;0626 062E\nFC9A;0;;;0626 0645\nFC9B;0;;;0626 0647\nFC9C;0;;;0628 062C\nFC9D;0;;;0628 062D\nFC9E;0;;;0628 062E\nFC9F;0;;;0628 0645\nFCA0;0;;;0628 0647\nFCA1;0;;;062A 062C\nFCA2;0;;;062A 062D\nFCA3;0;;;062A 062E\nFCA4;0;;;062A 0645\nFCA5;0;;;062A 0647\nFCA6;0;;;062B 0645\nFCA7;0;;;062C 062D\nFCA8;0;;;062C 0645\nFCA9;0;;;062D 062C\nFCAA;0;;;062D 0645\nFCAB;0;;;062E 062C\nFCAC;0;;;062E 0645\nFCAD;0;;;0633 062C\nFCAE;0;;;0633 062D\nFCAF;0;;;0633 062E\nFCB0;0;;;0633 0645\nFCB1;0;;;0635 062D\nFCB2;0;;;0635 062E\nFCB3;0;;;0635 0645\nFCB4;0;;;0636 062C\nFCB5;0;;;0636 062D\nFCB6;0;;;0636 062E\nFCB7;0;;;0636 0645\nFCB8;0;;;0637 062D\nFCB9;0;;;0638 0645\nFCBA;0;;;0639 062C\nFCBB;0;;;0639 0645\nFCBC;0;;;063A 062C\nFCBD;0;;;063A 0645\nFCBE;0;;;0641 062C\nFCBF;0;;;0641 062D\nFCC0;0;;;0641 062E\nFCC1;0;;;0641 0645\nFCC2;0;;;0642 062D\nFCC3;0;;;0642 0645\nFCC4;0;;;0643 062C\nFCC5;0;;;0643 062D\nFCC6;0;;;0643 062E\nFCC7;0;;;0643 0644\nFCC8;0;;;0643 0645\nFCC9;0;;;0644 062C\nFCCA;0;;;0644 062D\nFCCB;0;;;0644 062E\nFCCC;0;;;0644 0645\nFCCD;0;;;0644 0647\nFCCE;0;;;0645 062C\nFCCF;0;;;0645 062D\nFCD0;0;;;0645 062E\nFCD1;0;;;0645 0645\nFCD2;0;;;0646 062C\nFCD3;0;;;0646 062D\nFCD4;0;;;0646 062E\nFCD5;0;;;0646 0645\nFCD6;0;;;0646 0647\nFCD7;0;;;0647 062C\nFCD8;0;;;0647 0645\nFCD9;0;;;0647 0670\nFCDA;0;;;064A 062C\nFCDB;0;;;064A 062D\nFCDC;0;;;064A 062E\nFCDD;0;;;064A 0645\nFCDE;0;;;064A 0647\nFCDF;0;;;0626 0645\nFCE0;0;;;0626 0647\nFCE1;0;;;0628 0645\nFCE2;0;;;0628 0647\nFCE3;0;;;062A 0645\nFCE4;0;;;062A 0647\nFCE5;0;;;062B 0645\nFCE6;0;;;062B 0647\nFCE7;0;;;0633 0645\nFCE8;0;;;0633 0647\nFCE9;0;;;0634 0645\nFCEA;0;;;0634 0647\nFCEB;0;;;0643 0644\nFCEC;0;;;0643 0645\nFCED;0;;;0644 0645\nFCEE;0;;;0646 0645\nFCEF;0;;;0646 0647\nFCF0;0;;;064A 0645\nFCF1;0;;;064A 0647\nFCF2;0;;;0640 064E 0651\nFCF3;0;;;0640 064F 0651\nFCF4;0;;;0640 0650 0651\nFCF5;0;;;0637 0649\nFCF6;0;;;0637 064A\nFCF7;0;;;0639 0649\nFCF8;0;;;0639 064A\nFCF9;0;;;063A 0649\nFCFA;0;;;063A 064A\nFCFB;0;;;0633 0649\nFCFC;0;;;0633 064A\nFCFD;0;;;0634 0649\nFCFE;0;;;0634 064A\nFCFF;0;;;062D 0649\nFD00;0;;;062D 064A\nFD01;0;;;062C 0649\nFD02;0;;;062C 064A\nFD03;0;;;062E 0649\nFD04;0;;;062E 064A\nFD05;0;;;0635 0649\nFD06;0;;;0635 064A\nFD07;0;;;0636 0649\nFD08;0;;;0636 064A\nFD09;0;;;0634 062C\nFD0A;0;;;0634 062D\nFD0B;0;;;0634 062E\nFD0C;0;;;0634 0645\nFD0D;0;;;0634 0631\nFD0E;0;;;0633 0631\nFD0F;0;;;0635 0631\nFD10;0;;;0636 0631\nFD11;0;;;0637 0649\nFD12;0;;;0637 064A\nFD13;0;;;0639 0649\nFD14;0;;;0639 064A\nFD15;0;;;063A 0649\nFD16;0;;;063A 064A\nFD17;0;;;0633 0649\nFD18;0;;;0633 064A\nFD19;0;;;0634 0649\nFD1A;0;;;0634 064A\nFD1B;0;;;062D 0649\nFD1C;0;;;062D 064A\nFD1D;0;;;062C 0649\nFD1E;0;;;062C 064A\nFD1F;0;;;062E 0649\nFD20;0;;;062E 064A\nFD21;0;;;0635 0649\nFD22;0;;;0635 064A\nFD23;0;;;0636 0649\nFD24;0;;;0636 064A\nFD25;0;;;0634 062C\nFD26;0;;;0634 062D\nFD27;0;;;0634 062E\nFD28;0;;;0634 0645\nFD29;0;;;0634 0631\nFD2A;0;;;0633 0631\nFD2B;0;;;0635 0631\nFD2C;0;;;0636 0631\nFD2D;0;;;0634 062C\nFD2E;0;;;0634 062D\nFD2F;0;;;0634 062E\nFD30;0;;;0634 0645\nFD31;0;;;0633 0647\nFD32;0;;;0634 0647\nFD33;0;;;0637 0645\nFD34;0;;;0633 062C\nFD35;0;;;0633 062D\nFD36;0;;;0633 062E\nFD37;0;;;0634 062C\nFD38;0;;;0634 062D\nFD39;0;;;0634 062E\nFD3A;0;;;0637 0645\nFD3B;0;;;0638 0645\nFD3C;0;;;0627 064B\nFD3D;0;;;0627 064B\nFD50;0;;;062A 062C 0645\nFD51;0;;;062A 062D 062C\nFD52;0;;;062A 062D 062C\nFD53;0;;;062A 062D 0645\nFD54;0;;;062A 062E 0645\nFD55;0;;;062A 0645 062C\nFD56;0;;;062A 0645 062D\nFD57;0;;;062A 0645 062E\nFD58;0;;;062C 0645 062D\nFD59;0;;;062C 0645 062D\nFD5A;0;;;062D 0645 064A\nFD5B;0;;;062D 0645 0649\nFD5C;0;;;0633 062D 062C\nFD5D;0;;;0633 062C 062D\nFD5E;0;;;0633 062C 0649\nFD5F;0;;;0633 0645 062D\nFD60;0;;;0633 0645 062D\nFD61;0;;;0633 0645 062C\nFD62;0;;;0633 0645 0645\nFD63;0;;;0633 0645 0645\nFD64;0;;;0635 062D 062D\nFD65;0;;;0635 062D 062D\nFD66;0;;;0635 0645 0645\nFD67;0;;;0634 062D 0645\nFD68;0;;;0634 062D 0645\nFD69;0;;;0634 062C 064A\nFD6A;0;;;0634 0645 062E\nFD6B;0;;;0634 0645 062E\nFD6C;0;;;0634 0645 0645\nFD6D;0;;;0634 0645 0645\nFD6E;0;;;0636 062D 0649\nFD6F;0;;;0636 062E 0645\nFD70;0;;;0636 062E 0645\nFD71;0;;;0637 0645 062D\nFD72;0;;;0637 0645 062D\nFD73;0;;;0637 0645 0645\nFD74;0;;;0637 0645 064A\nFD75;0;;;0639 062C 0645\nFD76;0;;;0639 0645 0645\nFD77;0;;;0639 0645 0645\nFD78;0;;;0639 0645 0649\nFD79;0;;;063A 0645 0645\nFD7A;0;;;063A 0645 064A\nFD7B;0;;;063A 0645 0649\nFD7C;0;;;0641 062E 0645\nFD7D;0;;;0641 062E 0645\nFD7E;0;;;0642 0645 062D\nFD7F;0;;;0642 0645 0645\nFD80;0;;;0644 062D 0645\nFD81;0;;;0644 062D 064A\nFD82;0;;;0644 062D 0649\nFD83;0;;;0644 062C 062C\nFD84;0;;;0644 062C 062C\nFD85;0;;;0644 062E 0645\nFD86;0;;;0644 062E 0645\nFD87;0;;;0644 0645 062D\nFD88;0;;;0644 0645 062D\nFD89;0;;;0645 062D 062C\nFD8A;0;;;0645 062D 0645\nFD8B;0;;;0645 062D 064A\nFD8C;0;;;0645 062C 062D\nFD8D;0;;;0645 062C 0645\nFD8E;0;;;0645 062E 062C\nFD8F;0;;;0645 062E 0645\nFD92;0;;;0645 062C 062E\nFD93;0;;;0647 0645 062C\nFD94;0;;;0647 0645 0645\nFD95;0;;;0646 062D 0645\nFD96;0;;;0646 062D 0649\nFD97;0;;;0646 062C 0645\nFD98;0;;;0646 062C 0645\nFD99;0;;;0646 062C 0649\nFD9A;0;;;0646 0645 064A\nFD9B;0;;;0646 0645 0649\nFD9C;0;;;064A 0645 0645\nFD9D;0;;;064A 0645 0645\nFD9E;0;;;0628 062E 064A\nFD9F;0;;;062A 062C 064A\nFDA0;0;;;062A 062C 0649\nFDA1;0;;;062A 062E 064A\nFDA2;0;;;062A 062E 0649\nFDA3;0;;;062A 0645 064A\nFDA4;0;;;062A 0645 0649\nFDA5;0;;;062C 0645 064A\nFDA6;0;;;062C 062D 0649\nFDA7;0;;;062C 0645 0649\nFDA8;0;;;0633 062E 0649\nFDA9;0;;;0635 062D 064A\nFDAA;0;;;0634 062D 064A\nFDAB;0;;;0636 062D 064A\nFDAC;0;;;0644 062C 064A\nFDAD;0;;;0644 0645 064A\nFDAE;0;;;064A 062D 064A\nFDAF;0;;;064A 062C 064A\nFDB0;0;;;064A 0645 064A\nFDB1;0;;;0645 0645 064A\nFDB2;0;;;0642 0645 064A\nFDB3;0;;;0646 062D 064A\nFDB4;0;;;0642 0645 062D\nFDB5;0;;;0644 062D 0645\nFDB6;0;;;0639 0645 064A\nFDB7;0;;;0643 0645 064A\nFDB8;0;;;0646 062C 062D\nFDB9;0;;;0645 062E 064A\nFDBA;0;;;0644 062C 0645\nFDBB;0;;;0643 0645 0645\nFDBC;0;;;0644 062C 0645\nFDBD;0;;;0646 062C 062D\nFDBE;0;;;062C 062D 064A\nFDBF;0;;;062D 062C 064A\nFDC0;0;;;0645 062C 064A\nFDC1;0;;;0641 0645 064A\nFDC2;0;;;0628 062D 064A\nFDC3;0;;;0643 0645 0645\nFDC4;0;;;0639 062C 0645\nFDC5;0;;;0635 0645 0645\nFDC6;0;;;0633 062E 064A\nFDC7;0;;;0646 062C 064A\nFDF0;0;;;0635 0644 06D2\nFDF1;0;;;0642 0644 06D2\nFDF2;0;;;0627 0644 0644 0647\nFDF3;0;;;0627 0643 0628 0631\nFDF4;0;;;0645 062D 0645 062F\nFDF5;0;;;0635 0644 0639 0645\nFDF6;0;;;0631 0633 0648 0644\nFDF7;0;;;0639 0644 064A 0647\nFDF8;0;;;0648 0633 0644 0645\nFDF9;0;;;0635 0644 0649\nFDFA;0;;;0635 0644 0649 0020 0627 0644 0644 0647 0020 0639 0644 064A 0647 0020 0648 0633 0644 0645\nFDFB;0;;;062C 0644 0020 062C 0644 0627 0644 0647\nFDFC;0;;;0631 06CC 0627 0644\nFE10;0;;;002C\nFE11;0;;;3001\nFE12;0;;;3002\nFE13;0;;;003A\nFE14;0;;;003B\nFE15;0;;;0021\nFE16;0;;;003F\nFE17;0;;;3016\nFE18;0;;;3017\nFE19;0;;;2026\nFE20;230;\nFE21;230;\nFE22;230;\nFE23;230;\nFE24;230;\nFE25;230;\nFE26;230;\nFE27;220;\nFE28;220;\nFE29;220;\nFE2A;220;\nFE2B;220;\nFE2C;220;\nFE2D;220;\nFE2E;230;\nFE2F;230;\nFE30;0;;;2025\nFE31;0;;;2014\nFE32;0;;;2013\nFE33;0;;;005F\nFE34;0;;;005F\nFE35;0;;;0028\nFE36;0;;;0029\nFE37;0;;;007B\nFE38;0;;;007D\nFE39;0;;;3014\nFE3A;0;;;3015\nFE3B;0;;;3010\nFE3C;0;;;3011\nFE3D;0;;;300A\nFE3E;0;;;300B\nFE3F;0;;;3008\nFE40;0;;;3009\nFE41;0;;;300C\nFE42;0;;;300D\nFE43;0;;;300E\nFE44;0;;;300F\nFE47;0;;;005B\nFE48;0;;;005D\nFE49;0;;;203E\nFE4A;0;;;203E\nFE4B;0;;;203E\nFE4C;0;;;203E\nFE4D;0;;;005F\nFE4E;0;;;005F\nFE4F;0;;;005F\nFE50;0;;;002C\nFE51;0;;;3001\nFE52;0;;;002E\nFE54;0;;;003B\nFE55;0;;;003A\nFE56;0;;;003F\nFE57;0;;;0021\nFE58;0;;;2014\nFE59;0;;;0028\nFE5A;0;;;0029\nFE5B;0;;;007B\nFE5C;0;;;007D\nFE5D;0;;;3014\nFE5E;0;;;3015\nFE5F;0;;;0023\nFE60;0;;;0026\nFE61;0;;;002A\nFE62;0;;;002B\nFE63;0;;;002D\nFE64;0;;;003C\nFE65;0;;;003E\nFE66;0;;;003D\nFE68;0;;;005C\nFE69;0;;;0024\nFE6A;0;;;0025\nFE6B;0;;;0040\nFE70;0;;;0020 064B\nFE71;0;;;0640 064B\nFE72;0;;;0020 064C\nFE74;0;;;0020 064D\nFE76;0;;;0020 064E\nFE77;0;;;0640 064E\nFE78;0;;;0020 064F\nFE79;0;;;0640 064F\nFE7A;0;;;0020 0650\nFE7B;0;;;0640 0650\nFE7C;0;;;0020 0651\nFE7D;0;;;0640 0651\nFE7E;0;;;0020 0652\nFE7F;0;;;0640 0652\nFE80;0;;;0621\nFE81;0;;;0622\nFE82;0;;;0622\nFE83;0;;;0623\nFE84;0;;;0623\nFE85;0;;;0624\nFE86;0;;;0624\nFE87;0;;;0625\nFE88;0;;;0625\nFE89;0;;;0626\nFE8A;0;;;0626\nFE8B;0;;;0626\nFE8C;0;;;0626\nFE8D;0;;;0627\nFE8E;0;;;0627\nFE8F;0;;;0628\nFE90;0;;;0628\nFE91;0;;;0628\nFE92;0;;;0628\nFE93;0;;;0629\nFE94;0;;;0629\nFE95;0;;;062A\nFE96;0;;;062A\nFE97;0;;;062A\nFE98;0;;;062A\nFE99;0;;;062B\nFE9A;0;;;062B\nFE9B;0;;;062B\nFE9C;0;;;062B\nFE9D;0;;;062C\nFE9E;0;;;062C\nFE9F;0;;;062C\nFEA0;0;;;062C\nFEA1;0;;;062D\nFEA2;0;;;062D\nFEA3;0;;;062D\nFEA4;0;;;062D\nFEA5;0;;;062E\nFEA6;0;;;062E\nFEA7;0;;;062E\nFEA8;0;;;062E\nFEA9;0;;;062F\nFEAA;0;;;062F\nFEAB;0;;;0630\nFEAC;0;;;0630\nFEAD;0;;;0631\nFEAE;0;;;0631\nFEAF;0;;;0632\nFEB0;0;;;0632\nFEB1;0;;;0633\nFEB2;0;;;0633\nFEB3;0;;;0633\nFEB4;0;;;0633\nFEB5;0;;;0634\nFEB6;0;;;0634\nFEB7;0;;;0634\nFEB8;0;;;0634\nFEB9;0;;;0635\nFEBA;0;;;0635\nFEBB;0;;;0635\nFEBC;0;;;0635\nFEBD;0;;;0636\nFEBE;0;;;0636\nFEBF;0;;;0636\nFEC0;0;;;0636\nFEC1;0;;;0637\nFEC2;0;;;0637\nFEC3;0;;;0637\nFEC4;0;;;0637\nFEC5;0;;;0638\nFEC6;0;;;0638\nFEC7;0;;;0638\nFEC8;0;;;0638\nFEC9;0;;;0639\nFECA;0;;;0639\nFECB;0;;;0639\nFECC;0;;;0639\nFECD;0;;;063A\nFECE;0;;;063A\nFECF;0;;;063A\nFED0;0;;;063A\nFED1;0;;;0641\nFED2;0;;;0641\nFED3;0;;;0641\nFED4;0;;;0641\nFED5;0;;;0642\nFED6;0;;;0642\nFED7;0;;;0642\nFED8;0;;;0642\nFED9;0;;;0643\nFEDA;0;;;0643\nFEDB;0;;;0643\nFEDC;0;;;0643\nFEDD;0;;;0644\nFEDE;0;;;0644\nFEDF;0;;;0644\nFEE0;0;;;0644\nFEE1;0;;;0645\nFEE2;0;;;0645\nFEE3;0;;;0645\nFEE4;0;;;0645\nFEE5;0;;;0646\nFEE6;0;;;0646\nFEE7;0;;;0646\nFEE8;0;;;0646\nFEE9;0;;;0647\nFEEA;0;;;0647\nFEEB;0;;;0647\nFEEC;0;;;0647\nFEED;0;;;0648\nFEEE;0;;;0648\nFEEF;0;;;0649\nFEF0;0;;;0649\nFEF1;0;;;064A\nFEF2;0;;;064A\nFEF3;0;;;064A\nFEF4;0;;;064A\nFEF5;0;;;0644 0622\nFEF6;0;;;0644 0622\nFEF7;0;;;0644 0623\nFEF8;0;;;0644 0623\nFEF9;0;;;0644 0625\nFEFA;0;;;0644 0625\nFEFB;0;;;0644 0627\nFEFC;0;;;0644 0627\nFF01;0;;;0021\nFF02;0;;;0022\nFF03;0;;;0023\nFF04;0;;;0024\nFF05;0;;;0025\nFF06;0;;;0026\nFF07;0;;;0027\nFF08;0;;;0028\nFF09;0;;;0029\nFF0A;0;;;002A\nFF0B;0;;;002B\nFF0C;0;;;002C\nFF0D;0;;;002D\nFF0E;0;;;002E\nFF0F;0;;;002F\nFF10;0;;;0030\nFF11;0;;;0031\nFF12;0;;;0032\nFF13;0;;;0033\nFF14;0;;;0034\nFF15;0;;;0035\nFF16;0;;;0036\nFF17;0;;;0037\nFF18;0;;;0038\nFF19;0;;;0039\nFF1A;0;;;003A\nFF1B;0;;;003B\nFF1C;0;;;003C\nFF1D;0;;;003D\nFF1E;0;;;003E\nFF1F;0;;;003F\nFF20;0;;;0040\nFF21;0;;;0041\nFF22;0;;;0042\nFF23;0;;;0043\nFF24;0;;;0044\nFF25;0;;;0045\nFF26;0;;;0046\nFF27;0;;;0047\nFF28;0;;;0048\nFF29;0;;;0049\nFF2A;0;;;004A\nFF2B;0;;;004B\nFF2C;0;;;004C\nFF2D;0;;;004D\nFF2E;0;;;004E\nFF2F;0;;;004F\nFF30;0;;;0050\nFF31;0;;;0051\nFF32;0;;;0052\nFF33;0;;;0053\nFF34;0;;;0054\nFF35;0;;;0055\nFF36;0;;;0056\nFF37;0;;;0057\nFF38;0;;;0058\nFF39;0;;;0059\nFF3A;0;;;005A\nFF3B;0;;;005B\nFF3C;0;;;005C\nFF3D;0;;;005D\nFF3E;0;;;005E\nFF3F;0;;;005F\nFF40;0;;;0060\nFF41;0;;;0061\nFF42;0;;;0062\nFF43;0;;;0063\nFF44;0;;;0064\nFF45;0;;;0065\nFF46;0;;;0066\nFF47;0;;;0067\nFF48;0;;;0068\nFF49;0;;;0069\nFF4A;0;;;006A\nFF4B;0;;;006B\nFF4C;0;;;006C\nFF4D;0;;;006D\nFF4E;0;;;006E\nFF4F;0;;;006F\nFF50;0;;;0070\nFF51;0;;;0071\nFF52;0;;;0072\nFF53;0;;;0073\nFF54;0;;;0074\nFF55;0;;;0075\nFF56;0;;;0076\nFF57;0;;;0077\nFF58;0;;;0078\nFF59;0;;;0079\nFF5A;0;;;007A\nFF5B;0;;;007B\nFF5C;0;;;007C\nFF5D;0;;;007D\nFF5E;0;;;007E\nFF5F;0;;;2985\nFF60;0;;;2986\nFF61;0;;;3002\nFF62;0;;;300C\nFF63;0;;;300D\nFF64;0;;;3001\nFF65;0;;;30FB\nFF66;0;;;30F2\nFF67;0;;;30A1\nFF68;0;;;30A3\nFF69;0;;;30A5\nFF6A;0;;;30A7\nFF6B;0;;;30A9\nFF6C;0;;;30E3\nFF6D;0;;;30E5\nFF6E;0;;;30E7\nFF6F;0;;;30C3\nFF70;0;;;30FC\nFF71;0;;;30A2\nFF72;0;;;30A4\nFF73;0;;;30A6\nFF74;0;;;30A8\nFF75;0;;;30AA\nFF76;0;;;30AB\nFF77;0;;;30AD\nFF78;0;;;30AF\nFF79;0;;;30B1\nFF7A;0;;;30B3\nFF7B;0;;;30B5\nFF7C;0;;;30B7\nFF7D;0;;;30B9\nFF7E;0;;;30BB\nFF7F;0;;;30BD\nFF80;0;;;30BF\nFF81;0;;;30C1\nFF82;0;;;30C4\nFF83;0;;;30C6\nFF84;0;;;30C8\nFF85;0;;;30CA\nFF86;0;;;30CB\nFF87;0;;;30CC\nFF88;0;;;30CD\nFF89;0;;;30CE\nFF8A;0;;;30CF\nFF8B;0;;;30D2\nFF8C;0;;;30D5\nFF8D;0;;;30D8\nFF8E;0;;;30DB\nFF8F;0;;;30DE\nFF90;0;;;30DF\nFF91;0;;;30E0\nFF92;0;;;30E1\nFF93;0;;;30E2\nFF94;0;;;30E4\nFF95;0;;;30E6\nFF96;0;;;30E8\nFF97;0;;;30E9\nFF98;0;;;30EA\nFF99;0;;;30EB\nFF9A;0;;;30EC\nFF9B;0;;;30ED\nFF9C;0;;;30EF\nFF9D;0;;;30F3\nFF9E;0;;;3099\nFF9F;0;;;309A\nFFA0;0;;;3164\nFFA1;0;;;3131\nFFA2;0;;;3132\nFFA3;0;;;3133\nFFA4;0;;;3134\nFFA5;0;;;3135\nFFA6;0;;;3136\nFFA7;0;;;3137\nFFA8;0;;;3138\nFFA9;0;;;3139\nFFAA;0;;;313A\nFFAB;0;;;313B\nFFAC;0;;;313C\nFFAD;0;;;313D\nFFAE;0;;;313E\nFFAF;0;;;313F\nFFB0;0;;;3140\nFFB1;0;;;3141\nFFB2;0;;;3142\nFFB3;0;;;3143\nFFB4;0;;;3144\nFFB5;0;;;3145\nFFB6;0;;;3146\nFFB7;0;;;3147\nFFB8;0;;;3148\nFFB9;0;;;3149\nFFBA;0;;;314A\nFFBB;0;;;314B\nFFBC;0;;;314C\nFFBD;0;;;314D\nFFBE;0;;;314E\nFFC2;0;;;314F\nFFC3;0;;;3150\nFFC4;0;;;3151\nFFC5;0;;;3152\nFFC6;0;;;3153\nFFC7;0;;;3154\nFFCA;0;;;3155\nFFCB;0;;;3156\nFFCC;0;;;3157\nFFCD;0;;;3158\nFFCE;0;;;3159\nFFCF;0;;;315A\nFFD2;0;;;315B\nFFD3;0;;;315C\nFFD4;0;;;315D\nFFD5;0;;;315E\nFFD6;0;;;315F\nFFD7;0;;;3160\nFFDA;0;;;3161\nFFDB;0;;;3162\nFFDC;0;;;3163\nFFE0;0;;;00A2\nFFE1;0;;;00A3\nFFE2;0;;;00AC\nFFE3;0;;;00AF\nFFE4;0;;;00A6\nFFE5;0;;;00A5\nFFE6;0;;;20A9\nFFE8;0;;;2502\nFFE9;0;;;2190\nFFEA;0;;;2191\nFFEB;0;;;2192\nFFEC;0;;;2193\nFFED;0;;;25A0\nFFEE;0;;;25CB\n101FD;220;\n102E0;220;\n10376;230;\n10377;230;\n10378;230;\n10379;230;\n1037A;230;\n10781;0;;;02D0\n10782;0;;;02D1\n10783;0;;;00E6\n10784;0;;;0299\n10785;0;;;0253\n10787;0;;;02A3\n10788;0;;;AB66\n10789;0;;;02A5\n1078A;0;;;02A4\n1078B;0;;;0256\n1078C;0;;;0257\n1078D;0;;;1D91\n1078E;0;;;0258\n1078F;0;;;025E\n10790;0;;;02A9\n10791;0;;;0264\n10792;0;;;0262\n10793;0;;;0260\n10794;0;;;029B\n10795;0;;;0127\n10796;0;;;029C\n10797;0;;;0267\n10798;0;;;0284\n10799;0;;;02AA\n1079A;0;;;02AB\n1079B;0;;;026C\n1079C;0;;;1DF04\n1079D;0;;;A78E\n1079E;0;;;026E\n1079F;0;;;1DF05\n107A0;0;;;028E\n107A1;0;;;1DF06\n107A2;0;;;00F8\n107A3;0;;;0276\n107A4;0;;;0277\n107A5;0;;;0071\n107A6;0;;;027A\n107A7;0;;;1DF08\n107A8;0;;;027D\n107A9;0;;;027E\n107AA;0;;;0280\n107AB;0;;;02A8\n107AC;0;;;02A6\n107AD;0;;;AB67\n107AE;0;;;02A7\n107AF;0;;;0288\n107B0;0;;;2C71\n107B2;0;;;028F\n107B3;0;;;02A1\n107B4;0;;;02A2\n107B5;0;;;0298\n107B6;0;;;01C0\n107B7;0;;;01C1\n107B8;0;;;01C2\n107B9;0;;;1DF0A\n107BA;0;;;1DF1E\n10A0D;220;\n10A0F;230;\n10A38;230;\n10A39;1;\n10A3A;220;\n10A3F;9;\n10AE5;230;\n10AE6;220;\n10D24;230;\n10D25;230;\n10D26;230;\n10D27;230;\n10EAB;230;\n10EAC;230;\n10F46;220;\n10F47;220;\n10F48;230;\n10F49;230;\n10F4A;230;\n10F4B;220;\n10F4C;230;\n10F4D;220;\n10F4E;220;\n10F4F;220;\n10F50;220;\n10F82;230;\n10F83;220;\n10F84;230;\n10F85;220;\n11046;9;\n11070;9;\n1107F;9;\n1109A;0;11099 110BA\n1109C;0;1109B 110BA\n110AB;0;110A5 110BA\n110B9;9;\n110BA;7;\n11100;230;\n11101;230;\n11102;230;\n1112E;0;11131 11127\n1112F;0;11132 11127\n11133;9;\n11134;9;\n11173;7;\n111C0;9;\n111CA;7;\n11235;9;\n11236;7;\n112E9;7;\n112EA;9;\n1133B;7;\n1133C;7;\n1134B;0;11347 1133E\n1134C;0;11347 11357\n1134D;9;\n11366;230;\n11367;230;\n11368;230;\n11369;230;\n1136A;230;\n1136B;230;\n1136C;230;\n11370;230;\n11371;230;\n11372;230;\n11373;230;\n11374;230;\n11442;9;\n11446;7;\n1145E;230;\n114BB;0;114B9 114BA\n114BC;0;114B9 114B0\n114BE;0;114B9 114BD\n114C2;9;\n114C3;7;\n115BA;0;115B8 115AF\n115BB;0;115B9 115AF\n115BF;9;\n115C0;7;\n1163F;9;\n116B6;9;\n116B7;7;\n1172B;9;\n11839;9;\n1183A;7;\n11938;0;11935 11930\n1193D;9;\n1193E;9;\n11943;7;\n119E0;9;\n11A34;9;\n11A47;9;\n11A99;9;\n11C3F;9;\n11D42;7;\n11D44;9;\n11D45;9;\n11D97;9;\n16AF0;1;\n16AF1;1;\n16AF2;1;\n16AF3;1;\n16AF4;1;\n16B30;230;\n16B31;230;\n16B32;230;\n16B33;230;\n16B34;230;\n16B35;230;\n16B36;230;\n16FF0;6;\n16FF1;6;\n1BC9E;1;\n1D15E;0;1D157 1D165;x\n1D15F;0;1D158 1D165;x\n1D160;0;1D15F 1D16E;x\n1D161;0;1D15F 1D16F;x\n1D162;0;1D15F 1D170;x\n1D163;0;1D15F 1D171;x\n1D164;0;1D15F 1D172;x\n1D165;216;\n1D166;216;\n1D167;1;\n1D168;1;\n1D169;1;\n1D16D;226;\n1D16E;216;\n1D16F;216;\n1D170;216;\n1D171;216;\n1D172;216;\n1D17B;220;\n1D17C;220;\n1D17D;220;\n1D17E;220;\n1D17F;220;\n1D180;220;\n1D181;220;\n1D182;220;\n1D185;230;\n1D186;230;\n1D187;230;\n1D188;230;\n1D189;230;\n1D18A;220;\n1D18B;220;\n1D1AA;230;\n1D1AB;230;\n1D1AC;230;\n1D1AD;230;\n1D1BB;0;1D1B9 1D165;x\n1D1BC;0;1D1BA 1D165;x\n1D1BD;0;1D1BB 1D16E;x\n1D1BE;0;1D1BC 1D16E;x\n1D1BF;0;1D1BB 1D16F;x\n1D1C0;0;1D1BC 1D16F;x\n1D242;230;\n1D243;230;\n1D244;230;\n1D400;0;;;0041\n1D401;0;;;0042\n1D402;0;;;0043\n1D403;0;;;0044\n1D404;0;;;0045\n1D405;0;;;0046\n1D406;0;;;0047\n1D407;0;;;0048\n1D408;0;;;0049\n1D409;0;;;004A\n1D40A;0;;;004B\n1D40B;0;;;004C\n1D40C;0;;;004D\n1D40D;0;;;004E\n1D40E;0;;;004F\n1D40F;0;;;0050\n1D410;0;;;0051\n1D411;0;;;0052\n1D412;0;;;0053\n1D413;0;;;0054\n1D414;0;;;0055\n1D415;0;;;0056\n1D416;0;;;0057\n1D417;0;;;0058\n1D418;0;;;0059\n1D419;0;;;005A\n1D41A;0;;;0061\n1D41B;0;;;0062\n1D41C;0;;;0063\n1D41D;0;;;0064\n1D41E;0;;;0065\n1D41F;0;;;0066\n1D420;0;;;0067\n1D421;0;;;0068\n1D422;0;;;0069\n1D423;0;;;006A\n1D424;0;;;006B\n1D425;0;;;006C\n1D426;0;;;006D\n1D427;0;;;006E\n1D428;0;;;006F\n1D429;0;;;0070\n1D42A;0;;;0071\n1D42B;0;;;0072\n1D42C;0;;;0073\n1D42D;0;;;0074\n1D42E;0;;;0075\n1D42F;0;;;0076\n1D430;0;;;0077\n1D431;0;;;0078\n1D432;0;;;0079\n1D433;0;;;007A\n1D434;0;;;0041\n1D435;0;;;0042\n1D436;0;;;0043\n1D437;0;;;0044\n1D438;0;;;0045\n1D439;0;;;0046\n1D43A;0;;;0047\n1D43B;0;;;0048\n1D43C;0;;;0049\n1D43D;0;;;004A\n1D43E;0;;;004B\n1D43F;0;;;004C\n1D440;0;;;004D\n1D441;0;;;004E\n1D442;0;;;004F\n1D443;0;;;0050\n1D444;0;;;0051\n1D445;0;;;0052\n1D446;0;;;0053\n1D447;0;;;0054\n1D448;0;;;0055\n1D449;0;;;0056\n1D44A;0;;;0057\n1D44B;0;;;0058\n1D44C;0;;;0059\n1D44D;0;;;005A\n1D44E;0;;;0061\n1D44F;0;;;0062\n1D450;0;;;0063\n1D451;0;;;0064\n1D452;0;;;0065\n1D453;0;;;0066\n1D454;0;;;0067\n1D456;0;;;0069\n1D457;0;;;006A\n1D458;0;;;006B\n1D459;0;;;006C\n1D45A;0;;;006D\n1D45B;0;;;006E\n1D45C;0;;;006F\n1D45D;0;;;0070\n1D45E;0;;;0071\n1D45F;0;;;0072\n1D460;0;;;0073\n1D461;0;;;0074\n1D462;0;;;0075\n1D463;0;;;0076\n1D464;0;;;0077\n1D465;0;;;0078\n1D466;0;;;0079\n1D467;0;;;007A\n1D468;0;;;0041\n1D469;0;;;0042\n1D46A;0;;;0043\n1D46B;0;;;0044\n1D46C;0;;;0045\n1D46D;0;;;0046\n1D46E;0;;;0047\n1D46F;0;;;0048\n1D470;0;;;0049\n1D471;0;;;004A\n1D472;0;;;004B\n1D473;0;;;004C\n1D474;0;;;004D\n1D475;0;;;004E\n1D476;0;;;004F\n1D477;0;;;0050\n1D478;0;;;0051\n1D479;0;;;0052\n1D47A;0;;;0053\n1D47B;0;;;0054\n1D47C;0;;;0055\n1D47D;0;;;0056\n1D47E;0;;;0057\n1D47F;0;;;0058\n1D480;0;;;0059\n1D481;0;;;005A\n1D482;0;;;0061\n1D483;0;;;0062\n1D484;0;;;0063\n1D485;0;;;0064\n1D486;0;;;0065\n1D487;0;;;0066\n1D488;0;;;0067\n1D489;0;;;0068\n1D48A;0;;;0069\n1D48B;0;;;006A\n1D48C;0;;;006B\n1D48D;0;;;006C\n1D48E;0;;;006D\n1D48F;0;;;006E\n1D490;0;;;006F\n1D491;0;;;0070\n1D492;0;;;0071\n1D493;0;;;0072\n1D494;0;;;0073\n1D495;0;;;0074\n1D496;0;;;0075\n1D497;0;;;0076\n1D498;0;;;0077\n1D499;0;;;0078\n1D49A;0;;;0079\n1D49B;0;;;007A\n1D49C;0;;;0041\n1D49E;0;;;0043\n1D49F;0;;;0044\n1D4A2;0;;;0047\n1D4A5;0;;;004A\n1D4A6;0;;;004B\n1D4A9;0;;;004E\n1D4AA;0;;;004F\n1D4AB;0;;;0050\n1D4AC;0;;;0051\n1D4AE;0;;;0053\n1D4AF;0;;;0054\n1D4B0;0;;;0055\n1D4B1;0;;;0056\n1D4B2;0;;;0057\n1D4B3;0;;;0058\n1D4B4;0;;;0059\n1D4B5;0;;;005A\n1D4B6;0;;;0061\n1D4B7;0;;;0062\n1D4B8;0;;;0063\n1D4B9;0;;;0064\n1D4BB;0;;;0066\n1D4BD;0;;;0068\n1D4BE;0;;;0069\n1D4BF;0;;;006A\n1D4C0;0;;;006B\n1D4C1;0;;;006C\n1D4C2;0;;;006D\n1D4C3;0;;;006E\n1D4C5;0;;;0070\n1D4C6;0;;;0071\n1D4C7;0;;;0072\n1D4C8;0;;;0073\n1D4C9;0;;;0074\n1D4CA;0;;;0075\n1D4CB;0;;;0076\n1D4CC;0;;;0077\n1D4CD;0;;;0078\n1D4CE;0;;;0079\n1D4CF;0;;;007A\n1D4D0;0;;;0041\n1D4D1;0;;;0042\n1D4D2;0;;;0043\n1D4D3;0;;;0044\n1D4D4;0;;;0045\n1D4D5;0;;;0046\n1D4D6;0;;;0047\n1D4D7;0;;;0048\n1D4D8;0;;;0049\n1D4D9;0;;;004A\n1D4DA;0;;;004B\n1D4DB;0;;;004C\n1D4DC;0;;;004D\n1D4DD;0;;;004E\n1D4DE;0;;;004F\n1D4DF;0;;;0050\n1D4E0;0;;;0051\n1D4E1;0;;;0052\n1D4E2;0;;;0053\n1D4E3;0;;;0054\n1D4E4;0;;;0055\n1D4E5;0;;;0056\n1D4E6;0;;;0057\n1D4E7;0;;;0058\n1D4E8;0;;;0059\n1D4E9;0;;;005A\n1D4EA;0;;;0061\n1D4EB;0;;;0062\n1D4EC;0;;;0063\n1D4ED;0;;;0064\n1D4EE;0;;;0065\n1D4EF;0;;;0066\n1D4F0;0;;;0067\n1D4F1;0;;;0068\n1D4F2;0;;;0069\n1D4F3;0;;;006A\n1D4F4;0;;;006B\n1D4F5;0;;;006C\n1D4F6;0;;;006D\n1D4F7;0;;;006E\n1D4F8;0;;;006F\n1D4F9;0;;;0070\n1D4FA;0;;;0071\n1D4FB;0;;;0072\n1D4FC;0;;;0073\n1D4FD;0;;;0074\n1D4FE;0;;;0075\n1D4FF;0;;;0076\n1D500;0;;;0077\n1D501;0;;;0078\n1D502;0;;;0079\n1D503;0;;;007A\n1D504;0;;;0041\n1D505;0;;;0042\n1D507;0;;;0044\n1D508;0;;;0045\n1D509;0;;;0046\n1D50A;0;;;0047\n1D50D;0;;;004A\n1D50E;0;;;004B\n1D50F;0;;;004C\n1D510;0;;;004D\n1D511;0;;;004E\n1D512;0;;;004F\n1D513;0;;;0050\n1D514;0;;;0051\n1D516;0;;;0053\n1D517;0;;;0054\n1D518;0;;;0055\n1D519;0;;;0056\n1D51A;0;;;0057\n1D51B;0;;;0058\n1D51C;0;;;0059\n1D51E;0;;;0061\n1D51F;0;;;0062\n1D520;0;;;0063\n1D521;0;;;0064\n1D522;0;;;0065\n1D523;0;;;0066\n1D524;0;;;0067\n1D525;0;;;0068\n1D526;0;;;0069\n1D527;0;;;006A\n1D528;0;;;006B\n1D529;0;;;006C\n1D52A;0;;;006D\n1D52B;0;;;006E\n1D52C;0;;;006F\n1D52D;0;;;0070\n1D52E;0;;;0071\n1D52F;0;;;0072\n1D530;0;;;0073\n1D531;0;;;0074\n1D532;0;;;0075\n1D533;0;;;0076\n1D534;0;;;0077\n1D535;0;;;0078\n1D536;0;;;0079\n1D537;0;;;007A\n1D538;0;;;0041\n1D539;0;;;0042\n1D53B;0;;;0044\n1D53C;0;;;0045\n1D53D;0;;;0046\n1D53E;0;;;0047\n1D540;0;;;0049\n1D541;0;;;004A\n1D542;0;;;004B\n1D543;0;;;004C\n1D544;0;;;004D\n1D546;0;;;004F\n1D54A;0;;;0053\n1D54B;0;;;0054\n1D54C;0;;;0055\n1D54D;0;;;0056\n1D54E;0;;;0057\n1D54F;0;;;0058\n1D550;0;;;0059\n1D552;0;;;0061\n1D553;0;;;0062\n1D554;0;;;0063\n1D555;0;;;0064\n1D556;0;;;0065\n1D557;0;;;0066\n1D558;0;;;0067\n1D559;0;;;0068\n1D55A;0;;;0069\n1D55B;0;;;006A\n1D55C;0;;;006B\n1D55D;0;;;006C\n1D55E;0;;;006D\n1D55F;0;;;006E\n1D560;0;;;006F\n1D561;0;;;0070\n1D562;0;;;0071\n1D563;0;;;0072\n1D564;0;;;0073\n1D565;0;;;0074\n1D566;0;;;0075\n1D567;0;;;0076\n1D568;0;;;0077\n1D569;0;;;0078\n1D56A;0;;;0079\n1D56B;0;;;007A\n1D56C;0;;;0041\n1D56D;0;;;0042\n1D56E;0;;;0043\n1D56F;0;;;0044\n1D570;0;;;0045\n1D571;0;;;0046\n1D572;0;;;0047\n1D573;0;;;0048\n1D574;0;;;0049\n1D575;0;;;004A\n1D576;0;;;004B\n1D577;0;;;004C\n1D578;0;;;004D\n1D579;0;;;004E\n1D57A;0;;;004F\n1D57B;0;;;0050\n1D57C;0;;;0051\n1D57D;0;;;0052\n1D57E;0;;;0053\n1D57F;0;;;0054\n1D580;0;;;0055\n1D581;0;;;0056\n1D582;0;;;0057\n1D583;0;;;0058\n1D584;0;;;0059\n1D585;0;;;005A\n1D586;0;;;0061\n1D587;0;;;0062\n1D588;0;;;0063\n1D589;0;;;0064\n1D58A;0;;;0065\n1D58B;0;;;0066\n1D58C;0;;;0067\n1D58D;0;;;0068\n1D58E;0;;;0069\n1D58F;0;;;006A\n1D590;0;;;006B\n1D591;0;;;006C\n1D592;0;;;006D\n1D593;0;;;006E\n1D594;0;;;006F\n1D595;0;;;0070\n1D596;0;;;0071\n1D597;0;;;0072\n1D598;0;;;0073\n1D599;0;;;0074\n1D59A;0;;;0075\n1D59B;0;;;0076\n1D59C;0;;;0077\n1D59D;0;;;0078\n1D59E;0;;;0079\n1D59F;0;;;007A\n1D5A0;0;;;0041\n1D5A1;0;;;0042\n1D5A2;0;;;0043\n1D5A3;0;;;0044\n1D5A4;0;;;0045\n1D5A5;0;;;0046\n1D5A6;0;;;0047\n1D5A7;0;;;0048\n1D5A8;0;;;0049\n1D5A9;0;;;004A\n1D5AA;0;;;004B\n1D5AB;0;;;004C\n1D5AC;0;;;004D\n1D5AD;0;;;004E\n1D5AE;0;;;004F\n1D5AF;0;;;0050\n1D5B0;0;;;0051\n1D5B1;0;;;0052\n1D5B2;0;;;0053\n1D5B3;0;;;0054\n1D5B4;0;;;0055\n1D5B5;0;;;0056\n1D5B6;0;;;0057\n1D5B7;0;;;0058\n1D5B8;0;;;0059\n1D5B9;0;;;005A\n1D5BA;0;;;0061\n1D5BB;0;;;0062\n1D5BC;0;;;0063\n1D5BD;0;;;0064\n1D5BE;0;;;0065\n1D5BF;0;;;0066\n1D5C0;0;;;0067\n1D5C1;0;;;0068\n1D5C2;0;;;0069\n1D5C3;0;;;006A\n1D5C4;0;;;006B\n1D5C5;0;;;006C\n1D5C6;0;;;006D\n1D5C7;0;;;006E\n1D5C8;0;;;006F\n1D5C9;0;;;0070\n1D5CA;0;;;0071\n1D5CB;0;;;0072\n1D5CC;0;;;0073\n1D5CD;0;;;0074\n1D5CE;0;;;0075\n1D5CF;0;;;0076\n1D5D0;0;;;0077\n1D5D1;0;;;0078\n1D5D2;0;;;0079\n1D5D3;0;;;007A\n1D5D4;0;;;0041\n1D5D5;0;;;0042\n1D5D6;0;;;0043\n1D5D7;0;;;0044\n1D5D8;0;;;0045\n1D5D9;0;;;0046\n1D5DA;0;;;0047\n1D5DB;0;;;0048\n1D5DC;0;;;0049\n1D5DD;0;;;004A\n1D5DE;0;;;004B\n1D5DF;0;;;004C\n1D5E0;0;;;004D\n1D5E1;0;;;004E\n1D5E2;0;;;004F\n1D5E3;0;;;0050\n1D5E4;0;;;0051\n1D5E5;0;;;0052\n1D5E6;0;;;0053\n1D5E7;0;;;0054\n1D5E8;0;;;0055\n1D5E9;0;;;0056\n1D5EA;0;;;0057\n1D5EB;0;;;0058\n1D5EC;0;;;0059\n1D5ED;0;;;005A\n1D5EE;0;;;0061\n1D5EF;0;;;0062\n1D5F0;0;;;0063\n1D5F1;0;;;0064\n1D5F2;0;;;0065\n1D5F3;0;;;0066\n1D5F4;0;;;0067\n1D5F5;0;;;0068\n1D5F6;0;;;0069\n1D5F7;0;;;006A\n1D5F8;0;;;006B\n1D5F9;0;;;006C\n1D5FA;0;;;006D\n1D5FB;0;;;006E\n1D5FC;0;;;006F\n1D5FD;0;;;0070\n1D5FE;0;;;0071\n1D5FF;0;;;0072\n1D600;0;;;0073\n1D601;0;;;0074\n1D602;0;;;0075\n1D603;0;;;0076\n1D604;0;;;0077\n1D605;0;;;0078\n1D606;0;;;0079\n1D607;0;;;007A\n1D608;0;;;0041\n1D609;0;;;0042\n1D60A;0;;;0043\n1D60B;0;;;0044\n1D60C;0;;;0045\n1D60D;0;;;0046\n1D60E;0;;;0047\n1D60F;0;;;0048\n1D610;0;;;0049\n1D611;0;;;004A\n1D612;0;;;004B\n1D613;0;;;004C\n1D614;0;;;004D\n1D615;0;;;004E\n1D616;0;;;004F\n1D617;0;;;0050\n1D618;0;;;0051\n1D619;0;;;0052\n1D61A;0;;;0053\n1D61B;0;;;0054\n1D61C;0;;;0055\n1D61D;0;;;0056\n1D61E;0;;;0057\n1D61F;0;;;0058\n1D620;0;;;0059\n1D621;0;;;005A\n1D622;0;;;0061\n1D623;0;;;0062\n1D624;0;;;0063\n1D625;0;;;0064\n1D626;0;;;0065\n1D627;0;;;0066\n1D628;0;;;0067\n1D629;0;;;0068\n1D62A;0;;;0069\n1D62B;0;;;006A\n1D62C;0;;;006B\n1D62D;0;;;006C\n1D62E;0;;;006D\n1D62F;0;;;006E\n1D630;0;;;006F\n1D631;0;;;0070\n1D632;0;;;0071\n1D633;0;;;0072\n1D634;0;;;0073\n1D635;0;;;0074\n1D636;0;;;0075\n1D637;0;;;0076\n1D638;0;;;0077\n1D639;0;;;0078\n1D63A;0;;;0079\n1D63B;0;;;007A\n1D63C;0;;;0041\n1D63D;0;;;0042\n1D63E;0;;;0043\n1D63F;0;;;0044\n1D640;0;;;0045\n1D641;0;;;0046\n1D642;0;;;0047\n1D643;0;;;0048\n1D644;0;;;0049\n1D645;0;;;004A\n1D646;0;;;004B\n1D647;0;;;004C\n1D648;0;;;004D\n1D649;0;;;004E\n1D64A;0;;;004F\n1D64B;0;;;0050\n1D64C;0;;;0051\n1D64D;0;;;0052\n1D64E;0;;;0053\n1D64F;0;;;0054\n1D650;0;;;0055\n1D651;0;;;0056\n1D652;0;;;0057\n1D653;0;;;0058\n1D654;0;;;0059\n1D655;0;;;005A\n1D656;0;;;0061\n1D657;0;;;0062\n1D658;0;;;0063\n1D659;0;;;0064\n1D65A;0;;;0065\n1D65B;0;;;0066\n1D65C;0;;;0067\n1D65D;0;;;0068\n1D65E;0;;;0069\n1D65F;0;;;006A\n1D660;0;;;006B\n1D661;0;;;006C\n1D662;0;;;006D\n1D663;0;;;006E\n1D664;0;;;006F\n1D665;0;;;0070\n1D666;0;;;0071\n1D667;0;;;0072\n1D668;0;;;0073\n1D669;0;;;0074\n1D66A;0;;;0075\n1D66B;0;;;0076\n1D66C;0;;;0077\n1D66D;0;;;0078\n1D66E;0;;;0079\n1D66F;0;;;007A\n1D670;0;;;0041\n1D671;0;;;0042\n1D672;0;;;0043\n1D673;0;;;0044\n1D674;0;;;0045\n1D675;0;;;0046\n1D676;0;;;0047\n1D677;0;;;0048\n1D678;0;;;0049\n1D679;0;;;004A\n1D67A;0;;;004B\n1D67B;0;;;004C\n1D67C;0;;;004D\n1D67D;0;;;004E\n1D67E;0;;;004F\n1D67F;0;;;0050\n1D680;0;;;0051\n1D681;0;;;0052\n1D682;0;;;0053\n1D683;0;;;0054\n1D684;0;;;0055\n1D685;0;;;0056\n1D686;0;;;0057\n1D687;0;;;0058\n1D688;0;;;0059\n1D689;0;;;005A\n1D68A;0;;;0061\n1D68B;0;;;0062\n1D68C;0;;;0063\n1D68D;0;;;0064\n1D68E;0;;;0065\n1D68F;0;;;0066\n1D690;0;;;0067\n1D691;0;;;0068\n1D692;0;;;0069\n1D693;0;;;006A\n1D694;0;;;006B\n1D695;0;;;006C\n1D696;0;;;006D\n1D697;0;;;006E\n1D698;0;;;006F\n1D699;0;;;0070\n1D69A;0;;;0071\n1D69B;0;;;0072\n1D69C;0;;;0073\n1D69D;0;;;0074\n1D69E;0;;;0075\n1D69F;0;;;0076\n1D6A0;0;;;0077\n1D6A1;0;;;0078\n1D6A2;0;;;0079\n1D6A3;0;;;007A\n1D6A4;0;;;0131\n1D6A5;0;;;0237\n1D6A8;0;;;0391\n1D6A9;0;;;0392\n1D6AA;0;;;0393\n1D6AB;0;;;0394\n1D6AC;0;;;0395\n1D6AD;0;;;0396\n1D6AE;0;;;0397\n1D6AF;0;;;0398\n1D6B0;0;;;0399\n1D6B1;0;;;039A\n1D6B2;0;;;039B\n1D6B3;0;;;039C\n1D6B4;0;;;039D\n1D6B5;0;;;039E\n1D6B6;0;;;039F\n1D6B7;0;;;03A0\n1D6B8;0;;;03A1\n1D6B9;0;;;03F4\n1D6BA;0;;;03A3\n1D6BB;0;;;03A4\n1D6BC;0;;;03A5\n1D6BD;0;;;03A6\n1D6BE;0;;;03A7\n1D6BF;0;;;03A8\n1D6C0;0;;;03A9\n1D6C1;0;;;2207\n1D6C2;0;;;03B1\n1D6C3;0;;;03B2\n1D6C4;0;;;03B3\n1D6C5;0;;;03B4\n1D6C6;0;;;03B5\n1D6C7;0;;;03B6\n1D6C8;0;;;03B7\n1D6C9;0;;;03B8\n1D6CA;0;;;03B9\n1D6CB;0;;;03BA\n1D6CC;0;;;03BB\n1D6CD;0;;;03BC\n1D6CE;0;;;03BD\n1D6CF;0;;;03BE\n1D6D0;0;;;03BF\n1D6D1;0;;;03C0\n1D6D2;0;;;03C1\n1D6D3;0;;;03C2\n1D6D4;0;;;03C3\n1D6D5;0;;;03C4\n1D6D6;0;;;03C5\n1D6D7;0;;;03C6\n1D6D8;0;;;03C7\n1D6D9;0;;;03C8\n1D6DA;0;;;03C9\n1D6DB;0;;;2202\n1D6DC;0;;;03F5\n1D6DD;0;;;03D1\n1D6DE;0;;;03F0\n1D6DF;0;;;03D5\n1D6E0;0;;;03F1\n1D6E1;0;;;03D6\n1D6E2;0;;;0391\n1D6E3;0;;;0392\n1D6E4;0;;;0393\n1D6E5;0;;;0394\n1D6E6;0;;;0395\n1D6E7;0;;;0396\n1D6E8;0;;;0397\n1D6E9;0;;;0398\n1D6EA;0;;;0399\n1D6EB;0;;;039A\n1D6EC;0;;;039B\n1D6ED;0;;;039C\n1D6EE;0;;;039D\n1D6EF;0;;;039E\n1D6F0;0;;;039F\n1D6F1;0;;;03A0\n1D6F2;0;;;03A1\n1D6F3;0;;;03F4\n1D6F4;0;;;03A3\n1D6F5;0;;;03A4\n1D6F6;0;;;03A5\n1D6F7;0;;;03A6\n1D6F8;0;;;03A7\n1D6F9;0;;;03A8\n1D6FA;0;;;03A9\n1D6FB;0;;;2207\n1D6FC;0;;;03B1\n1D6FD;0;;;03B2\n1D6FE;0;;;03B3\n1D6FF;0;;;03B4\n1D700;0;;;03B5\n1D701;0;;;03B6\n1D702;0;;;03B7\n1D703;0;;;03B8\n1D704;0;;;03B9\n1D705;0;;;03BA\n1D706;0;;;03BB\n1D707;0;;;03BC\n1D708;0;;;03BD\n1D709;0;;;03BE\n1D70A;0;;;03BF\n1D70B;0;;;03C0\n1D70C;0;;;03C1\n1D70D;0;;;03C2\n1D70E;0;;;03C3\n1D70F;0;;;03C4\n1D710;0;;;03C5\n1D711;0;;;03C6\n1D712;0;;;03C7\n1D713;0;;;03C8\n1D714;0;;;03C9\n1D715;0;;;2202\n1D716;0;;;03F5\n1D717;0;;;03D1\n1D718;0;;;03F0\n1D719;0;;;03D5\n1D71A;0;;;03F1\n1D71B;0;;;03D6\n1D71C;0;;;0391\n1D71D;0;;;0392\n1D71E;0;;;0393\n1D71F;0;;;0394\n1D720;0;;;0395\n1D721;0;;;0396\n1D722;0;;;0397\n1D723;0;;;0398\n1D724;0;;;0399\n1D725;0;;;039A\n1D726;0;;;039B\n1D727;0;;;039C\n1D728;0;;;039D\n1D729;0;;;039E\n1D72A;0;;;039F\n1D72B;0;;;03A0\n1D72C;0;;;03A1\n1D72D;0;;;03F4\n1D72E;0;;;03A3\n1D72F;0;;;03A4\n1D730;0;;;03A5\n1D731;0;;;03A6\n1D732;0;;;03A7\n1D733;0;;;03A8\n1D734;0;;;03A9\n1D735;0;;;2207\n1D736;0;;;03B1\n1D737;0;;;03B2\n1D738;0;;;03B3\n1D739;0;;;03B4\n1D73A;0;;;03B5\n1D73B;0;;;03B6\n1D73C;0;;;03B7\n1D73D;0;;;03B8\n1D73E;0;;;03B9\n1D73F;0;;;03BA\n1D740;0;;;03BB\n1D741;0;;;03BC\n1D742;0;;;03BD\n1D743;0;;;03BE\n1D744;0;;;03BF\n1D745;0;;;03C0\n1D746;0;;;03C1\n1D747;0;;;03C2\n1D748;0;;;03C3\n1D749;0;;;03C4\n1D74A;0;;;03C5\n1D74B;0;;;03C6\n1D74C;0;;;03C7\n1D74D;0;;;03C8\n1D74E;0;;;03C9\n1D74F;0;;;2202\n1D750;0;;;03F5\n1D751;0;;;03D1\n1D752;0;;;03F0\n1D753;0;;;03D5\n1D754;0;;;03F1\n1D755;0;;;03D6\n1D756;0;;;0391\n1D757;0;;;0392\n1D758;0;;;0393\n1D759;0;;;0394\n1D75A;0;;;0395\n1D75B;0;;;0396\n1D75C;0;;;0397\n1D75D;0;;;0398\n1D75E;0;;;0399\n1D75F;0;;;039A\n1D760;0;;;039B\n1D761;0;;;039C\n1D762;0;;;039D\n1D763;0;;;039E\n1D764;0;;;039F\n1D765;0;;;03A0\n1D766;0;;;03A1\n1D767;0;;;03F4\n1D768;0;;;03A3\n1D769;0;;;03A4\n1D76A;0;;;03A5\n1D76B;0;;;03A6\n1D76C;0;;;03A7\n1D76D;0;;;03A8\n1D76E;0;;;03A9\n1D76F;0;;;2207\n1D770;0;;;03B1\n1D771;0;;;03B2\n1D772;0;;;03B3\n1D773;0;;;03B4\n1D774;0;;;03B5\n1D775;0;;;03B6\n1D776;0;;;03B7\n1D777;0;;;03B8\n1D778;0;;;03B9\n1D779;0;;;03BA\n1D77A;0;;;03BB\n1D77B;0;;;03BC\n1D77C;0;;;03BD\n1D77D;0;;;03BE\n1D77E;0;;;03BF\n1D77F;0;;;03C0\n1D780;0;;;03C1\n1D781;0;;;03C2\n1D782;0;;;03C3\n1D783;0;;;03C4\n1D784;0;;;03C5\n1D785;0;;;03C6\n1D786;0;;;03C7\n1D787;0;;;03C8\n1D788;0;;;03C9\n1D789;0;;;2202\n1D78A;0;;;03F5\n1D78B;0;;;03D1\n1D78C;0;;;03F0\n1D78D;0;;;03D5\n1D78E;0;;;03F1\n1D78F;0;;;03D6\n1D790;0;;;0391\n1D791;0;;;0392\n1D792;0;;;0393\n1D793;0;;;0394\n1D794;0;;;0395\n1D795;0;;;0396\n1D796;0;;;0397\n1D797;0;;;0398\n1D798;0;;;0399\n1D799;0;;;039A\n1D79A;0;;;039B\n1D79B;0;;;039C\n1D79C;0;;;039D\n1D79D;0;;;039E\n1D79E;0;;;039F\n1D79F;0;;;03A0\n1D7A0;0;;;03A1\n1D7A1;0;;;03F4\n1D7A2;0;;;03A3\n1D7A3;0;;;03A4\n1D7A4;0;;;03A5\n1D7A5;0;;;03A6\n1D7A6;0;;;03A7\n1D7A7;0;;;03A8\n1D7A8;0;;;03A9\n1D7A9;0;;;2207\n1D7AA;0;;;03B1\n1D7AB;0;;;03B2\n1D7AC;0;;;03B3\n1D7AD;0;;;03B4\n1D7AE;0;;;03B5\n1D7AF;0;;;03B6\n1D7B0;0;;;03B7\n1D7B1;0;;;03B8\n1D7B2;0;;;03B9\n1D7B3;0;;;03BA\n1D7B4;0;;;03BB\n1D7B5;0;;;03BC\n1D7B6;0;;;03BD\n1D7B7;0;;;03BE\n1D7B8;0;;;03BF\n1D7B9;0;;;03C0\n1D7BA;0;;;03C1\n1D7BB;0;;;03C2\n1D7BC;0;;;03C3\n1D7BD;0;;;03C4\n1D7BE;0;;;03C5\n1D7BF;0;;;03C6\n1D7C0;0;;;03C7\n1D7C1;0;;;03C8\n1D7C2;0;;;03C9\n1D7C3;0;;;2202\n1D7C4;0;;;03F5\n1D7C5;0;;;03D1\n1D7C6;0;;;03F0\n1D7C7;0;;;03D5\n1D7C8;0;;;03F1\n1D7C9;0;;;03D6\n1D7CA;0;;;03DC\n1D7CB;0;;;03DD\n1D7CE;0;;;0030\n1D7CF;0;;;0031\n1D7D0;0;;;0032\n1D7D1;0;;;0033\n1D7D2;0;;;0034\n1D7D3;0;;;0035\n1D7D4;0;;;0036\n1D7D5;0;;;0037\n1D7D6;0;;;0038\n1D7D7;0;;;0039\n1D7D8;0;;;0030\n1D7D9;0;;;0031\n1D7DA;0;;;0032\n1D7DB;0;;;0033\n1D7DC;0;;;0034\n1D7DD;0;;;0035\n1D7DE;0;;;0036\n1D7DF;0;;;0037\n1D7E0;0;;;0038\n1D7E1;0;;;0039\n1D7E2;0;;;0030\n1D7E3;0;;;0031\n1D7E4;0;;;0032\n1D7E5;0;;;0033\n1D7E6;0;;;0034\n1D7E7;0;;;0035\n1D7E8;0;;;0036\n1D7E9;0;;;0037\n1D7EA;0;;;0038\n1D7EB;0;;;0039\n1D7EC;0;;;0030\n1D7ED;0;;;0031\n1D7EE;0;;;0032\n1D7EF;0;;;0033\n1D7F0;0;;;0034\n1D7F1;0;;;0035\n1D7F2;0;;;0036\n1D7F3;0;;;0037\n1D7F4;0;;;0038\n1D7F5;0;;;0039\n1D7F6;0;;;0030\n1D7F7;0;;;0031\n1D7F8;0;;;0032\n1D7F9;0;;;0033\n1D7FA;0;;;0034\n1D7FB;0;;;0035\n1D7FC;0;;;0036\n1D7FD;0;;;0037\n1D7FE;0;;;0038\n1D7FF;0;;;0039\n1E000;230;\n1E001;230;\n1E002;230;\n1E003;230;\n1E004;230;\n1E005;230;\n1E006;230;\n1E008;230;\n1E009;230;\n1E00A;230;\n1E00B;230;\n1E00C;230;\n1E00D;230;\n1E00E;230;\n1E00F;230;\n1E010;230;\n1E011;230;\n1E012;230;\n1E013;230;\n1E014;230;\n1E015;230;\n1E016;230;\n1E017;230;\n1E018;230;\n1E01B;230;\n1E01C;230;\n1E01D;230;\n1E01E;230;\n1E01F;230;\n1E020;230;\n1E021;230;\n1E023;230;\n1E024;230;\n1E026;230;\n1E027;230;\n1E028;230;\n1E029;230;\n1E02A;230;\n1E130;230;\n1E131;230;\n1E132;230;\n1E133;230;\n1E134;230;\n1E135;230;\n1E136;230;\n1E2AE;230;\n1E2EC;230;\n1E2ED;230;\n1E2EE;230;\n1E2EF;230;\n1E8D0;220;\n1E8D1;220;\n1E8D2;220;\n1E8D3;220;\n1E8D4;220;\n1E8D5;220;\n1E8D6;220;\n1E944;230;\n1E945;230;\n1E946;230;\n1E947;230;\n1E948;230;\n1E949;230;\n1E94A;7;\n1EE00;0;;;0627\n1EE01;0;;;0628\n1EE02;0;;;062C\n1EE03;0;;;062F\n1EE05;0;;;0648\n1EE06;0;;;0632\n1EE07;0;;;062D\n1EE08;0;;;0637\n1EE09;0;;;064A\n1EE0A;0;;;0643\n1EE0B;0;;;0644\n1EE0C;0;;;0645\n1EE0D;0;;;0646\n1EE0E;0;;;0633\n1EE0F;0;;;0639\n1EE10;0;;;0641\n1EE11;0;;;0635\n1EE12;0;;;0642\n1EE13;0;;;0631\n1EE14;0;;;0634\n1EE15;0;;;062A\n1EE16;0;;;062B\n1EE17;0;;;062E\n1EE18;0;;;0630\n1EE19;0;;;0636\n1EE1A;0;;;0638\n1EE1B;0;;;063A\n1EE1C;0;;;066E\n1EE1D;0;;;06BA\n1EE1E;0;;;06A1\n1EE1F;0;;;066F\n1EE21;0;;;0628\n1EE22;0;;;062C\n1EE24;0;;;0647\n1EE27;0;;;062D\n1EE29;0;;;064A\n1EE2A;0;;;0643\n1EE2B;0;;;0644\n1EE2C;0;;;0645\n1EE2D;0;;;0646\n1EE2E;0;;;0633\n1EE2F;0;;;0639\n1EE30;0;;;0641\n1EE31;0;;;0635\n1EE32;0;;;0642\n1EE34;0;;;0634\n1EE35;0;;;062A\n1EE36;0;;;062B\n1EE37;0;;;062E\n1EE39;0;;;0636\n1EE3B;0;;;063A\n1EE42;0;;;062C\n1EE47;0;;;062D\n1EE49;0;;;064A\n1EE4B;0;;;0644\n1EE4D;0;;;0646\n1EE4E;0;;;0633\n1EE4F;0;;;0639\n1EE51;0;;;0635\n1EE52;0;;;0642\n1EE54;0;;;0634\n1EE57;0;;;062E\n1EE59;0;;;0636\n1EE5B;0;;;063A\n1EE5D;0;;;06BA\n1EE5F;0;;;066F\n1EE61;0;;;0628\n1EE62;0;;;062C\n1EE64;0;;;0647\n1EE67;0;;;062D\n1EE68;0;;;0637\n1EE69;0;;;064A\n1EE6A;0;;;0643\n1EE6C;0;;;0645\n1EE6D;0;;;0646\n1EE6E;0;;;0633\n1EE6F;0;;;0639\n1EE70;0;;;0641\n1EE71;0;;;0635\n1EE72;0;;;0642\n1EE74;0;;;0634\n1EE75;0;;;062A\n1EE76;0;;;062B\n1EE77;0;;;062E\n1EE79;0;;;0636\n1EE7A;0;;;0638\n1EE7B;0;;;063A\n1EE7C;0;;;066E\n1EE7E;0;;;06A1\n1EE80;0;;;0627\n1EE81;0;;;0628\n1EE82;0;;;062C\n1EE83;0;;;062F\n1EE84;0;;;0647\n1EE85;0;;;0648\n1EE86;0;;;0632\n1EE87;0;;;062D\n1EE88;0;;;0637\n1EE89;0;;;064A\n1EE8B;0;;;0644\n1EE8C;0;;;0645\n1EE8D;0;;;0646\n1EE8E;0;;;0633\n1EE8F;0;;;0639\n1EE90;0;;;0641\n1EE91;0;;;0635\n1EE92;0;;;0642\n1EE93;0;;;0631\n1EE94;0;;;0634\n1EE95;0;;;062A\n1EE96;0;;;062B\n1EE97;0;;;062E\n1EE98;0;;;0630\n1EE99;0;;;0636\n1EE9A;0;;;0638\n1EE9B;0;;;063A\n1EEA1;0;;;0628\n1EEA2;0;;;062C\n1EEA3;0;;;062F\n1EEA5;0;;;0648\n1EEA6;0;;;0632\n1EEA7;0;;;062D\n1EEA8;0;;;0637\n1EEA9;0;;;064A\n1EEAB;0;;;0644\n1EEAC;0;;;0645\n1EEAD;0;;;0646\n1EEAE;0;;;0633\n1EEAF;0;;;0639\n1EEB0;0;;;0641\n1EEB1;0;;;0635\n1EEB2;0;;;0642\n1EEB3;0;;;0631\n1EEB4;0;;;0634\n1EEB5;0;;;062A\n1EEB6;0;;;062B\n1EEB7;0;;;062E\n1EEB8;0;;;0630\n1EEB9;0;;;0636\n1EEBA;0;;;0638\n1EEBB;0;;;063A\n1F100;0;;;0030 002E\n1F101;0;;;0030 002C\n1F102;0;;;0031 002C\n1F103;0;;;0032 002C\n1F104;0;;;0033 002C\n1F105;0;;;0034 002C\n1F106;0;;;0035 002C\n1F107;0;;;0036 002C\n1F108;0;;;0037 002C\n1F109;0;;;0038 002C\n1F10A;0;;;0039 002C\n1F110;0;;;0028 0041 0029\n1F111;0;;;0028 0042 0029\n1F112;0;;;0028 0043 0029\n1F113;0;;;0028 0044 0029\n1F114;0;;;0028 0045 0029\n1F115;0;;;0028 0046 0029\n1F116;0;;;0028 0047 0029\n1F117;0;;;0028 0048 0029\n1F118;0;;;0028 0049 0029\n1F119;0;;;0028 004A 0029\n1F11A;0;;;0028 004B 0029\n1F11B;0;;;0028 004C 0029\n1F11C;0;;;0028 004D 0029\n1F11D;0;;;0028 004E 0029\n1F11E;0;;;0028 004F 0029\n1F11F;0;;;0028 0050 0029\n1F120;0;;;0028 0051 0029\n1F121;0;;;0028 0052 0029\n1F122;0;;;0028 0053 0029\n1F123;0;;;0028 0054 0029\n1F124;0;;;0028 0055 0029\n1F125;0;;;0028 0056 0029\n1F126;0;;;0028 0057 0029\n1F127;0;;;0028 0058 0029\n1F128;0;;;0028 0059 0029\n1F129;0;;;0028 005A 0029\n1F12A;0;;;3014 0053 3015\n1F12B;0;;;0043\n1F12C;0;;;0052\n1F12D;0;;;0043 0044\n1F12E;0;;;0057 005A\n1F130;0;;;0041\n1F131;0;;;0042\n1F132;0;;;0043\n1F133;0;;;0044\n1F134;0;;;0045\n1F135;0;;;0046\n1F136;0;;;0047\n1F137;0;;;0048\n1F138;0;;;0049\n1F139;0;;;004A\n1F13A;0;;;004B\n1F13B;0;;;004C\n1F13C;0;;;004D\n1F13D;0;;;004E\n1F13E;0;;;004F\n1F13F;0;;;0050\n1F140;0;;;0051\n1F141;0;;;0052\n1F142;0;;;0053\n1F143;0;;;0054\n1F144;0;;;0055\n1F145;0;;;0056\n1F146;0;;;0057\n1F147;0;;;0058\n1F148;0;;;0059\n1F149;0;;;005A\n1F14A;0;;;0048 0056\n1F14B;0;;;004D 0056\n1F14C;0;;;0053 0044\n1F14D;0;;;0053 0053\n1F14E;0;;;0050 0050 0056\n1F14F;0;;;0057 0043\n1F16A;0;;;004D 0043\n1F16B;0;;;004D 0044\n1F16C;0;;;004D 0052\n1F190;0;;;0044 004A\n1F200;0;;;307B 304B\n1F201;0;;;30B3 30B3\n1F202;0;;;30B5\n1F210;0;;;624B\n1F211;0;;;5B57\n1F212;0;;;53CC\n1F213;0;;;30C7\n1F214;0;;;4E8C\n1F215;0;;;591A\n1F216;0;;;89E3\n1F217;0;;;5929\n1F218;0;;;4EA4\n1F219;0;;;6620\n1F21A;0;;;7121\n1F21B;0;;;6599\n1F21C;0;;;524D\n1F21D;0;;;5F8C\n1F21E;0;;;518D\n1F21F;0;;;65B0\n1F220;0;;;521D\n1F221;0;;;7D42\n1F222;0;;;751F\n1F223;0;;;8CA9\n1F224;0;;;58F0\n1F225;0;;;5439\n1F226;0;;;6F14\n1F227;0;;;6295\n1F228;0;;;6355\n1F229;0;;;4E00\n1F22A;0;;;4E09\n1F22B;0;;;904A\n1F22C;0;;;5DE6\n1F22D;0;;;4E2D\n1F22E;0;;;53F3\n1F22F;0;;;6307\n1F230;0;;;8D70\n1F231;0;;;6253\n1F232;0;;;7981\n1F233;0;;;7A7A\n1F234;0;;;5408\n1F235;0;;;6E80\n1F236;0;;;6709\n1F237;0;;;6708\n1F238;0;;;7533\n1F239;0;;;5272\n1F23A;0;;;55B6\n1F23B;0;;;914D\n1F240;0;;;3014 672C 3015\n1F241;0;;;3014 4E09 3015\n1F242;0;;;3014 4E8C 3015\n1F243;0;;;3014 5B89 3015\n1F244;0;;;3014 70B9 3015\n1F245;0;;;3014 6253 3015\n1F246;0;;;3014 76D7 3015\n1F247;0;;;3014 52DD 3015\n1F248;0;;;3014 6557 3015\n1F250;0;;;5F97\n1F251;0;;;53EF\n1FBF0;0;;;0030\n1FBF1;0;;;0031\n1FBF2;0;;;0032\n1FBF3;0;;;0033\n1FBF4;0;;;0034\n1FBF5;0;;;0035\n1FBF6;0;;;0036\n1FBF7;0;;;0037\n1FBF8;0;;;0038\n1FBF9;0;;;0039\n2F800;0;4E3D;x\n2F801;0;4E38;x\n2F802;0;4E41;x\n2F803;0;20122;x\n2F804;0;4F60;x\n2F805;0;4FAE;x\n2F806;0;4FBB;x\n2F807;0;5002;x\n2F808;0;507A;x\n2F809;0;5099;x\n2F80A;0;50E7;x\n2F80B;0;50CF;x\n2F80C;0;349E;x\n2F80D;0;2063A;x\n2F80E;0;514D;x\n2F80F;0;5154;x\n2F810;0;5164;x\n2F811;0;5177;x\n2F812;0;2051C;x\n2F813;0;34B9;x\n2F814;0;5167;x\n2F815;0;518D;x\n2F816;0;2054B;x\n2F817;0;5197;x\n2F818;0;51A4;x\n2F819;0;4ECC;x\n2F81A;0;51AC;x\n2F81B;0;51B5;x\n2F81C;0;291DF;x\n2F81D;0;51F5;x\n2F81E;0;5203;x\n2F81F;0;34DF;x\n2F820;0;523B;x\n2F821;0;5246;x\n2F822;0;5272;x\n2F823;0;5277;x\n2F824;0;3515;x\n2F825;0;52C7;x\n2F826;0;52C9;x\n2F827;0;52E4;x\n2F828;0;52FA;x\n2F829;0;5305;x\n2F82A;0;5306;x\n2F82B;0;5317;x\n2F82C;0;5349;x\n2F82D;0;5351;x\n2F82E;0;535A;x\n2F82F;0;5373;x\n2F830;0;537D;x\n2F831;0;537F;x\n2F832;0;537F;x\n2F833;0;537F;x\n2F834;0;20A2C;x\n2F835;0;7070;x\n2F836;0;53CA;x\n2F837;0;53DF;x\n2F838;0;20B63;x\n2F839;0;53EB;x\n2F83A;0;53F1;x\n2F83B;0;5406;x\n2F83C;0;549E;x\n2F83D;0;5438;x\n2F83E;0;5448;x\n2F83F;0;5468;x\n2F840;0;54A2;x\n2F841;0;54F6;x\n2F842;0;5510;x\n2F843;0;5553;x\n2F844;0;5563;x\n2F845;0;5584;x\n2F846;0;5584;x\n2F847;0;5599;x\n2F848;0;55AB;x\n2F849;0;55B3;x\n2F84A;0;55C2;x\n2F84B;0;5716;x\n2F84C;0;5606;x\n2F84D;0;5717;x\n2F84E;0;5651;x\n2F84F;0;5674;x\n2F850;0;5207;x\n2F851;0;58EE;x\n2F852;0;57CE;x\n2F853;0;57F4;x\n2F854;0;580D;x\n2F855;0;578B;x\n2F856;0;5832;x\n2F857;0;5831;x\n2F858;0;58AC;x\n2F859;0;214E4;x\n2F85A;0;58F2;x\n2F85B;0;58F7;x\n2F85C;0;5906;x\n2F85D;0;591A;x\n2F85E;0;5922;x\n2F85F;0;5962;x\n2F860;0;216A8;x\n2F861;0;216EA;x\n2F862;0;59EC;x\n2F863;0;5A1B;x\n2F864;0;5A27;x\n2F865;0;59D8;x\n2F866;0;5A66;x\n2F867;0;36EE;x\n2F868;0;36FC;x\n2F869;0;5B08;x\n2F86A;0;5B3E;x\n2F86B;0;5B3E;x\n2F86C;0;219C8;x\n2F86D;0;5BC3;x\n2F86E;0;5BD8;x\n2F86F;0;5BE7;x\n2F870;0;5BF3;x\n2F871;0;21B18;x\n2F872;0;5BFF;x\n2F873;0;5C06;x\n2F874;0;5F53;x\n2F875;0;5C22;x\n2F876;0;3781;x\n2F877;0;5C60;x\n2F878;0;5C6E;x\n2F879;0;5CC0;x\n2F87A;0;5C8D;x\n2F87B;0;21DE4;x\n2F87C;0;5D43;x\n2F87D;0;21DE6;x\n2F87E;0;5D6E;x\n2F87F;0;5D6B;x\n2F880;0;5D7C;x\n2F881;0;5DE1;x\n2F882;0;5DE2;x\n2F883;0;382F;x\n2F884;0;5DFD;x\n2F885;0;5E28;x\n2F886;0;5E3D;x\n2F887;0;5E69;x\n2F888;0;3862;x\n2F889;0;22183;x\n2F88A;0;387C;x\n2F88B;0;5EB0;x\n2F88C;0;5EB3;x\n2F88D;0;5EB6;x\n2F88E;0;5ECA;x\n2F88F;0;2A392;x\n2F890;0;5EFE;x\n2F891;0;22331;x\n2F892;0;22331;x\n2F893;0;8201;x\n2F894;0;5F22;x\n2F895;0;5F22;x\n2F896;0;38C7;x\n2F897;0;232B8;x\n2F898;0;261DA;x\n2F899;0;5F62;x\n2F89A;0;5F6B;x\n2F89B;0;38E3;x\n2F89C;0;5F9A;x\n2F89D;0;5FCD;x\n2F89E;0;5FD7;x\n2F89F;0;5FF9;x\n2F8A0;0;6081;x\n2F8A1;0;393A;x\n2F8A2;0;391C;x\n2F8A3;0;6094;x\n2F8A4;0;226D4;x\n2F8A5;0;60C7;x\n2F8A6;0;6148;x\n2F8A7;0;614C;x\n2F8A8;0;614E;x\n2F8A9;0;614C;x\n2F8AA;0;617A;x\n2F8AB;0;618E;x\n2F8AC;0;61B2;x\n2F8AD;0;61A4;x\n2F8AE;0;61AF;x\n2F8AF;0;61DE;x\n2F8B0;0;61F2;x\n2F8B1;0;61F6;x\n2F8B2;0;6210;x\n2F8B3;0;621B;x\n2F8B4;0;625D;x\n2F8B5;0;62B1;x\n2F8B6;0;62D4;x\n2F8B7;0;6350;x\n2F8B8;0;22B0C;x\n2F8B9;0;633D;x\n2F8BA;0;62FC;x\n2F8BB;0;6368;x\n2F8BC;0;6383;x\n2F8BD;0;63E4;x\n2F8BE;0;22BF1;x\n2F8BF;0;6422;x\n2F8C0;0;63C5;x\n2F8C1;0;63A9;x\n2F8C2;0;3A2E;x\n2F8C3;0;6469;x\n2F8C4;0;647E;x\n2F8C5;0;649D;x\n2F8C6;0;6477;x\n2F8C7;0;3A6C;x\n2F8C8;0;654F;x\n2F8C9;0;656C;x\n2F8CA;0;2300A;x\n2F8CB;0;65E3;x\n2F8CC;0;66F8;x\n2F8CD;0;6649;x\n2F8CE;0;3B19;x\n2F8CF;0;6691;x\n2F8D0;0;3B08;x\n2F8D1;0;3AE4;x\n2F8D2;0;5192;x\n2F8D3;0;5195;x\n2F8D4;0;6700;x\n2F8D5;0;669C;x\n2F8D6;0;80AD;x\n2F8D7;0;43D9;x\n2F8D8;0;6717;x\n2F8D9;0;671B;x\n2F8DA;0;6721;x\n2F8DB;0;675E;x\n2F8DC;0;6753;x\n2F8DD;0;233C3;x\n2F8DE;0;3B49;x\n2F8DF;0;67FA;x\n2F8E0;0;6785;x\n2F8E1;0;6852;x\n2F8E2;0;6885;x\n2F8E3;0;2346D;x\n2F8E4;0;688E;x\n2F8E5;0;681F;x\n2F8E6;0;6914;x\n2F8E7;0;3B9D;x\n2F8E8;0;6942;x\n2F8E9;0;69A3;x\n2F8EA;0;69EA;x\n2F8EB;0;6AA8;x\n2F8EC;0;236A3;x\n2F8ED;0;6ADB;x\n2F8EE;0;3C18;x\n2F8EF;0;6B21;x\n2F8F0;0;238A7;x\n2F8F1;0;6B54;x\n2F8F2;0;3C4E;x\n2F8F3;0;6B72;x\n2F8F4;0;6B9F;x\n2F8F5;0;6BBA;x\n2F8F6;0;6BBB;x\n2F8F7;0;23A8D;x\n2F8F8;0;21D0B;x\n2F8F9;0;23AFA;x\n2F8FA;0;6C4E;x\n2F8FB;0;23CBC;x\n2F8FC;0;6CBF;x\n2F8FD;0;6CCD;x\n2F8FE;0;6C67;x\n2F8FF;0;6D16;x\n2F900;0;6D3E;x\n2F901;0;6D77;x\n2F902;0;6D41;x\n2F903;0;6D69;x\n2F904;0;6D78;x\n2F905;0;6D85;x\n2F906;0;23D1E;x\n2F907;0;6D34;x\n2F908;0;6E2F;x\n2F909;0;6E6E;x\n2F90A;0;3D33;x\n2F90B;0;6ECB;x\n2F90C;0;6EC7;x\n2F90D;0;23ED1;x\n2F90E;0;6DF9;x\n2F90F;0;6F6E;x\n2F910;0;23F5E;x\n2F911;0;23F8E;x\n2F912;0;6FC6;x\n2F913;0;7039;x\n2F914;0;701E;x\n2F915;0;701B;x\n2F916;0;3D96;x\n2F917;0;704A;x\n2F918;0;707D;x\n2F919;0;7077;x\n2F91A;0;70AD;x\n2F91B;0;20525;x\n2F91C;0;7145;x\n2F91D;0;24263;x\n2F91E;0;719C;x\n2F91F;0;243AB;x\n2F920;0;7228;x\n2F921;0;7235;x\n2F922;0;7250;x\n2F923;0;24608;x\n2F924;0;7280;x\n2F925;0;7295;x\n2F926;0;24735;x\n2F927;0;24814;x\n2F928;0;737A;x\n2F929;0;738B;x\n2F92A;0;3EAC;x\n2F92B;0;73A5;x\n2F92C;0;3EB8;x\n2F92D;0;3EB8;x\n2F92E;0;7447;x\n2F92F;0;745C;x\n2F930;0;7471;x\n2F931;0;7485;x\n2F932;0;74CA;x\n2F933;0;3F1B;x\n2F934;0;7524;x\n2F935;0;24C36;x\n2F936;0;753E;x\n2F937;0;24C92;x\n2F938;0;7570;x\n2F939;0;2219F;x\n2F93A;0;7610;x\n2F93B;0;24FA1;x\n2F93C;0;24FB8;x\n2F93D;0;25044;x\n2F93E;0;3FFC;x\n2F93F;0;4008;x\n2F940;0;76F4;x\n2F941;0;250F3;x\n2F942;0;250F2;x\n2F943;0;25119;x\n2F944;0;25133;x\n2F945;0;771E;x\n2F946;0;771F;x\n2F947;0;771F;x\n2F948;0;774A;x\n2F949;0;4039;x\n2F94A;0;778B;x\n2F94B;0;4046;x\n2F94C;0;4096;x\n2F94D;0;2541D;x\n2F94E;0;784E;x\n2F94F;0;788C;x\n2F950;0;78CC;x\n2F951;0;40E3;x\n2F952;0;25626;x\n2F953;0;7956;x\n2F954;0;2569A;x\n2F955;0;256C5;x\n2F956;0;798F;x\n2F957;0;79EB;x\n2F958;0;412F;x\n2F959;0;7A40;x\n2F95A;0;7A4A;x\n2F95B;0;7A4F;x\n2F95C;0;2597C;x\n2F95D;0;25AA7;x\n2F95E;0;25AA7;x\n2F95F;0;7AEE;x\n2F960;0;4202;x\n2F961;0;25BAB;x\n2F962;0;7BC6;x\n2F963;0;7BC9;x\n2F964;0;4227;x\n2F965;0;25C80;x\n2F966;0;7CD2;x\n2F967;0;42A0;x\n2F968;0;7CE8;x\n2F969;0;7CE3;x\n2F96A;0;7D00;x\n2F96B;0;25F86;x\n2F96C;0;7D63;x\n2F96D;0;4301;x\n2F96E;0;7DC7;x\n2F96F;0;7E02;x\n2F970;0;7E45;x\n2F971;0;4334;x\n2F972;0;26228;x\n2F973;0;26247;x\n2F974;0;4359;x\n2F975;0;262D9;x\n2F976;0;7F7A;x\n2F977;0;2633E;x\n2F978;0;7F95;x\n2F979;0;7FFA;x\n2F97A;0;8005;x\n2F97B;0;264DA;x\n2F97C;0;26523;x\n2F97D;0;8060;x\n2F97E;0;265A8;x\n2F97F;0;8070;x\n2F980;0;2335F;x\n2F981;0;43D5;x\n2F982;0;80B2;x\n2F983;0;8103;x\n2F984;0;440B;x\n2F985;0;813E;x\n2F986;0;5AB5;x\n2F987;0;267A7;x\n2F988;0;267B5;x\n2F989;0;23393;x\n2F98A;0;2339C;x\n2F98B;0;8201;x\n2F98C;0;8204;x\n2F98D;0;8F9E;x\n2F98E;0;446B;x\n2F98F;0;8291;x\n2F990;0;828B;x\n2F991;0;829D;x\n2F992;0;52B3;x\n2F993;0;82B1;x\n2F994;0;82B3;x\n2F995;0;82BD;x\n2F996;0;82E6;x\n2F997;0;26B3C;x\n2F998;0;82E5;x\n2F999;0;831D;x\n2F99A;0;8363;x\n2F99B;0;83AD;x\n2F99C;0;8323;x\n2F99D;0;83BD;x\n2F99E;0;83E7;x\n2F99F;0;8457;x\n2F9A0;0;8353;x\n2F9A1;0;83CA;x\n2F9A2;0;83CC;x\n2F9A3;0;83DC;x\n2F9A4;0;26C36;x\n2F9A5;0;26D6B;x\n2F9A6;0;26CD5;x\n2F9A7;0;452B;x\n2F9A8;0;84F1;x\n2F9A9;0;84F3;x\n2F9AA;0;8516;x\n2F9AB;0;273CA;x\n2F9AC;0;8564;x\n2F9AD;0;26F2C;x\n2F9AE;0;455D;x\n2F9AF;0;4561;x\n2F9B0;0;26FB1;x\n2F9B1;0;270D2;x\n2F9B2;0;456B;x\n2F9B3;0;8650;x\n2F9B4;0;865C;x\n2F9B5;0;8667;x\n2F9B6;0;8669;x\n2F9B7;0;86A9;x\n2F9B8;0;8688;x\n2F9B9;0;870E;x\n2F9BA;0;86E2;x\n2F9BB;0;8779;x\n2F9BC;0;8728;x\n2F9BD;0;876B;x\n2F9BE;0;8786;x\n2F9BF;0;45D7;x\n2F9C0;0;87E1;x\n2F9C1;0;8801;x\n2F9C2;0;45F9;x\n2F9C3;0;8860;x\n2F9C4;0;8863;x\n2F9C5;0;27667;x\n2F9C6;0;88D7;x\n2F9C7;0;88DE;x\n2F9C8;0;4635;x\n2F9C9;0;88FA;x\n2F9CA;0;34BB;x\n2F9CB;0;278AE;x\n2F9CC;0;27966;x\n2F9CD;0;46BE;x\n2F9CE;0;46C7;x\n2F9CF;0;8AA0;x\n2F9D0;0;8AED;x\n2F9D1;0;8B8A;x\n2F9D2;0;8C55;x\n2F9D3;0;27CA8;x\n2F9D4;0;8CAB;x\n2F9D5;0;8CC1;x\n2F9D6;0;8D1B;x\n2F9D7;0;8D77;x\n2F9D8;0;27F2F;x\n2F9D9;0;20804;x\n2F9DA;0;8DCB;x\n2F9DB;0;8DBC;x\n2F9DC;0;8DF0;x\n2F9DD;0;208DE;x\n2F9DE;0;8ED4;x\n2F9DF;0;8F38;x\n2F9E0;0;285D2;x\n2F9E1;0;285ED;x\n2F9E2;0;9094;x\n2F9E3;0;90F1;x\n2F9E4;0;9111;x\n2F9E5;0;2872E;x\n2F9E6;0;911B;x\n2F9E7;0;9238;x\n2F9E8;0;92D7;x\n2F9E9;0;92D8;x\n2F9EA;0;927C;x\n2F9EB;0;93F9;x\n2F9EC;0;9415;x\n2F9ED;0;28BFA;x\n2F9EE;0;958B;x\n2F9EF;0;4995;x\n2F9F0;0;95B7;x\n2F9F1;0;28D77;x\n2F9F2;0;49E6;x\n2F9F3;0;96C3;x\n2F9F4;0;5DB2;x\n2F9F5;0;9723;x\n2F9F6;0;29145;x\n2F9F7;0;2921A;x\n2F9F8;0;4A6E;x\n2F9F9;0;4A76;x\n2F9FA;0;97E0;x\n2F9FB;0;2940A;x\n2F9FC;0;4AB2;x\n2F9FD;0;29496;x\n2F9FE;0;980B;x\n2F9FF;0;980B;x\n2FA00;0;9829;x\n2FA01;0;295B6;x\n2FA02;0;98E2;x\n2FA03;0;4B33;x\n2FA04;0;9929;x\n2FA05;0;99A7;x\n2FA06;0;99C2;x\n2FA07;0;99FE;x\n2FA08;0;4BCE;x\n2FA09;0;29B30;x\n2FA0A;0;9B12;x\n2FA0B;0;9C40;x\n2FA0C;0;9CFD;x\n2FA0D;0;4CCE;x\n2FA0E;0;4CED;x\n2FA0F;0;9D67;x\n2FA10;0;2A0CE;x\n2FA11;0;4CF8;x\n2FA12;0;2A105;x\n2FA13;0;2A20E;x\n2FA14;0;2A291;x\n2FA15;0;9EBB;x\n2FA16;0;4D56;x\n2FA17;0;9EF9;x\n2FA18;0;9EFE;x\n2FA19;0;9F05;x\n2FA1A;0;9F0F;x\n2FA1B;0;9F16;x\n2FA1C;0;9F3B;x\n2FA1D;0;2A600;x\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
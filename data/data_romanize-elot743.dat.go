@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("romanize-elot743.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# ELOT 743 transcription of Greek.\n# Fields: lower-case letter or letters; romanization.\n# The digraphs ου, αυ, ευ, ηυ, μπ and ντ depend on their context, and are handled in code.\nα\ta\nβ\tv\nγ\tg\nδ\td\nε\te\nζ\tz\nη\ti\nθ\tth\nι\ti\nκ\tk\nλ\tl\nμ\tm\nν\tn\nξ\tx\nο\to\nπ\tp\nρ\tr\nσ\ts\nς\ts\nτ\tt\nυ\ty\nφ\tf\nχ\tch\nψ\tps\nω\to\nγγ\tng\nγξ\tnx\nγχ\tnch\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
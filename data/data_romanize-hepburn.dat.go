@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("romanize-hepburn.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Modified Hepburn romanization of kana. Katakana are looked up as hiragana.\n# Fields: kana; romanization.\n# The sokuon (っ), the moraic nasal (ん) and the long vowel mark (ー) depend on\n# their context, and are handled in code.\nあ\ta\nい\ti\nう\tu\nえ\te\nお\to\nか\tka\nき\tki\nく\tku\nけ\tke\nこ\tko\nさ\tsa\nし\tshi\nす\tsu\nせ\tse\nそ\tso\nた\tta\nち\tchi\nつ\ttsu\nて\tte\nと\tto\nな\tna\nに\tni\nぬ\tnu\nね\tne\nの\tno\nは\tha\nひ\thi\nふ\tfu\nへ\the\nほ\tho\nま\tma\nみ\tmi\nむ\tmu\nめ\tme\nも\tmo\nや\tya\nゆ\tyu\nよ\tyo\nら\tra\nり\tri\nる\tru\nれ\tre\nろ\tro\nわ\twa\nゐ\ti\nゑ\te\nを\to\nん\tn\nが\tga\nぎ\tgi\nぐ\tgu\nげ\tge\nご\tgo\nざ\tza\nじ\tji\nず\tzu\nぜ\tze\nぞ\tzo\nだ\tda\nぢ\tji\nづ\tzu\nで\tde\nど\tdo\nば\tba\nび\tbi\nぶ\tbu\nべ\tbe\nぼ\tbo\nぱ\tpa\nぴ\tpi\nぷ\tpu\nぺ\tpe\nぽ\tpo\nぁ\ta\nぃ\ti\nぅ\tu\nぇ\te\nぉ\to\nゃ\tya\nゅ\tyu\nょ\tyo\nゎ\twa\nゔ\tvu\nきゃ\tkya\nきゅ\tkyu\nきょ\tkyo\nぎゃ\tgya\nぎゅ\tgyu\nぎょ\tgyo\nしゃ\tsha\nしゅ\tshu\nしょ\tsho\nじゃ\tja\nじゅ\tju\nじょ\tjo\nちゃ\tcha\nちゅ\tchu\nちょ\tcho\nぢゃ\tja\nぢゅ\tju\nぢょ\tjo\nにゃ\tnya\nにゅ\tnyu\nにょ\tnyo\nひゃ\thya\nひゅ\thyu\nひょ\thyo\nびゃ\tbya\nびゅ\tbyu\nびょ\tbyo\nぴゃ\tpya\nぴゅ\tpyu\nぴょ\tpyo\nみゃ\tmya\nみゅ\tmyu\nみょ\tmyo\nりゃ\trya\nりゅ\tryu\nりょ\tryo\nふぁ\tfa\nふぃ\tfi\nふぇ\tfe\nふぉ\tfo\nてぃ\tti\nでぃ\tdi\nとぅ\ttu\nどぅ\tdu\nうぃ\twi\nうぇ\twe\nうぉ\two\nゔぁ\tva\nゔぃ\tvi\nゔぇ\tve\nゔぉ\tvo\nしぇ\tshe\nじぇ\tje\nちぇ\tche\nつぁ\ttsa\nつぃ\ttsi\nつぇ\ttse\nつぉ\ttso\nいぇ\tye\nっ\t\nー\t\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("romanize-icao.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# ICAO Doc 9303 (machine readable travel documents) transliteration of Cyrillic.\n# Fields: lower-case letter; romanization.\nа\ta\nб\tb\nв\tv\nг\tg\nґ\tg\nд\td\nѓ\tg\nђ\td\nе\te\nё\te\nє\tie\nж\tzh\nз\tz\nѕ\tdz\nи\ti\nі\ti\nї\ti\nй\ti\nј\tj\nк\tk\nќ\tk\nл\tl\nљ\tlj\nм\tm\nн\tn\nњ\tnj\nо\to\nп\tp\nр\tr\nс\ts\nт\tt\nћ\tc\nу\tu\nў\tu\nф\tf\nх\tkh\nц\tts\nч\tch\nџ\tdz\nш\tsh\nщ\tshch\nъ\tie\nы\ty\nь\t\nэ\te\nю\tiu\nя\tia\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("romanize-iso9.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# ISO 9:1995 transliteration of Cyrillic, which is also GOST 7.79-2000 system A.\n# Fields: lower-case letter; romanization.\nа\ta\nб\tb\nв\tv\nг\tg\nґ\tg̀\nд\td\nѓ\tǵ\nђ\tđ\nе\te\nё\të\nє\tê\nж\tž\nз\tz\nѕ\tẑ\nи\ti\nі\tì\nї\tï\nй\tj\nј\tǰ\nк\tk\nќ\tḱ\nл\tl\nљ\tl̂\nм\tm\nн\tn\nњ\tn̂\nо\to\nп\tp\nр\tr\nс\ts\nт\tt\nћ\tć\nу\tu\nў\tŭ\nф\tf\nх\th\nц\tc\nч\tč\nџ\td̂\nш\tš\nщ\tŝ\nъ\tʺ\nы\ty\nь\tʹ\nэ\tè\nю\tû\nя\tâ\nѣ\tě\nѳ\tf̀\nѵ\tỳ\nѫ\tǎ\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
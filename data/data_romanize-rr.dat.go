@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("romanize-rr.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# Revised Romanization of Korean, by conjoining jamo. Hangul syllables are\n# decomposed into jamo before they are looked up.\n# Fields: jamo; romanization; for a final consonant, its romanization before a vowel.\nᄀ\tg\nᄁ\tkk\nᄂ\tn\nᄃ\td\nᄄ\ttt\nᄅ\tr\nᄆ\tm\nᄇ\tb\nᄈ\tpp\nᄉ\ts\nᄊ\tss\nᄋ\t\nᄌ\tj\nᄍ\tjj\nᄎ\tch\nᄏ\tk\nᄐ\tt\nᄑ\tp\nᄒ\th\nᅡ\ta\nᅢ\tae\nᅣ\tya\nᅤ\tyae\nᅥ\teo\nᅦ\te\nᅧ\tyeo\nᅨ\tye\nᅩ\to\nᅪ\twa\nᅫ\twae\nᅬ\toe\nᅭ\tyo\nᅮ\tu\nᅯ\two\nᅰ\twe\nᅱ\twi\nᅲ\tyu\nᅳ\teu\nᅴ\tui\nᅵ\ti\nᆨ\tk\tg\nᆩ\tk\tkk\nᆪ\tk\tks\nᆫ\tn\tn\nᆬ\tn\tnj\nᆭ\tn\tn\nᆮ\tt\td\nᆯ\tl\tr\nᆰ\tk\tlg\nᆱ\tm\tlm\nᆲ\tl\tlb\nᆳ\tl\tls\nᆴ\tl\tlt\nᆵ\tp\tlp\nᆶ\tl\tr\nᆷ\tm\tm\nᆸ\tp\tb\nᆹ\tp\tps\nᆺ\tt\ts\nᆻ\tt\tss\nᆼ\tng\tng\nᆽ\tt\tj\nᆾ\tt\tch\nᆿ\tk\tk\nᇀ\tt\tt\nᇁ\tp\tp\nᇂ\tt\t\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
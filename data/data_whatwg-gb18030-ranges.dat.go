@@ -0,0 +1,18 @@
+// This file is automatically generated by generate-charset-data.
+// Do not hand-edit.
+
+package data
+
+import (
+	"github.com/paulrosania/go-charset/charset"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+func init() {
+	charset.RegisterDataFile("whatwg-gb18030-ranges.dat", func() (io.ReadCloser, error) {
+		r := strings.NewReader("# index gb18030 ranges: the first pointer of each range of\n# four-byte GB18030 codes, and its code point.\n0\t0x0080\n36\t0x00A5\n38\t0x00A9\n45\t0x00B2\n50\t0x00B8\n81\t0x00D8\n89\t0x00E2\n95\t0x00EB\n96\t0x00EE\n100\t0x00F4\n103\t0x00F8\n104\t0x00FB\n105\t0x00FD\n109\t0x0102\n126\t0x0114\n133\t0x011C\n148\t0x012C\n172\t0x0145\n175\t0x0149\n179\t0x014E\n208\t0x016C\n306\t0x01CF\n307\t0x01D1\n308\t0x01D3\n309\t0x01D5\n310\t0x01D7\n311\t0x01D9\n312\t0x01DB\n313\t0x01DD\n341\t0x01FA\n428\t0x0252\n443\t0x0262\n544\t0x02C8\n545\t0x02CC\n558\t0x02DA\n741\t0x03A2\n742\t0x03AA\n749\t0x03C2\n750\t0x03CA\n805\t0x0402\n819\t0x0450\n820\t0x0452\n7922\t0x2011\n7924\t0x2017\n7925\t0x201A\n7927\t0x201E\n7934\t0x2027\n7943\t0x2031\n7944\t0x2034\n7945\t0x2036\n7950\t0x203C\n8062\t0x20AD\n8148\t0x2104\n8149\t0x2106\n8152\t0x210A\n8164\t0x2117\n8174\t0x2122\n8236\t0x216C\n8240\t0x217A\n8262\t0x2194\n8264\t0x219A\n8374\t0x2209\n8380\t0x2210\n8381\t0x2212\n8384\t0x2216\n8388\t0x221B\n8390\t0x2221\n8392\t0x2224\n8393\t0x2226\n8394\t0x222C\n8396\t0x222F\n8401\t0x2238\n8406\t0x223E\n8416\t0x2249\n8419\t0x224D\n8424\t0x2253\n8437\t0x2262\n8439\t0x2268\n8445\t0x2270\n8482\t0x2296\n8485\t0x229A\n8496\t0x22A6\n8521\t0x22C0\n8603\t0x2313\n8936\t0x246A\n8946\t0x249C\n9046\t0x254C\n9050\t0x2574\n9063\t0x2590\n9066\t0x2596\n9076\t0x25A2\n9092\t0x25B4\n9100\t0x25BE\n9108\t0x25C8\n9111\t0x25CC\n9113\t0x25D0\n9131\t0x25E6\n9162\t0x2607\n9164\t0x260A\n9218\t0x2641\n9219\t0x2643\n11329\t0x2E82\n11331\t0x2E85\n11334\t0x2E89\n11336\t0x2E8D\n11346\t0x2E98\n11361\t0x2EA8\n11363\t0x2EAB\n11366\t0x2EAF\n11370\t0x2EB4\n11372\t0x2EB8\n11375\t0x2EBC\n11389\t0x2ECB\n11682\t0x2FFC\n11686\t0x3004\n11687\t0x3018\n11692\t0x301F\n11694\t0x302A\n11714\t0x303F\n11716\t0x3094\n11723\t0x309F\n11725\t0x30F7\n11730\t0x30FF\n11736\t0x312A\n11982\t0x322A\n11989\t0x3232\n12102\t0x32A4\n12336\t0x3390\n12348\t0x339F\n12350\t0x33A2\n12384\t0x33C5\n12393\t0x33CF\n12395\t0x33D3\n12397\t0x33D6\n12510\t0x3448\n12553\t0x3474\n12851\t0x359F\n12962\t0x360F\n12973\t0x361B\n13738\t0x3919\n13823\t0x396F\n13919\t0x39D1\n13933\t0x39E0\n14080\t0x3A74\n14298\t0x3B4F\n14585\t0x3C6F\n14698\t0x3CE1\n15583\t0x4057\n15847\t0x4160\n16318\t0x4338\n16434\t0x43AD\n16438\t0x43B2\n16481\t0x43DE\n16729\t0x44D7\n17102\t0x464D\n17122\t0x4662\n17315\t0x4724\n17320\t0x472A\n17402\t0x477D\n17418\t0x478E\n17859\t0x4948\n17909\t0x497B\n17911\t0x497E\n17915\t0x4984\n17916\t0x4987\n17936\t0x499C\n17939\t0x49A0\n17961\t0x49B8\n18664\t0x4C78\n18703\t0x4CA4\n18814\t0x4D1A\n18962\t0x4DAF\n19043\t0x9FA6\n33469\t0xE76C\n33470\t0xE7C8\n33471\t0xE7E7\n33484\t0xE815\n33485\t0xE819\n33490\t0xE81F\n33497\t0xE827\n33501\t0xE82D\n33505\t0xE833\n33513\t0xE83C\n33520\t0xE844\n33536\t0xE856\n33550\t0xE865\n37845\t0xF92D\n37921\t0xF97A\n37948\t0xF996\n38029\t0xF9E8\n38038\t0xF9F2\n38064\t0xFA10\n38065\t0xFA12\n38066\t0xFA15\n38069\t0xFA19\n38075\t0xFA22\n38076\t0xFA25\n38078\t0xFA2A\n39108\t0xFE32\n39109\t0xFE45\n39113\t0xFE53\n39114\t0xFE58\n39115\t0xFE67\n39116\t0xFE6C\n39265\t0xFF5F\n39394\t0xFFE6\n189000\t0x10000\n")
+		return ioutil.NopCloser(r), nil
+	})
+}
@@ -0,0 +1,78 @@
+// +build ignore
+
+// go run generate-iana.go character-sets.xml
+
+// The generate-iana-data command generates the data file
+// iana-charsets.txt in github.com/paulrosania/go-charset/datafiles
+// from the IANA character sets registry (character-sets.xml), as found at
+// https://www.iana.org/assignments/character-sets/character-sets.xml.
+// It should be run in the data directory, and followed by
+// generate.go.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type registry struct {
+	Registry []struct {
+		ID     string `xml:"id,attr"`
+		Record []struct {
+			Name  string   `xml:"name"`
+			MIB   string   `xml:"value"`
+			Alias []string `xml:"alias"`
+			MIME  string   `xml:"preferred_alias"`
+		} `xml:"record"`
+	} `xml:"registry"`
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fatalf("usage: go run generate-iana.go character-sets.xml")
+	}
+	data, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		fatalf("%v", err)
+	}
+	var reg registry
+	if err := xml.Unmarshal(data, &reg); err != nil {
+		fatalf("cannot decode registry: %v", err)
+	}
+	if len(reg.Registry) == 0 || reg.Registry[0].ID != "character-sets-1" {
+		fatalf("unexpected registry format")
+	}
+	var buf bytes.Buffer
+	buf.WriteString("# The IANA character sets registry\n")
+	buf.WriteString("# (https://www.iana.org/assignments/character-sets),\n")
+	buf.WriteString("# generated by data/generate-iana.go.\n")
+	buf.WriteString("# MIBenum<TAB>name<TAB>preferred MIME name, or \"-\"<TAB>aliases\n")
+	for _, rec := range reg.Registry[0].Record {
+		name := strings.TrimSpace(rec.Name)
+		var aliases []string
+		for _, a := range rec.Alias {
+			// aliases may be followed by a comment.
+			if f := strings.Fields(a); len(f) > 0 && f[0] != name {
+				aliases = append(aliases, f[0])
+			}
+		}
+		mime := strings.TrimSpace(rec.MIME)
+		if mime == "" {
+			mime = "-"
+		}
+		fmt.Fprintf(&buf, "%s\t%s\t%s\t%s\n", strings.TrimSpace(rec.MIB), name, mime, strings.Join(aliases, " "))
+	}
+	if err := ioutil.WriteFile(filepath.Join("..", "datafiles", "iana-charsets.txt"), buf.Bytes(), 0666); err != nil {
+		fatalf("cannot write output file: %v", err)
+	}
+}
+
+func fatalf(f string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, "%s\n", fmt.Sprintf(f, a...))
+	os.Exit(2)
+}
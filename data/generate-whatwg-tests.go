@@ -0,0 +1,302 @@
+// +build ignore
+
+// go run generate-whatwg-tests.go chrome
+
+// The generate-whatwg-tests command generates the whatwg-*.txt
+// test files in github.com/paulrosania/go-charset/charset/testdata
+// by decoding test input with the TextDecoder of a browser, so that
+// the WHATWG decoders are checked against an implementation of the
+// Encoding Standard that is independent of the whatwg-* data files.
+// The argument is the path of a Chrome or Chromium binary that can
+// run headless. It should be run in the data directory.
+//
+// The files are:
+//
+//	whatwg-labels.txt	each label in charset/whatwg.go and a few
+//				that are not labels, with the name of the
+//				encoding that the browser gives it, or "-"
+//				if the browser has no decoder for it (the
+//				replacement encoding, or an unknown label).
+//	whatwg-single-byte.txt	for each single-byte encoding, the decoding
+//				of bytes 80 to ff.
+//	whatwg-<label>.txt	for each multi-byte encoding, a line for
+//				each prefix, giving the decodings of the
+//				prefix followed by each byte from 30 to ff,
+//				separated by spaces.
+//	whatwg-vectors.txt	label, input and output, in hex, of
+//				short sequences, mostly malformed.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var singleByte = []string{
+	"ibm866",
+	"iso-8859-2",
+	"iso-8859-3",
+	"iso-8859-4",
+	"iso-8859-5",
+	"iso-8859-6",
+	"iso-8859-7",
+	"iso-8859-8",
+	"iso-8859-8-i",
+	"iso-8859-10",
+	"iso-8859-13",
+	"iso-8859-14",
+	"iso-8859-15",
+	"iso-8859-16",
+	"koi8-r",
+	"koi8-u",
+	"macintosh",
+	"windows-874",
+	"windows-1250",
+	"windows-1251",
+	"windows-1252",
+	"windows-1253",
+	"windows-1254",
+	"windows-1255",
+	"windows-1256",
+	"windows-1257",
+	"windows-1258",
+	"x-mac-cyrillic",
+	"x-user-defined",
+}
+
+// multiByte gives, for each multi-byte encoding, the prefixes
+// to which each byte from 30 to ff is appended: every lead byte,
+// and for euc-jp the JIS X 0212 prefixes, and for gb18030 some
+// of the four-byte prefixes.
+var multiByte = map[string][]string{
+	"gb18030":   append(leads(), fourBytePrefixes()...),
+	"big5":      leads(),
+	"euc-kr":    leads(),
+	"shift_jis": leads(),
+	"euc-jp":    append(leads(), jis0212Prefixes()...),
+}
+
+func leads() []string {
+	var p []string
+	for b := 0x80; b <= 0xff; b++ {
+		p = append(p, fmt.Sprintf("%02x", b))
+	}
+	return p
+}
+
+func jis0212Prefixes() []string {
+	var p []string
+	for b := 0xa1; b <= 0xfe; b++ {
+		p = append(p, fmt.Sprintf("8f%02x", b))
+	}
+	return p
+}
+
+func fourBytePrefixes() []string {
+	var p []string
+	for _, b1 := range []int{0x81, 0x84, 0x85, 0x90, 0xe3, 0xfe} {
+		for b2 := 0x30; b2 <= 0x39; b2++ {
+			for _, b3 := range []int{0x81, 0xfe} {
+				p = append(p, fmt.Sprintf("%02x%02x%02x", b1, b2, b3))
+			}
+		}
+	}
+	return p
+}
+
+// vectors holds short inputs, in hex, for each label.
+var vectors = map[string][]string{
+	"utf-8": {
+		"61e282ac62", "e28241", "e282", "f08080", "eda080", "c0af", "f4908080",
+		"efbbbf61", "c2", "c241", "e0a080", "e09f80", "f0908080", "f08f8080",
+		"f48f8080", "f5", "80", "bf41", "fe", "ff", "e1804141", "eeb080", "edbfbf",
+	},
+	"utf-16le": {
+		"41003dd800de", "00d84100", "00dc410042", "41", "00d8", "00d800d8", "3dd800de00",
+		"fffe4100", "feff",
+	},
+	"utf-16be": {
+		"d83dde00", "d83d", "dc00", "d800d800", "004100", "feff0041",
+	},
+	"iso-2022-jp": {
+		"611b244224221b284262", "1b284a5c7e1b284931", "1b24421b284261", "1b285861",
+		"1b24422422", "611b", "1b244224", "1b244024221b2842", "1b284931", "1b2849",
+		"0e", "0f", "1b", "1b24", "1b2428", "1b2842", "1b284a", "7e5c", "1b24422121",
+		"1b244221", "1b2442212121", "1b244224227f", "1b2442242224241b2842",
+		"1b2442240a22", "1b284a0a", "1b2849df", "1b284920", "1b24427f21",
+	},
+	"gb18030": {
+		"81308130", "8431a439", "8431a530", "90308130", "e3329a35", "e3329a36",
+		"a8bc", "8135f437", "a3a0", "81308141", "84318130", "8130", "81", "80", "ff",
+		"fe39fe39", "a6d9a6da", "fe59fea0", "8130ff30",
+	},
+	"big5": {
+		"8862", "8864", "88a3", "88a5", "a1", "a13c", "8840", "c6a1", "f9fe", "a140a1",
+		"8080", "ff41",
+	},
+	"euc-jp": {
+		"8eb1", "8ee0", "8e", "8fa1a1", "8fb0a1", "8fa1", "8f", "a4a2", "a441",
+		"a1c0", "8fa2b7", "fefe",
+	},
+	"shift_jis": {
+		"82a0", "b15c7e", "824180a0", "f0408740", "82", "a0", "fcfc", "8180", "817f",
+		"ff", "fd",
+	},
+	"euc-kr": {
+		"b0a1", "c8fe", "8141", "a1", "a141", "fefe", "c9a1", "80", "ff",
+	},
+}
+
+// notLabels are names that the standard does not give any encoding.
+var notLabels = []string{"utf-7", "utf-32", "cesu-8", "iso-2022-jp-2", "x-mac-ukrainian-2", "ebcdic-cp-us", "gb2312-80"}
+
+const script = `
+function hex(s) {
+	var out = [];
+	for (var i = 0; i < s.length; i += 2) out.push(parseInt(s.substr(i, 2), 16));
+	return new Uint8Array(out);
+}
+function utf8hex(s) {
+	var b = new TextEncoder().encode(s), out = "";
+	for (var i = 0; i < b.length; i++) out += (b[i] < 16 ? "0" : "") + b[i].toString(16);
+	return out;
+}
+var out = [];
+out.push("=== whatwg-labels.txt");
+LABELS.forEach(function(l) {
+	var e = "-";
+	try { e = new TextDecoder(l).encoding; } catch (err) {}
+	out.push(l + "\t" + e);
+});
+out.push("=== whatwg-single-byte.txt");
+SINGLE.forEach(function(l) {
+	var b = [];
+	for (var i = 0x80; i <= 0xff; i++) b.push(i);
+	out.push(l + "\t" + new TextDecoder(l, {ignoreBOM: true}).decode(new Uint8Array(b)));
+});
+Object.keys(MULTI).sort().forEach(function(l) {
+	out.push("=== whatwg-" + l + ".txt");
+	MULTI[l].forEach(function(p) {
+		var d = [];
+		for (var t = 0x30; t <= 0xff; t++) {
+			d.push(new TextDecoder(l, {ignoreBOM: true}).decode(hex(p + (t < 16 ? "0" : "") + t.toString(16))));
+		}
+		out.push(p + "\t" + d.join(" "));
+	});
+});
+out.push("=== whatwg-vectors.txt");
+Object.keys(VECTORS).sort().forEach(function(l) {
+	VECTORS[l].forEach(function(v) {
+		out.push(l + "\t" + v + "\t" + utf8hex(new TextDecoder(l, {ignoreBOM: true}).decode(hex(v))));
+	});
+});
+out.push("=== " + navigator.userAgent);
+document.getElementById("o").textContent = out.join("\n");
+`
+
+func main() {
+	if len(os.Args) != 2 {
+		fatalf("usage: go run generate-whatwg-tests.go chrome")
+	}
+	labels, err := whatwgLabels()
+	if err != nil {
+		fatalf("%v", err)
+	}
+	labels = append(labels, notLabels...)
+	var s string
+	for _, r := range []struct {
+		name string
+		v    interface{}
+	}{
+		{"LABELS", labels},
+		{"SINGLE", singleByte},
+		{"MULTI", multiByte},
+		{"VECTORS", vectors},
+	} {
+		s += fmt.Sprintf("var %s = %s;\n", r.name, jsValue(r.v))
+	}
+	s += script
+	dir, err := ioutil.TempDir("", "whatwg-tests")
+	if err != nil {
+		fatalf("%v", err)
+	}
+	defer os.RemoveAll(dir)
+	page := filepath.Join(dir, "t.html")
+	err = ioutil.WriteFile(page, []byte("<!DOCTYPE html><meta charset=utf-8><pre id=o></pre><script>"+s+"</script>"), 0666)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	cmd := exec.Command(os.Args[1], "--headless", "--no-sandbox", "--disable-gpu", "--dump-dom", "file://"+page)
+	cmd.Stderr = os.Stderr
+	dom, err := cmd.Output()
+	if err != nil {
+		fatalf("cannot run browser: %v", err)
+	}
+	start := bytes.Index(dom, []byte("<pre id=\"o\">"))
+	end := bytes.Index(dom, []byte("</pre>"))
+	if start < 0 || end < start {
+		fatalf("no output from browser")
+	}
+	text := html.UnescapeString(string(dom[start+len("<pre id=\"o\">") : end]))
+	parts := strings.Split(text, "=== ")
+	browser := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.IndexByte(part, '\n')
+		file := filepath.Join("..", "charset", "testdata", part[:i])
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "# Generated by data/generate-whatwg-tests.go with %s.\n", strings.TrimSpace(browser))
+		buf.WriteString(part[i+1:])
+		if err := ioutil.WriteFile(file, buf.Bytes(), 0666); err != nil {
+			fatalf("cannot write output file: %v", err)
+		}
+	}
+}
+
+// whatwgLabels returns the labels in charset/whatwg.go.
+func whatwgLabels() ([]string, error) {
+	src, err := ioutil.ReadFile(filepath.Join("..", "charset", "whatwg.go"))
+	if err != nil {
+		return nil, err
+	}
+	var labels []string
+	for _, m := range regexp.MustCompile(`\{"[^"]+", \[\]string\{([^}]*)\}`).FindAllSubmatch(src, -1) {
+		for _, l := range regexp.MustCompile(`"([^"]*)"`).FindAllSubmatch(m[1], -1) {
+			labels = append(labels, string(l[1]))
+		}
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("no labels found in whatwg.go")
+	}
+	return labels, nil
+}
+
+// jsValue returns v as a JavaScript literal.
+func jsValue(v interface{}) string {
+	switch v := v.(type) {
+	case []string:
+		q := make([]string, len(v))
+		for i, s := range v {
+			q[i] = fmt.Sprintf("%q", s)
+		}
+		return "[" + strings.Join(q, ",") + "]"
+	case map[string][]string:
+		var q []string
+		for k, s := range v {
+			q = append(q, fmt.Sprintf("%q:%s", k, jsValue(s)))
+		}
+		return "{" + strings.Join(q, ",") + "}"
+	}
+	panic("unexpected type")
+}
+
+func fatalf(f string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, "%s\n", fmt.Sprintf(f, a...))
+	os.Exit(2)
+}
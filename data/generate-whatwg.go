@@ -0,0 +1,168 @@
+// +build ignore
+
+// go run generate-whatwg.go index-dir
+
+// The generate-whatwg-data command generates the whatwg-* data
+// files in github.com/paulrosania/go-charset/datafiles from the
+// index files of the WHATWG Encoding Standard
+// (https://encoding.spec.whatwg.org/index-*.txt), which should
+// be in index-dir. It should be run in the data directory, and
+// followed by generate.go.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// singleByte holds the indexes of the single-byte encodings,
+// which are written as code pages.
+var singleByte = []string{
+	"ibm866",
+	"iso-8859-2",
+	"iso-8859-3",
+	"iso-8859-4",
+	"iso-8859-5",
+	"iso-8859-6",
+	"iso-8859-7",
+	"iso-8859-8",
+	"iso-8859-10",
+	"iso-8859-13",
+	"iso-8859-14",
+	"iso-8859-15",
+	"iso-8859-16",
+	"koi8-r",
+	"koi8-u",
+	"macintosh",
+	"windows-874",
+	"windows-1250",
+	"windows-1251",
+	"windows-1252",
+	"windows-1253",
+	"windows-1254",
+	"windows-1255",
+	"windows-1256",
+	"windows-1257",
+	"windows-1258",
+	"x-mac-cyrillic",
+}
+
+// multiByte holds the indexes of the multibyte encodings, with
+// their number of pointers.
+var multiByte = []struct {
+	name string
+	size int
+}{
+	{"big5", 126 * 157},
+	{"euc-kr", 126 * 190},
+	{"gb18030", 126 * 190},
+	{"jis0208", 11280},
+	{"jis0212", 94 * 94},
+}
+
+var dataDir = filepath.Join("..", "datafiles")
+
+func main() {
+	if len(os.Args) != 2 {
+		fatalf("usage: go run generate-whatwg.go index-dir")
+	}
+	indexDir := os.Args[1]
+	for _, name := range singleByte {
+		index := readIndex(indexDir, name, 128)
+		var buf bytes.Buffer
+		for i := 0; i < 128; i++ {
+			buf.WriteRune(rune(i))
+		}
+		for _, r := range index {
+			buf.WriteRune(r)
+		}
+		writeFile("whatwg-"+name+".cp", buf.Bytes())
+	}
+	for _, m := range multiByte {
+		var buf bytes.Buffer
+		for _, r := range readIndex(indexDir, m.name, m.size) {
+			buf.WriteRune(r)
+		}
+		writeFile("whatwg-"+m.name+".dat", buf.Bytes())
+	}
+	var buf bytes.Buffer
+	buf.WriteString("# index gb18030 ranges: the first pointer of each range of\n")
+	buf.WriteString("# four-byte GB18030 codes, and its code point.\n")
+	for _, f := range readLines(indexDir, "gb18030-ranges") {
+		fmt.Fprintf(&buf, "%d\t0x%04X\n", f.pointer, f.codePoint)
+	}
+	writeFile("whatwg-gb18030-ranges.dat", buf.Bytes())
+}
+
+// An entry is a line of an index file.
+type entry struct {
+	pointer   int
+	codePoint rune
+}
+
+// readIndex returns the code point of each pointer of the named
+// index, which has size pointers, or U+FFFD if it has none.
+func readIndex(dir, name string, size int) []rune {
+	index := make([]rune, size)
+	for i := range index {
+		index[i] = utf8.RuneError
+	}
+	for _, e := range readLines(dir, name) {
+		if e.pointer >= size {
+			fatalf("index-%s.txt: pointer %d out of range", name, e.pointer)
+		}
+		index[e.pointer] = e.codePoint
+	}
+	return index
+}
+
+// readLines returns the entries of the named index file.
+// Each line holds a pointer, a code point in hexadecimal,
+// and a comment, separated by tabs.
+func readLines(dir, name string) []entry {
+	file := "index-" + name + ".txt"
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	var entries []entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			fatalf("%s: bad line %q", file, line)
+		}
+		pointer, err1 := strconv.Atoi(f[0])
+		cp, err2 := strconv.ParseInt(f[1], 0, 32)
+		if err1 != nil || err2 != nil || pointer < 0 {
+			fatalf("%s: bad line %q", file, line)
+		}
+		entries = append(entries, entry{pointer, rune(cp)})
+	}
+	if err := scanner.Err(); err != nil {
+		fatalf("%s: %v", file, err)
+	}
+	return entries
+}
+
+func writeFile(name string, data []byte) {
+	if err := ioutil.WriteFile(filepath.Join(dataDir, name), data, 0666); err != nil {
+		fatalf("cannot write output file: %v", err)
+	}
+}
+
+func fatalf(f string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, "%s\n", fmt.Sprintf(f, a...))
+	os.Exit(2)
+}
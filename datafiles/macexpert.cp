@@ -0,0 +1 @@
+�������������������������������� ⁽⁾‥․,-.⁄:;��������¼½¾⅛⅜⅝⅞⅓⅔������ﬀﬁﬂﬃﬄ₍�₎₡�������⁸₄₃₆₈₇�₂��₅�����₉₀₁�����������‒������¹²³⁴⁵⁶⁷⁹⁰��������ⁿ����
\ No newline at end of file